@@ -0,0 +1,160 @@
+package main
+
+import (
+	"concentus/opus"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Encodes 16-bit little endian PCM into the opus_demo bitstream format: each
+// packet is preceded by its length and final range, both 32-bit big endian.
+func main() {
+	rate := flag.Int("rate", 48000, "sample rate of the input")
+	channels := flag.Int("channels", 2, "number of input channels")
+	frameMs := flag.Float64("framesize", 20, "frame duration in ms (2.5, 5, 10, 20, 40 or 60)")
+	bitrate := flag.Int("bitrate", 64000, "bitrate in bits per second")
+	size := flag.Int("size", 0, "target total size of the packets in bytes (two-pass)")
+	twopass := flag.Bool("twopass", false, "use two-pass encoding to hit -bitrate on average")
+	cbr := flag.Bool("cbr", false, "constant bitrate (single pass only)")
+	complexity := flag.Int("complexity", 10, "encoder complexity (0-10)")
+	silk := flag.Bool("silk", false, "force SILK-only mode")
+	variable := flag.Bool("variable", false, "pick the frame duration of each packet from the signal, up to -framesize")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [options] input.pcm output.bit\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	input, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		fail(err)
+	}
+	pcm := make([]int16, len(input)/2)
+	for i := range pcm {
+		pcm[i] = int16(binary.LittleEndian.Uint16(input[2*i:]))
+	}
+	pcm = pcm[:len(pcm)/(*channels)*(*channels)]
+	if len(pcm) == 0 {
+		fail(fmt.Errorf("input is empty"))
+	}
+
+	encoder, err := opus.NewOpusEncoder(*rate, *channels, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		fail(err)
+	}
+	encoder.SetComplexity(*complexity)
+	if *silk {
+		encoder.SetForceMode(opus.MODE_SILK_ONLY)
+	}
+	frameSize := int(*frameMs * float64(*rate) / 1000)
+
+	var packets [][]byte
+	samples := 0
+	switch {
+	case *variable:
+		packets, samples, err = encodeVariable(encoder, pcm, frameSize, *channels, *bitrate)
+	case *size > 0:
+		packets, err = encoder.EncodeTwoPass(pcm, 0, frameSize, *size)
+	case *twopass:
+		packets, err = encoder.EncodeTwoPassBitrate(pcm, 0, frameSize, *bitrate)
+	default:
+		packets, err = encodeSinglePass(encoder, pcm, frameSize, *channels, *bitrate, *cbr)
+	}
+	if err != nil {
+		fail(err)
+	}
+	if samples == 0 {
+		samples = len(packets) * frameSize
+	}
+
+	out, err := os.Create(flag.Arg(1))
+	if err != nil {
+		fail(err)
+	}
+	defer out.Close()
+	total := 0
+	for _, packet := range packets {
+		if err := writePacket(out, packet); err != nil {
+			fail(err)
+		}
+		total += len(packet)
+	}
+	seconds := float64(samples) / float64(*rate)
+	fmt.Fprintf(os.Stderr, "%d packets, %d bytes, %.1f kbit/s\n", len(packets), total, float64(total)*8/seconds/1000)
+	if *size > 0 {
+		fmt.Fprintf(os.Stderr, "target %d bytes, off by %+.2f%%\n", *size, 100*float64(total-*size)/float64(*size))
+	}
+}
+
+func encodeSinglePass(encoder *opus.OpusEncoder, pcm []int16, frameSize, channels, bitrate int, cbr bool) ([][]byte, error) {
+	encoder.SetBitrate(bitrate)
+	encoder.SetUseVBR(!cbr)
+	var packets [][]byte
+	buf := make([]byte, 1275)
+	frame := make([]int16, frameSize*channels)
+	for off := 0; off < len(pcm); off += frameSize * channels {
+		n := copy(frame, pcm[off:])
+		for i := n; i < len(frame); i++ {
+			frame[i] = 0
+		}
+		ret, err := encoder.Encode(frame, 0, frameSize, buf, 0, len(buf))
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, append([]byte(nil), buf[:ret]...))
+	}
+	return packets, nil
+}
+
+// Encodes with packets of varying duration, returning them with their total
+// duration in samples per channel.
+func encodeVariable(encoder *opus.OpusEncoder, pcm []int16, frameSize, channels, bitrate int) ([][]byte, int, error) {
+	encoder.SetBitrate(bitrate)
+	venc := opus.NewOpusVariableEncoder(encoder)
+	maxDuration := map[int]opus.OpusFramesize{
+		1: opus.OPUS_FRAMESIZE_2_5_MS, 2: opus.OPUS_FRAMESIZE_5_MS, 4: opus.OPUS_FRAMESIZE_10_MS,
+		8: opus.OPUS_FRAMESIZE_20_MS, 16: opus.OPUS_FRAMESIZE_40_MS, 24: opus.OPUS_FRAMESIZE_60_MS,
+	}[frameSize*400/encoder.GetSampleRate()]
+	if err := venc.SetMaxDuration(maxDuration); err != nil {
+		return nil, 0, err
+	}
+	written, err := venc.Write(pcm, 0, len(pcm)/channels)
+	if err != nil {
+		return nil, 0, err
+	}
+	tail, err := venc.Flush()
+	if err != nil {
+		return nil, 0, err
+	}
+	var packets [][]byte
+	samples := 0
+	for _, p := range append(written, tail...) {
+		packets = append(packets, p.Data)
+		samples += p.Duration
+	}
+	return packets, samples, nil
+}
+
+func writePacket(w io.Writer, packet []byte) error {
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[0:], uint32(len(packet)))
+	// A zero final range tells opus_demo to skip the range check.
+	binary.BigEndian.PutUint32(header[4:], 0)
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(packet)
+	return err
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"concentus/netsim"
+	"encoding/binary"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Encodes a corpus of 16-bit little endian PCM files, sends the packets
+// through a simulated network and reports the quality of the decoded audio.
+// Every option that takes a list is swept; with -grid the results of every
+// combination are written as CSV.
+func main() {
+	rate := flag.Int("rate", 48000, "sample rate of the input")
+	channels := flag.Int("channels", 1, "number of input channels")
+	frameMs := flag.String("framesize", "20", "frame durations in ms")
+	bitrate := flag.String("bitrate", "24000", "bitrates in bits per second")
+	plp := flag.String("plp", "0", "expected packet loss percentages given to the encoder")
+	fec := flag.String("fec", "0", "in-band FEC off (0) or on (1)")
+	complexity := flag.Int("complexity", 10, "encoder complexity (0-10)")
+	silk := flag.Bool("silk", true, "force SILK-only mode, which carries the in-band FEC")
+	loss := flag.String("loss", "0", "mean packet loss percentages")
+	burst := flag.String("burst", "1", "mean loss burst lengths in packets")
+	jitter := flag.String("jitter", "0", "delay jitter in ms (standard deviation)")
+	reorder := flag.String("reorder", "0", "percentages of packets held back behind the next one")
+	buffer := flag.String("buffer", "40", "playout delays in ms")
+	seed := flag.Int64("seed", 1, "seed of the network model")
+	grid := flag.Bool("grid", false, "write one CSV row per combination")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [options] input.pcm...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var corpus [][]int16
+	for _, name := range flag.Args() {
+		input, err := os.ReadFile(name)
+		if err != nil {
+			fail(err)
+		}
+		pcm := make([]int16, len(input)/2)
+		for i := range pcm {
+			pcm[i] = int16(binary.LittleEndian.Uint16(input[2*i:]))
+		}
+		corpus = append(corpus, pcm[:len(pcm)/(*channels)*(*channels)])
+	}
+
+	axes := []struct {
+		name   string
+		values []float64
+	}{
+		{"framesize_ms", parseList(*frameMs)},
+		{"bitrate", parseList(*bitrate)},
+		{"plp", parseList(*plp)},
+		{"fec", parseList(*fec)},
+		{"loss_pct", parseList(*loss)},
+		{"burst", parseList(*burst)},
+		{"jitter_ms", parseList(*jitter)},
+		{"reorder_pct", parseList(*reorder)},
+		{"buffer_ms", parseList(*buffer)},
+	}
+
+	var out *csv.Writer
+	if *grid {
+		out = csv.NewWriter(os.Stdout)
+		var header []string
+		for _, axis := range axes {
+			header = append(header, axis.name)
+		}
+		out.Write(append(header, "mos", "bitrate_sent", "goodput", "frames", "lost", "late", "recovered", "concealed"))
+	}
+
+	/* Walk every combination, the last axis fastest */
+	index := make([]int, len(axes))
+	for {
+		v := make([]float64, len(axes))
+		for i, axis := range axes {
+			v[i] = axis.values[index[i]]
+		}
+		c := netsim.DefaultConfig()
+		c.Fs = *rate
+		c.Channels = *channels
+		c.FrameSize = int(v[0] * float64(*rate) / 1000)
+		c.Bitrate = int(v[1])
+		c.PacketLossPercent = int(v[2])
+		c.InbandFEC = v[3] != 0
+		c.Complexity = *complexity
+		c.SilkOnly = *silk
+		n := netsim.Network{
+			Loss:     v[4] / 100,
+			Burst:    v[5],
+			JitterMs: v[6],
+			Reorder:  v[7] / 100,
+			BufferMs: v[8],
+			Seed:     *seed,
+		}
+
+		var r netsim.Result
+		for _, pcm := range corpus {
+			one, err := netsim.Run(pcm, c, n)
+			if err != nil {
+				fail(err)
+			}
+			r.Add(one)
+		}
+
+		if *grid {
+			var row []string
+			for _, x := range v {
+				row = append(row, strconv.FormatFloat(x, 'g', -1, 64))
+			}
+			row = append(row, fmt.Sprintf("%.3f", r.MOS), fmt.Sprintf("%.0f", r.Bitrate), fmt.Sprintf("%.0f", r.Goodput))
+			for _, count := range []int{r.Frames, r.Lost, r.Late, r.Recovered, r.Concealed} {
+				row = append(row, strconv.Itoa(count))
+			}
+			out.Write(row)
+		} else {
+			fmt.Printf("%g ms frames, %d bit/s, plp %d%%, fec %v, loss %g%% in bursts of %g, jitter %g ms, reorder %g%%, buffer %g ms\n",
+				v[0], c.Bitrate, c.PacketLossPercent, c.InbandFEC, v[4], n.Burst, n.JitterMs, v[7], n.BufferMs)
+			fmt.Printf("  MOS %.2f, %.1f kbit/s sent, %.1f kbit/s in time\n", r.MOS, r.Bitrate/1000, r.Goodput/1000)
+			fmt.Printf("  %d frames: %d lost, %d late, %d recovered by FEC, %d concealed\n", r.Frames, r.Lost, r.Late, r.Recovered, r.Concealed)
+		}
+
+		i := len(axes) - 1
+		for ; i >= 0; i-- {
+			if index[i]++; index[i] < len(axes[i].values) {
+				break
+			}
+			index[i] = 0
+		}
+		if i < 0 {
+			break
+		}
+	}
+	if *grid {
+		out.Flush()
+		if err := out.Error(); err != nil {
+			fail(err)
+		}
+	}
+}
+
+// Parses a comma-separated list of numbers.
+func parseList(s string) []float64 {
+	var values []float64
+	for _, field := range strings.Split(s, ",") {
+		v, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			fail(fmt.Errorf("invalid value %q in %q", field, s))
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
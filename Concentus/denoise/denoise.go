@@ -0,0 +1,409 @@
+// Package denoise suppresses noise in speech with a recurrent network in
+// the design of RNNoise (Valin, 2018), and embeds the trained RNNoise model.
+// A Preprocessor runs it on the input of an Opus encoder.
+package denoise
+
+import (
+	"concentus/internal/fft"
+	"math"
+)
+
+/* Each 10 ms frame at 48 kHz is windowed, transformed and reduced to 42
+   features: the cepstrum of 22 band energies and its deltas, the pitch
+   correlation of the bands, the pitch period and a spectral variability
+   measure. A recurrent network turns them into a gain per band and a voice
+   activity probability. A comb filter at the pitch period restores the
+   harmonics between the bands before the gains are applied, and the frame
+   is resynthesized by overlap-add, 10 ms behind the input. */
+
+// FrameSize is the number of samples a Suppressor processes at a time.
+const FrameSize = 480
+
+const (
+	window_size   = 2 * FrameSize
+	freq_size     = FrameSize + 1
+	nb_bands      = 22
+	ceps_mem      = 8
+	nb_delta_ceps = 6
+	nb_features   = nb_bands + 3*nb_delta_ceps + 2
+
+	pitch_min_period = 60
+	pitch_max_period = 768
+	pitch_frame_size = 960
+	pitch_buf_size   = pitch_max_period + pitch_frame_size
+)
+
+const frame_size_shift = 2 // bins per band edge unit of eband5ms, as a shift
+
+/* Band edges of the 5 ms CELT bands, in units of 200 Hz */
+var eband5ms = [nb_bands]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 10, 12, 14, 16, 20, 24, 28, 34, 40, 48, 60, 78, 100}
+
+var (
+	half_window [FrameSize]float32
+	dct_table   [nb_bands * nb_bands]float32
+	window_fft  = fft.New(window_size)
+)
+
+func init() {
+	for i := 0; i < FrameSize; i++ {
+		s := math.Sin(.5 * math.Pi * (float64(i) + .5) / FrameSize)
+		half_window[i] = float32(math.Sin(.5 * math.Pi * s * s))
+	}
+	for i := 0; i < nb_bands; i++ {
+		for j := 0; j < nb_bands; j++ {
+			v := math.Cos((float64(i) + .5) * float64(j) * math.Pi / nb_bands)
+			if j == 0 {
+				v *= math.Sqrt(.5)
+			}
+			dct_table[i*nb_bands+j] = float32(v)
+		}
+	}
+}
+
+// Suppressor removes stationary noise from 48 kHz mono speech, one
+// FrameSize frame at a time. Its output lags the input by one frame.
+type Suppressor struct {
+	model         *Model
+	rnn           denoise_rnn_state
+	analysis_mem  [FrameSize]float32
+	cepstral_mem  [ceps_mem][nb_bands]float32
+	memid         int
+	synthesis_mem [FrameSize]float32
+	pitch_buf     [pitch_buf_size]float32
+	last_gain     float32
+	last_period   int
+	mem_hp_x      [2]float32
+	lastg         [nb_bands]float32
+	fft_in        []complex128
+	fft_out       []complex128
+	pitch_lp      []float32
+	last_vad      float32
+}
+
+// NewSuppressor creates a suppressor running model, or the embedded
+// model when model is nil.
+func NewSuppressor(model *Model) *Suppressor {
+	if model == nil {
+		model = DefaultModel()
+	}
+	st := &Suppressor{
+		model:    model,
+		fft_in:   make([]complex128, window_size),
+		fft_out:  make([]complex128, window_size),
+		pitch_lp: make([]float32, pitch_buf_size>>1),
+	}
+	st.Reset()
+	return st
+}
+
+// Reset clears the history, as at the start of a new stream.
+func (st *Suppressor) Reset() {
+	model := st.model
+	*st = Suppressor{
+		model:    model,
+		fft_in:   st.fft_in,
+		fft_out:  st.fft_out,
+		pitch_lp: st.pitch_lp,
+	}
+	st.rnn.init(model)
+}
+
+func (st *Suppressor) GetModel() *Model {
+	return st.model
+}
+
+// GetVADProbability returns the voice activity probability of the last
+// frame, from 0 to 1.
+func (st *Suppressor) GetVADProbability() float32 {
+	return st.last_vad
+}
+
+// ProcessFrame denoises FrameSize samples of in and writes as many
+// to out, one frame behind. in and out may be the same buffer. It returns
+// the voice activity probability of the frame.
+func (st *Suppressor) ProcessFrame(in []int16, in_ptr int, out []int16, out_ptr int) float32 {
+	var x [FrameSize]float32
+	for i := range x {
+		x[i] = float32(in[in_ptr+i])
+	}
+	vad := st.ProcessFrameFloat(x[:], x[:])
+	for i := range x {
+		out[out_ptr+i] = int16(max(-32768, min(32767, int(math.Floor(float64(.5+x[i]))))))
+	}
+	return vad
+}
+
+// ProcessFrameFloat is ProcessFrame on samples scaled as 16-bit integers.
+func (st *Suppressor) ProcessFrameFloat(in []float32, out []float32) float32 {
+	var X, P [freq_size][2]float32
+	var x [FrameSize]float32
+	var Ex, Ep, Exp, g [nb_bands]float32
+	var features [nb_features]float32
+	var gains [freq_size]float32
+	vad_prob := float32(0)
+
+	/* High-pass at about 60 Hz */
+	denoise_biquad(x[:], &st.mem_hp_x, in[:FrameSize], [2]float32{-2, 1}, [2]float32{-1.99599, 0.99600})
+	silence := st.compute_frame_features(&X, &P, &Ex, &Ep, &Exp, &features, x[:])
+
+	if !silence {
+		vad_prob = st.rnn.compute(st.model, g[:], features[:])
+		denoise_pitch_filter(&X, &P, &Ex, &Ep, &Exp, &g)
+		for i := 0; i < nb_bands; i++ {
+			/* Limit how fast the gains can fall */
+			g[i] = max(g[i], .6*st.lastg[i])
+			st.lastg[i] = g[i]
+		}
+		denoise_interp_band_gain(gains[:], g[:])
+		for i := 0; i < freq_size; i++ {
+			X[i][0] *= gains[i]
+			X[i][1] *= gains[i]
+		}
+	}
+	st.frame_synthesis(out, &X)
+	st.last_vad = vad_prob
+	return vad_prob
+}
+
+func denoise_biquad(y []float32, mem *[2]float32, x []float32, b [2]float32, a [2]float32) {
+	for i := range x {
+		xi := float64(x[i])
+		yi := float64(x[i] + mem[0])
+		mem[0] = mem[1] + float32(float64(b[0])*xi-float64(a[0])*yi)
+		mem[1] = float32(float64(b[1])*xi - float64(a[1])*yi)
+		y[i] = float32(yi)
+	}
+}
+
+func denoise_apply_window(x []float32) {
+	for i := 0; i < FrameSize; i++ {
+		x[i] *= half_window[i]
+		x[window_size-1-i] *= half_window[i]
+	}
+}
+
+// Transform of the window_size samples of x, scaled by 1/window_size. Only
+// the bins up to Nyquist are kept.
+func (st *Suppressor) forward_transform(X *[freq_size][2]float32, x []float32) {
+	for i := 0; i < window_size; i++ {
+		st.fft_in[i] = complex(float64(x[i]), 0)
+	}
+	window_fft.Forward(st.fft_in, st.fft_out)
+	for i := 0; i < freq_size; i++ {
+		X[i] = [2]float32{float32(real(st.fft_out[i])), float32(imag(st.fft_out[i]))}
+	}
+}
+
+// Inverse of forward_transform: the spectrum is completed by symmetry and
+// run through the forward FFT, whose output read backwards is the inverse.
+func (st *Suppressor) inverse_transform(x []float32, X *[freq_size][2]float32) {
+	for i := 0; i < freq_size; i++ {
+		st.fft_in[i] = complex(float64(X[i][0]), float64(X[i][1]))
+	}
+	for i := freq_size; i < window_size; i++ {
+		st.fft_in[i] = complex(float64(X[window_size-i][0]), -float64(X[window_size-i][1]))
+	}
+	window_fft.Forward(st.fft_in, st.fft_out)
+	x[0] = window_size * float32(real(st.fft_out[0]))
+	for i := 1; i < window_size; i++ {
+		x[i] = window_size * float32(real(st.fft_out[window_size-i]))
+	}
+}
+
+func denoise_compute_band_energy(bandE *[nb_bands]float32, X *[freq_size][2]float32) {
+	var sum [nb_bands]float32
+	for i := 0; i < nb_bands-1; i++ {
+		band_size := (eband5ms[i+1] - eband5ms[i]) << frame_size_shift
+		for j := 0; j < band_size; j++ {
+			frac := float32(j) / float32(band_size)
+			bin := X[(eband5ms[i]<<frame_size_shift)+j]
+			tmp := bin[0]*bin[0] + bin[1]*bin[1]
+			sum[i] += (1 - frac) * tmp
+			sum[i+1] += frac * tmp
+		}
+	}
+	sum[0] *= 2
+	sum[nb_bands-1] *= 2
+	*bandE = sum
+}
+
+func denoise_compute_band_corr(bandE *[nb_bands]float32, X, P *[freq_size][2]float32) {
+	var sum [nb_bands]float32
+	for i := 0; i < nb_bands-1; i++ {
+		band_size := (eband5ms[i+1] - eband5ms[i]) << frame_size_shift
+		for j := 0; j < band_size; j++ {
+			frac := float32(j) / float32(band_size)
+			k := (eband5ms[i] << frame_size_shift) + j
+			tmp := X[k][0]*P[k][0] + X[k][1]*P[k][1]
+			sum[i] += (1 - frac) * tmp
+			sum[i+1] += frac * tmp
+		}
+	}
+	sum[0] *= 2
+	sum[nb_bands-1] *= 2
+	*bandE = sum
+}
+
+// Spreads band gains over the bins, interpolating between band centres.
+func denoise_interp_band_gain(g []float32, bandE []float32) {
+	for i := range g {
+		g[i] = 0
+	}
+	for i := 0; i < nb_bands-1; i++ {
+		band_size := (eband5ms[i+1] - eband5ms[i]) << frame_size_shift
+		for j := 0; j < band_size; j++ {
+			frac := float32(j) / float32(band_size)
+			g[(eband5ms[i]<<frame_size_shift)+j] = (1-frac)*bandE[i] + frac*bandE[i+1]
+		}
+	}
+}
+
+func denoise_dct(out []float32, in []float32) {
+	for i := 0; i < nb_bands; i++ {
+		sum := float32(0)
+		for j := 0; j < nb_bands; j++ {
+			sum += in[j] * dct_table[j*nb_bands+i]
+		}
+		out[i] = sum * float32(math.Sqrt(2./22))
+	}
+}
+
+func (st *Suppressor) frame_analysis(X *[freq_size][2]float32, Ex *[nb_bands]float32, in []float32) {
+	var x [window_size]float32
+	copy(x[:], st.analysis_mem[:])
+	copy(x[FrameSize:], in[:FrameSize])
+	copy(st.analysis_mem[:], in[:FrameSize])
+	denoise_apply_window(x[:])
+	st.forward_transform(X, x[:])
+	denoise_compute_band_energy(Ex, X)
+}
+
+func (st *Suppressor) frame_synthesis(out []float32, y *[freq_size][2]float32) {
+	var x [window_size]float32
+	st.inverse_transform(x[:], y)
+	denoise_apply_window(x[:])
+	for i := 0; i < FrameSize; i++ {
+		out[i] = x[i] + st.synthesis_mem[i]
+	}
+	copy(st.synthesis_mem[:], x[FrameSize:])
+}
+
+// Fills the features of the frame in x and returns true when the frame is
+// silent, in which case the network is not run.
+func (st *Suppressor) compute_frame_features(X, P *[freq_size][2]float32, Ex, Ep, Exp *[nb_bands]float32,
+	features *[nb_features]float32, in []float32) bool {
+	var p [window_size]float32
+	var Ly, tmp [nb_bands]float32
+	E := float32(0)
+	spec_variability := float32(0)
+
+	st.frame_analysis(X, Ex, in)
+	copy(st.pitch_buf[:], st.pitch_buf[FrameSize:])
+	copy(st.pitch_buf[pitch_buf_size-FrameSize:], in[:FrameSize])
+
+	pitch_downsample(st.pitch_buf[:], st.pitch_lp)
+	pitch_index := pitch_search(st.pitch_lp[pitch_max_period>>1:], st.pitch_lp, pitch_frame_size,
+		pitch_max_period-3*pitch_min_period)
+	pitch_index = pitch_max_period - pitch_index
+	gain := remove_doubling(st.pitch_lp, pitch_max_period, pitch_min_period,
+		pitch_frame_size, &pitch_index, st.last_period, st.last_gain)
+	st.last_period = pitch_index
+	st.last_gain = gain
+
+	for i := 0; i < window_size; i++ {
+		p[i] = st.pitch_buf[pitch_buf_size-window_size-pitch_index+i]
+	}
+	denoise_apply_window(p[:])
+	st.forward_transform(P, p[:])
+	denoise_compute_band_energy(Ep, P)
+	denoise_compute_band_corr(Exp, X, P)
+	for i := 0; i < nb_bands; i++ {
+		Exp[i] = Exp[i] / float32(math.Sqrt(float64(.001+Ex[i]*Ep[i])))
+	}
+	denoise_dct(tmp[:], Exp[:])
+	for i := 0; i < nb_delta_ceps; i++ {
+		features[nb_bands+2*nb_delta_ceps+i] = tmp[i]
+	}
+	features[nb_bands+2*nb_delta_ceps] -= 1.3
+	features[nb_bands+2*nb_delta_ceps+1] -= 0.9
+	features[nb_bands+3*nb_delta_ceps] = .01 * float32(pitch_index-300)
+
+	logMax := float32(-2)
+	follow := float32(-2)
+	for i := 0; i < nb_bands; i++ {
+		Ly[i] = float32(math.Log10(float64(1e-2 + Ex[i])))
+		Ly[i] = max(logMax-7, max(follow-1.5, Ly[i]))
+		logMax = max(logMax, Ly[i])
+		follow = max(follow-1.5, Ly[i])
+		E += Ex[i]
+	}
+	if E < 0.04 {
+		/* No audio, leave the state alone */
+		*features = [nb_features]float32{}
+		return true
+	}
+	denoise_dct(features[:], Ly[:])
+	features[0] -= 12
+	features[1] -= 4
+	ceps_0 := &st.cepstral_mem[st.memid]
+	ceps_1 := &st.cepstral_mem[(st.memid+ceps_mem-1)%ceps_mem]
+	ceps_2 := &st.cepstral_mem[(st.memid+ceps_mem-2)%ceps_mem]
+	copy(ceps_0[:], features[:nb_bands])
+	st.memid++
+	for i := 0; i < nb_delta_ceps; i++ {
+		features[i] = ceps_0[i] + ceps_1[i] + ceps_2[i]
+		features[nb_bands+i] = ceps_0[i] - ceps_2[i]
+		features[nb_bands+nb_delta_ceps+i] = ceps_0[i] - 2*ceps_1[i] + ceps_2[i]
+	}
+	if st.memid == ceps_mem {
+		st.memid = 0
+	}
+	/* Spectral variability */
+	for i := 0; i < ceps_mem; i++ {
+		mindist := float32(1e15)
+		for j := 0; j < ceps_mem; j++ {
+			if j == i {
+				continue
+			}
+			dist := float32(0)
+			for k := 0; k < nb_bands; k++ {
+				tmp := st.cepstral_mem[i][k] - st.cepstral_mem[j][k]
+				dist += tmp * tmp
+			}
+			mindist = min(mindist, dist)
+		}
+		spec_variability += mindist
+	}
+	features[nb_bands+3*nb_delta_ceps+1] = spec_variability/ceps_mem - 2.1
+	return false
+}
+
+// Adds the pitch-delayed signal P to X in the bands where the gains g would
+// otherwise remove harmonics, then restores the band energies.
+func denoise_pitch_filter(X, P *[freq_size][2]float32, Ex, Ep, Exp *[nb_bands]float32, g *[nb_bands]float32) {
+	var r, norm, newE [nb_bands]float32
+	var rf, normf [freq_size]float32
+	for i := 0; i < nb_bands; i++ {
+		if Exp[i] > g[i] {
+			r[i] = 1
+		} else {
+			r[i] = Exp[i] * Exp[i] * (1 - g[i]*g[i]) / (.001 + g[i]*g[i]*(1-Exp[i]*Exp[i]))
+		}
+		r[i] = float32(math.Sqrt(float64(min(1, max(0, r[i])))))
+		r[i] *= float32(math.Sqrt(float64(Ex[i] / (1e-8 + Ep[i]))))
+	}
+	denoise_interp_band_gain(rf[:], r[:])
+	for i := 0; i < freq_size; i++ {
+		X[i][0] += rf[i] * P[i][0]
+		X[i][1] += rf[i] * P[i][1]
+	}
+	denoise_compute_band_energy(&newE, X)
+	for i := 0; i < nb_bands; i++ {
+		norm[i] = float32(math.Sqrt(float64(Ex[i] / (1e-8 + newE[i]))))
+	}
+	denoise_interp_band_gain(normf[:], norm[:])
+	for i := 0; i < freq_size; i++ {
+		X[i][0] *= normf[i]
+		X[i][1] *= normf[i]
+	}
+}
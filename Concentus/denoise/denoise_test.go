@@ -0,0 +1,376 @@
+package denoise
+
+import (
+	"concentus/opus"
+	"concentus/quality"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// A voiced/unvoiced alternation below 4 kHz, as in the opus tests.
+func testSpeechSignal(channels, samples, rate int) []int16 {
+	out := make([]int16, samples*channels)
+	phase, lp := 0.0, 0.0
+	seed := uint32(1)
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(rate)
+		f0 := 140 + 40*math.Sin(2*math.Pi*0.7*t)
+		phase += 2 * math.Pi * f0 / float64(rate)
+		voiced := 0.0
+		for h := 1; float64(h)*f0 < 3800; h++ {
+			voiced += math.Sin(float64(h)*phase) / float64(h)
+		}
+		seed = seed*1664525 + 1013904223
+		lp = 0.7*lp + 0.3*float64(int32(seed)>>16)/32768
+		s := 6000*math.Max(0, math.Sin(2*math.Pi*3*t))*voiced + 3000*math.Max(0, -math.Sin(2*math.Pi*3*t))*lp
+		for c := 0; c < channels; c++ {
+			out[i*channels+c] = int16(s * (1 - 0.2*float64(c)))
+		}
+	}
+	return out
+}
+
+// White noise of the given RMS.
+func testNoise(samples int, rms float64, seed int64) []float64 {
+	rng := rand.New(rand.NewSource(seed))
+	out := make([]float64, samples)
+	for i := range out {
+		out[i] = rms * rng.NormFloat64()
+	}
+	return out
+}
+
+// One second of noise, then speech in the same noise.
+func testNoisySpeech(seconds int, rms float64) (clean, noisy []int16) {
+	n := seconds * 48000
+	speech := testSpeechSignal(1, n-48000, 48000)
+	noise := testNoise(n, rms, 1)
+	clean = make([]int16, n)
+	noisy = make([]int16, n)
+	copy(clean[48000:], speech)
+	for i := range noisy {
+		noisy[i] = int16(math.Max(-32768, math.Min(32767, float64(clean[i])+noise[i])))
+	}
+	return clean, noisy
+}
+
+func testSuppress(ns *Suppressor, in []int16) ([]int16, []float32) {
+	out := make([]int16, len(in))
+	var vad []float32
+	for off := 0; off+FrameSize <= len(in); off += FrameSize {
+		vad = append(vad, ns.ProcessFrame(in, off, out, off))
+	}
+	/* Undo the one frame delay */
+	return out[FrameSize:], vad
+}
+
+// Energy in dB of x over [from, to).
+func testEnergy(x []int16, from, to int) float64 {
+	e := 1e-9
+	for _, v := range x[from:to] {
+		e += float64(v) * float64(v)
+	}
+	return 10 * math.Log10(e/float64(to-from))
+}
+
+// SNR in dB of x against ref over [from, to).
+func testSNR(ref, x []int16, from, to int) float64 {
+	s, n := 1e-9, 1e-9
+	for i := from; i < to; i++ {
+		d := float64(x[i]) - float64(ref[i])
+		s += float64(ref[i]) * float64(ref[i])
+		n += d * d
+	}
+	return 10 * math.Log10(s/n)
+}
+
+func TestTransform(t *testing.T) {
+	ns := NewSuppressor(nil)
+	rng := rand.New(rand.NewSource(1))
+	x := make([]float32, window_size)
+	for i := range x {
+		x[i] = float32(3000 * rng.NormFloat64())
+	}
+	var X [freq_size][2]float32
+	ns.forward_transform(&X, x)
+	for _, k := range []int{0, 1, 37, 240, 479, 480} {
+		var re, im float64
+		for n, v := range x {
+			a := 2 * math.Pi * float64(k*n) / window_size
+			re += float64(v) * math.Cos(a) / window_size
+			im -= float64(v) * math.Sin(a) / window_size
+		}
+		if math.Abs(re-float64(X[k][0])) > 0.05 || math.Abs(im-float64(X[k][1])) > 0.05 {
+			t.Fatalf("bin %d: %v, want %.3f%+.3fi", k, X[k], re, im)
+		}
+	}
+	y := make([]float32, window_size)
+	ns.inverse_transform(y, &X)
+	for i := range x {
+		if math.Abs(float64(x[i]-y[i])) > 1.5 {
+			t.Fatalf("sample %d: %f, want %f", i, y[i], x[i])
+		}
+	}
+}
+
+func TestSuppressor(t *testing.T) {
+	clean, noisy := testNoisySpeech(4, 800)
+	out, vad := testSuppress(NewSuppressor(nil), noisy)
+	n := len(out)
+
+	/* The input high-pass shifts the phase of the fundamental, so the
+	   reference goes through it too */
+	x := make([]float32, len(clean))
+	for i, v := range clean {
+		x[i] = float32(v)
+	}
+	var mem [2]float32
+	denoise_biquad(x, &mem, x, [2]float32{-2, 1}, [2]float32{-1.99599, 0.99600})
+	ref := make([]int16, len(clean))
+	for i, v := range x {
+		ref[i] = int16(v)
+	}
+
+	/* The first half second lets the floor settle */
+	noiseIn := testEnergy(noisy, 24000, 48000)
+	noiseOut := testEnergy(out, 24000, 48000)
+	snrIn := testSNR(ref, noisy, 48000, n)
+	snrOut := testSNR(ref, out, 48000, n)
+	speechIn := testEnergy(noisy, 48000, n)
+	speechOut := testEnergy(out, 48000, n)
+	t.Logf("noise %.1f -> %.1f dB, speech %.1f -> %.1f dB, SNR %.1f -> %.1f dB",
+		noiseIn, noiseOut, speechIn, speechOut, snrIn, snrOut)
+	if noiseIn-noiseOut < 10 {
+		t.Errorf("noise only lowered by %.1f dB", noiseIn-noiseOut)
+	}
+	if speechIn-speechOut > 3 {
+		t.Errorf("speech lowered by %.1f dB", speechIn-speechOut)
+	}
+	if snrOut < snrIn+6 {
+		t.Errorf("SNR %.1f dB, %.1f dB before", snrOut, snrIn)
+	}
+	before, _ := quality.Perceptual(clean[48000:n], noisy[48000:n], 1, 48000)
+	after, _ := quality.Perceptual(clean[48000:n], out[48000:n], 1, 48000)
+	t.Logf("MOS %.2f -> %.2f", before.MOS, after.MOS)
+	if after.MOS < before.MOS+0.5 {
+		t.Errorf("MOS %.2f, %.2f before", after.MOS, before.MOS)
+	}
+
+	var vadNoise, vadSpeech float32
+	for i, v := range vad {
+		if i >= 50 && i < 100 {
+			vadNoise += v / 50
+		} else if i >= 100 {
+			vadSpeech += v / float32(len(vad)-100)
+		}
+	}
+	t.Logf("VAD %.2f in noise, %.2f in speech", vadNoise, vadSpeech)
+	if vadNoise > 0.2 || vadSpeech < vadNoise+0.2 {
+		t.Errorf("VAD %.2f in noise, %.2f in speech", vadNoise, vadSpeech)
+	}
+
+	/* Digital silence goes through untouched */
+	silent, _ := testSuppress(NewSuppressor(nil), make([]int16, 48000))
+	for i, v := range silent {
+		if v != 0 {
+			t.Fatalf("sample %d of silence is %d", i, v)
+		}
+	}
+}
+
+// Serialises model with the RNNoise layer names, the GRUs as int8.
+func testDenoiseBlob(model *Model) []byte {
+	b := &testWeightBlob{}
+	floats := func(name string, w []float32) {
+		data := make([]byte, 4*len(w))
+		for i, v := range w {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+		}
+		b.array(name, WeightTypeFloat, data)
+	}
+	int8s := func(name string, w []float32) {
+		data := make([]byte, len(w))
+		for i, v := range w {
+			data[i] = byte(int8(math.Max(-128, math.Min(127, math.Round(float64(v)*256)))))
+		}
+		b.array(name, WeightTypeInt8, data)
+	}
+	for _, l := range []struct {
+		name  string
+		layer *denoise_dense
+	}{{"input_dense", model.input_dense}, {"denoise_output", model.denoise_output}, {"vad_output", model.vad_output}} {
+		floats(l.name+"_weights", l.layer.weights)
+		floats(l.name+"_bias", l.layer.bias)
+	}
+	for _, l := range []struct {
+		name string
+		gru  *denoise_gru
+	}{{"vad_gru", model.vad_gru}, {"noise_gru", model.noise_gru}, {"denoise_gru", model.denoise_gru}} {
+		int8s(l.name+"_weights", l.gru.input_weights)
+		int8s(l.name+"_recurrent_weights", l.gru.recurrent_weights)
+		floats(l.name+"_bias", l.gru.bias)
+	}
+	return b.buf.Bytes()
+}
+
+// The voice activity of RNNoise 0.1.1 on testNoisySpeech(4, 800), one
+// frame per line, is in testdata/rnnoise_vad.txt. The FFT here is in double
+// precision, so the probabilities are close rather than equal.
+func TestMatchesRNNoise(t *testing.T) {
+	data, err := os.ReadFile("testdata/rnnoise_vad.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []float64
+	for _, line := range strings.Fields(string(data)) {
+		v, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, v)
+	}
+	_, noisy := testNoisySpeech(4, 800)
+	_, vad := testSuppress(NewSuppressor(nil), noisy)
+	if len(vad) != len(want) {
+		t.Fatalf("%d frames, want %d", len(vad), len(want))
+	}
+	mean := 0.0
+	for i, v := range vad {
+		d := math.Abs(float64(v) - want[i])
+		if d > 0.005 {
+			t.Fatalf("frame %d: VAD %.3f, RNNoise %.3f", i, v, want[i])
+		}
+		mean += d / float64(len(vad))
+	}
+	if mean > 0.0005 {
+		t.Errorf("VAD off by %.4f on average", mean)
+	}
+}
+
+func TestLoadModel(t *testing.T) {
+	def := DefaultModel()
+	model, err := LoadModel(testDenoiseBlob(def))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.noise_gru.nb_neurons != def.noise_gru.nb_neurons || model.denoise_gru.nb_inputs != def.denoise_gru.nb_inputs {
+		t.Fatal("layer sizes not inferred")
+	}
+	for i, v := range def.input_dense.weights {
+		if model.input_dense.weights[i] != v {
+			t.Fatalf("float weight %d: %f, want %f", i, model.input_dense.weights[i], v)
+		}
+	}
+	for i, v := range def.vad_gru.recurrent_weights {
+		if math.Abs(float64(model.vad_gru.recurrent_weights[i]-v)) > 1./512 && math.Abs(float64(v)) < 127./256 {
+			t.Fatalf("int8 weight %d: %f, want %f", i, model.vad_gru.recurrent_weights[i], v)
+		}
+	}
+
+	/* A loaded model runs like any other */
+	_, noisy := testNoisySpeech(2, 800)
+	if _, vad := testSuppress(NewSuppressor(model), noisy); len(vad) != 200 {
+		t.Fatalf("%d frames", len(vad))
+	}
+
+	b := &testWeightBlob{rng: rand.New(rand.NewSource(1))}
+	b.floats("input_dense_weights", nb_features*24, 1)
+	b.floats("input_dense_bias", 24, 1)
+	if _, err := LoadModel(b.buf.Bytes()); err == nil {
+		t.Fatal("model without GRUs accepted")
+	}
+	if _, err := LoadModel([]byte("not a model")); err == nil {
+		t.Fatal("garbage accepted")
+	}
+}
+
+func TestPreprocessor(t *testing.T) {
+	var _ opus.Preprocessor = (*Preprocessor)(nil)
+	if _, err := NewPreprocessor(nil, 16000, 1); err == nil {
+		t.Fatal("16 kHz accepted")
+	}
+	pre, err := NewPreprocessor(nil, 48000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pre.GetModel() != DefaultModel() {
+		t.Fatal("not on the embedded model")
+	}
+
+	/* Each channel goes through its own suppressor */
+	_, noisy := testNoisySpeech(2, 800)
+	stereo := make([]int16, 2*len(noisy))
+	for i, v := range noisy {
+		stereo[2*i] = v
+		stereo[2*i+1] = v / 2
+	}
+	left, _ := testSuppress(NewSuppressor(nil), noisy)
+	for off := 0; off < len(stereo); off += 2 * FrameSize {
+		pre.Process(stereo[off : off+2*FrameSize])
+	}
+	for i, v := range left {
+		if stereo[2*(i+FrameSize)] != v {
+			t.Fatalf("sample %d: %d, want %d", i, stereo[2*(i+FrameSize)], v)
+		}
+	}
+}
+
+func testDecodePackets(tb testing.TB, packets [][]byte) []int16 {
+	dec, err := opus.NewOpusDecoder(48000, 1)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var out []int16
+	buf := make([]int16, 960)
+	for _, packet := range packets {
+		n, err := dec.Decode(packet, 0, len(packet), buf, 0, 960, false)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		out = append(out, buf[:n]...)
+	}
+	return out
+}
+
+// Noisy speech sounds closer to the clean input when the encoder denoises
+// it first.
+func TestPreprocessorEncoder(t *testing.T) {
+	clean, noisy := testNoisySpeech(3, 800)
+	buf := make([]byte, 1275)
+	score := func(ns bool) float64 {
+		enc, _ := opus.NewOpusEncoder(48000, 1, opus.OPUS_APPLICATION_VOIP)
+		enc.SetForceMode(opus.MODE_SILK_ONLY)
+		enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+		enc.SetBitrate(24000)
+		if ns {
+			pre, _ := NewPreprocessor(nil, 48000, 1)
+			if err := enc.SetPreprocessor(pre); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var packets [][]byte
+		for off := 0; off+960 <= len(noisy); off += 960 {
+			n, err := enc.Encode(noisy, off, 960, buf, 0, len(buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			packets = append(packets, append([]byte(nil), buf[:n]...))
+		}
+		out := testDecodePackets(t, packets)
+		q, err := quality.Perceptual(clean[48000:], out[48000:], 1, 48000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q.MOS
+	}
+	plain, denoised := score(false), score(true)
+	t.Logf("MOS %.2f without suppression, %.2f with", plain, denoised)
+	if denoised < plain+0.3 {
+		t.Fatalf("MOS %.2f with suppression, %.2f without", denoised, plain)
+	}
+}
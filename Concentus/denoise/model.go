@@ -0,0 +1,309 @@
+package denoise
+
+import (
+	"errors"
+	"sync"
+)
+
+/* The network of the noise suppressor, with the topology of RNNoise: a dense
+   layer on the features feeds a voice activity GRU; a noise estimation GRU
+   sees the features and both, and a denoising GRU sees the features and the
+   two GRU states before the output layer computes the band gains. The GRUs
+   apply the reset gate to the state before the recurrent weights, as in
+   RNNoise. */
+
+const denoise_weights_scale = 1. / 256 // of the 8-bit weights of RNNoise
+
+type denoise_dense struct {
+	nb_inputs  int
+	nb_neurons int
+	bias       []float32
+	weights    []float32 // input major: weights[j*nb_neurons+i]
+	activation int
+}
+
+type denoise_gru struct {
+	nb_inputs         int
+	nb_neurons        int
+	bias              []float32 // update, reset and output gates
+	input_weights     []float32 // input_weights[j*3*nb_neurons+i]
+	recurrent_weights []float32 // recurrent_weights[j*3*nb_neurons+i]
+	activation        int
+}
+
+// Model holds the weights of a noise suppression network. A model is
+// read-only and may be shared by any number of suppressors.
+type Model struct {
+	input_dense    *denoise_dense
+	vad_gru        *denoise_gru
+	noise_gru      *denoise_gru
+	denoise_gru    *denoise_gru
+	denoise_output *denoise_dense
+	vad_output     *denoise_dense
+}
+
+type denoise_rnn_state struct {
+	vad_gru_state     []float32
+	noise_gru_state   []float32
+	denoise_gru_state []float32
+}
+
+func (rnn *denoise_rnn_state) init(model *Model) {
+	rnn.vad_gru_state = make([]float32, model.vad_gru.nb_neurons)
+	rnn.noise_gru_state = make([]float32, model.noise_gru.nb_neurons)
+	rnn.denoise_gru_state = make([]float32, model.denoise_gru.nb_neurons)
+}
+
+// The activations of RNNoise, on the tansig table of the MLP of the
+// analysis rather than the approximations of the DNN models.
+func denoise_sigmoid(x float32) float32 {
+	return .5 + .5*tansig_approx(.5*x)
+}
+
+func denoise_activation(x []float32, activation int) {
+	for i, v := range x {
+		switch activation {
+		case activation_sigmoid:
+			x[i] = denoise_sigmoid(v)
+		case activation_tanh:
+			x[i] = tansig_approx(v)
+		case activation_relu:
+			x[i] = max(0, v)
+		}
+	}
+}
+
+func (layer *denoise_dense) compute(output []float32, input []float32) {
+	N := layer.nb_neurons
+	for i := 0; i < N; i++ {
+		sum := layer.bias[i]
+		for j := 0; j < layer.nb_inputs; j++ {
+			sum += layer.weights[j*N+i] * input[j]
+		}
+		output[i] = sum
+	}
+	denoise_activation(output[:N], layer.activation)
+}
+
+func (gru *denoise_gru) compute(state []float32, input []float32) {
+	N := gru.nb_neurons
+	M := gru.nb_inputs
+	stride := 3 * N
+	z := make([]float32, N)
+	r := make([]float32, N)
+	h := make([]float32, N)
+	for i := 0; i < N; i++ {
+		/* Update and reset gates */
+		zs := gru.bias[i]
+		rs := gru.bias[N+i]
+		for j := 0; j < M; j++ {
+			zs += gru.input_weights[j*stride+i] * input[j]
+			rs += gru.input_weights[j*stride+N+i] * input[j]
+		}
+		for j := 0; j < N; j++ {
+			zs += gru.recurrent_weights[j*stride+i] * state[j]
+			rs += gru.recurrent_weights[j*stride+N+i] * state[j]
+		}
+		z[i] = denoise_sigmoid(zs)
+		r[i] = denoise_sigmoid(rs)
+	}
+	for i := 0; i < N; i++ {
+		sum := gru.bias[2*N+i]
+		for j := 0; j < M; j++ {
+			sum += gru.input_weights[2*N+j*stride+i] * input[j]
+		}
+		for j := 0; j < N; j++ {
+			sum += gru.recurrent_weights[2*N+j*stride+i] * state[j] * r[j]
+		}
+		h[i] = sum
+	}
+	denoise_activation(h, gru.activation)
+	for i := 0; i < N; i++ {
+		h[i] = z[i]*state[i] + (1-z[i])*h[i]
+	}
+	copy(state, h)
+}
+
+// Runs the network on the features of a frame, filling the band gains and
+// returning the voice activity probability.
+func (rnn *denoise_rnn_state) compute(model *Model, gains []float32, input []float32) float32 {
+	dense_out := make([]float32, model.input_dense.nb_neurons)
+	model.input_dense.compute(dense_out, input)
+	model.vad_gru.compute(rnn.vad_gru_state, dense_out)
+	var vad [1]float32
+	model.vad_output.compute(vad[:], rnn.vad_gru_state)
+
+	noise_input := append(append(append([]float32(nil), dense_out...), rnn.vad_gru_state...), input...)
+	model.noise_gru.compute(rnn.noise_gru_state, noise_input)
+
+	denoise_input := append(append(append([]float32(nil), rnn.vad_gru_state...), rnn.noise_gru_state...), input...)
+	model.denoise_gru.compute(rnn.denoise_gru_state, denoise_input)
+	model.denoise_output.compute(gains, rnn.denoise_gru_state)
+	return vad[0]
+}
+
+// Weights of an array of the blob, from 8-bit values in the scale of
+// RNNoise or from floats.
+func denoise_weights(arrays map[string]*WeightArray, name string) []float32 {
+	a := arrays[name]
+	if a == nil {
+		return nil
+	}
+	if w := weight_floats(a); w != nil {
+		return w
+	}
+	if q := weight_int8(a); q != nil {
+		return denoise_scale_weights(q)
+	}
+	return nil
+}
+
+func denoise_scale_weights(q []int8) []float32 {
+	w := make([]float32, len(q))
+	for i, v := range q {
+		w[i] = float32(v) * denoise_weights_scale
+	}
+	return w
+}
+
+func denoise_dense_init(arrays map[string]*WeightArray, name string, nb_inputs int, activation int) (*denoise_dense, error) {
+	layer := &denoise_dense{
+		bias:       denoise_weights(arrays, name+"_bias"),
+		weights:    denoise_weights(arrays, name+"_weights"),
+		nb_inputs:  nb_inputs,
+		activation: activation,
+	}
+	layer.nb_neurons = len(layer.bias)
+	if layer.nb_neurons == 0 || len(layer.weights) != nb_inputs*layer.nb_neurons {
+		return nil, errors.New("denoise: missing or invalid weights for layer " + name)
+	}
+	return layer, nil
+}
+
+func denoise_gru_init(arrays map[string]*WeightArray, name string, nb_inputs int, activation int) (*denoise_gru, error) {
+	gru := &denoise_gru{
+		bias:              denoise_weights(arrays, name+"_bias"),
+		input_weights:     denoise_weights(arrays, name+"_weights"),
+		recurrent_weights: denoise_weights(arrays, name+"_recurrent_weights"),
+		nb_inputs:         nb_inputs,
+		activation:        activation,
+	}
+	gru.nb_neurons = len(gru.bias) / 3
+	N := gru.nb_neurons
+	if N == 0 || len(gru.bias) != 3*N || len(gru.input_weights) != 3*N*nb_inputs || len(gru.recurrent_weights) != 3*N*N {
+		return nil, errors.New("denoise: missing or invalid weights for layer " + name)
+	}
+	return gru, nil
+}
+
+// LoadModel builds a model from a weight blob in the format read by
+// ParseWeights. The arrays carry the layer names of RNNoise
+// (input_dense_weights, vad_gru_recurrent_weights, denoise_output_bias...)
+// and hold either floats or the 8-bit weights of RNNoise, scaled by 1/256.
+// The layer sizes are taken from the arrays.
+func LoadModel(blob []byte) (*Model, error) {
+	arrays, err := ParseWeights(blob)
+	if err != nil {
+		return nil, err
+	}
+	model := &Model{}
+	if model.input_dense, err = denoise_dense_init(arrays, "input_dense", nb_features, activation_tanh); err != nil {
+		return nil, err
+	}
+	dense := model.input_dense.nb_neurons
+	if model.vad_gru, err = denoise_gru_init(arrays, "vad_gru", dense, activation_relu); err != nil {
+		return nil, err
+	}
+	vad := model.vad_gru.nb_neurons
+	if model.noise_gru, err = denoise_gru_init(arrays, "noise_gru", dense+vad+nb_features, activation_relu); err != nil {
+		return nil, err
+	}
+	noise := model.noise_gru.nb_neurons
+	if model.denoise_gru, err = denoise_gru_init(arrays, "denoise_gru", vad+noise+nb_features, activation_relu); err != nil {
+		return nil, err
+	}
+	if model.denoise_output, err = denoise_dense_init(arrays, "denoise_output", model.denoise_gru.nb_neurons, activation_sigmoid); err != nil {
+		return nil, err
+	}
+	if model.vad_output, err = denoise_dense_init(arrays, "vad_output", vad, activation_sigmoid); err != nil {
+		return nil, err
+	}
+	if model.denoise_output.nb_neurons != nb_bands || model.vad_output.nb_neurons != 1 {
+		return nil, errors.New("denoise: invalid output size")
+	}
+	return model, nil
+}
+
+/* The embedded model is the one RNNoise 0.1.1 was trained with, from the
+   weights of model_data.go */
+
+var (
+	default_denoise_model      *Model
+	default_denoise_model_once sync.Once
+)
+
+// DefaultModel returns the embedded model, the trained network of
+// RNNoise.
+func DefaultModel() *Model {
+	default_denoise_model_once.Do(func() {
+		default_denoise_model = build_default_denoise_model()
+	})
+	return default_denoise_model
+}
+
+func build_default_denoise_model() *Model {
+	const (
+		dense_size   = 24
+		vad_size     = 24
+		noise_size   = 48
+		denoise_size = 96
+	)
+	F := nb_features
+	return &Model{
+		input_dense: &denoise_dense{
+			nb_inputs:  F,
+			nb_neurons: dense_size,
+			bias:       denoise_scale_weights(denoise_input_dense_bias),
+			weights:    denoise_scale_weights(denoise_input_dense_weights),
+			activation: activation_tanh,
+		},
+		vad_gru: &denoise_gru{
+			nb_inputs:         dense_size,
+			nb_neurons:        vad_size,
+			bias:              denoise_scale_weights(denoise_vad_gru_bias),
+			input_weights:     denoise_scale_weights(denoise_vad_gru_weights),
+			recurrent_weights: denoise_scale_weights(denoise_vad_gru_recurrent_weights),
+			activation:        activation_relu,
+		},
+		noise_gru: &denoise_gru{
+			nb_inputs:         dense_size + vad_size + F,
+			nb_neurons:        noise_size,
+			bias:              denoise_scale_weights(denoise_noise_gru_bias),
+			input_weights:     denoise_scale_weights(denoise_noise_gru_weights),
+			recurrent_weights: denoise_scale_weights(denoise_noise_gru_recurrent_weights),
+			activation:        activation_relu,
+		},
+		denoise_gru: &denoise_gru{
+			nb_inputs:         vad_size + noise_size + F,
+			nb_neurons:        denoise_size,
+			bias:              denoise_scale_weights(denoise_denoise_gru_bias),
+			input_weights:     denoise_scale_weights(denoise_denoise_gru_weights),
+			recurrent_weights: denoise_scale_weights(denoise_denoise_gru_recurrent_weights),
+			activation:        activation_relu,
+		},
+		denoise_output: &denoise_dense{
+			nb_inputs:  denoise_size,
+			nb_neurons: nb_bands,
+			bias:       denoise_scale_weights(denoise_denoise_output_bias),
+			weights:    denoise_scale_weights(denoise_denoise_output_weights),
+			activation: activation_sigmoid,
+		},
+		vad_output: &denoise_dense{
+			nb_inputs:  vad_size,
+			nb_neurons: 1,
+			bias:       denoise_scale_weights(denoise_vad_output_bias),
+			weights:    denoise_scale_weights(denoise_vad_output_weights),
+			activation: activation_sigmoid,
+		},
+	}
+}
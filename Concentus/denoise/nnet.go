@@ -0,0 +1,153 @@
+package denoise
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+/* Weight blobs and activations for the float inference of the network
+   (nnet.c). A blob, as written by the reference write_lpcnet_weights tool,
+   is a sequence of arrays, each behind a 64 byte header. */
+
+const weight_block_size = 64
+
+// The element types of a WeightArray.
+const (
+	WeightTypeFloat   = 0
+	WeightTypeInt     = 1
+	WeightTypeQWeight = 2
+	WeightTypeInt8    = 3
+)
+
+const (
+	activation_tanh = iota
+	activation_sigmoid
+	activation_relu
+)
+
+// WeightArray is one named array of a weight blob.
+type WeightArray struct {
+	Name string
+	Type int
+	Data []byte
+}
+
+// ParseWeights splits a weight blob into its named arrays.
+func ParseWeights(blob []byte) (map[string]*WeightArray, error) {
+	arrays := make(map[string]*WeightArray)
+	offset := 0
+	for len(blob) > 0 {
+		if len(blob) < weight_block_size || string(blob[0:4]) != "DNNw" {
+			return nil, fmt.Errorf("denoise: invalid weight blob at byte %d", offset)
+		}
+		typ := int(int32(binary.LittleEndian.Uint32(blob[8:])))
+		size := int(int32(binary.LittleEndian.Uint32(blob[12:])))
+		block_size := int(int32(binary.LittleEndian.Uint32(blob[16:])))
+		name := blob[20:weight_block_size]
+		if size < 0 || block_size < size || block_size > len(blob)-weight_block_size || name[len(name)-1] != 0 {
+			return nil, fmt.Errorf("denoise: invalid weight blob at byte %d", offset)
+		}
+		n := 0
+		for name[n] != 0 {
+			n++
+		}
+		arrays[string(name[:n])] = &WeightArray{
+			Name: string(name[:n]),
+			Type: typ,
+			Data: blob[weight_block_size : weight_block_size+size],
+		}
+		blob = blob[weight_block_size+block_size:]
+		offset += weight_block_size + block_size
+	}
+	return arrays, nil
+}
+
+func weight_floats(a *WeightArray) []float32 {
+	if a == nil || a.Type != WeightTypeFloat {
+		return nil
+	}
+	out := make([]float32, len(a.Data)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(a.Data[4*i:]))
+	}
+	return out
+}
+
+func weight_int8(a *WeightArray) []int8 {
+	if a == nil || (a.Type != WeightTypeInt8 && a.Type != WeightTypeQWeight) {
+		return nil
+	}
+	out := make([]int8, len(a.Data))
+	for i := range out {
+		out[i] = int8(a.Data[i])
+	}
+	return out
+}
+
+// tanh from a table with 0.04 steps and a first order correction, as in
+// RNNoise.
+func tansig_approx(x float32) float32 {
+	var y, dy float32
+	sign := float32(1)
+	if !(x < 8) {
+		return 1
+	}
+	if !(x > -8) {
+		return -1
+	}
+	if x < 0 {
+		x = -x
+		sign = -1
+	}
+	i := int(0.5 + 25*x)
+	x -= 0.04 * float32(i)
+	y = tansig_table[i]
+	dy = 1 - y*y
+	y = y + x*dy*(1-y*x)
+	return sign * y
+}
+
+var tansig_table = []float32{
+	0.000000, 0.039979, 0.079830, 0.119427, 0.158649,
+	0.197375, 0.235496, 0.272905, 0.309507, 0.345214,
+	0.379949, 0.413644, 0.446244, 0.477700, 0.507977,
+	0.537050, 0.564900, 0.591519, 0.616909, 0.641077,
+	0.664037, 0.685809, 0.706419, 0.725897, 0.744277,
+	0.761594, 0.777888, 0.793199, 0.807569, 0.821040,
+	0.833655, 0.845456, 0.856485, 0.866784, 0.876393,
+	0.885352, 0.893698, 0.901468, 0.908698, 0.915420,
+	0.921669, 0.927473, 0.932862, 0.937863, 0.942503,
+	0.946806, 0.950795, 0.954492, 0.957917, 0.961090,
+	0.964028, 0.966747, 0.969265, 0.971594, 0.973749,
+	0.975743, 0.977587, 0.979293, 0.980869, 0.982327,
+	0.983675, 0.984921, 0.986072, 0.987136, 0.988119,
+	0.989027, 0.989867, 0.990642, 0.991359, 0.992020,
+	0.992631, 0.993196, 0.993718, 0.994199, 0.994644,
+	0.995055, 0.995434, 0.995784, 0.996108, 0.996407,
+	0.996682, 0.996937, 0.997172, 0.997389, 0.997590,
+	0.997775, 0.997946, 0.998104, 0.998249, 0.998384,
+	0.998508, 0.998623, 0.998728, 0.998826, 0.998916,
+	0.999000, 0.999076, 0.999147, 0.999213, 0.999273,
+	0.999329, 0.999381, 0.999428, 0.999472, 0.999513,
+	0.999550, 0.999585, 0.999617, 0.999646, 0.999673,
+	0.999699, 0.999722, 0.999743, 0.999763, 0.999781,
+	0.999798, 0.999813, 0.999828, 0.999841, 0.999853,
+	0.999865, 0.999875, 0.999885, 0.999893, 0.999902,
+	0.999909, 0.999916, 0.999923, 0.999929, 0.999934,
+	0.999939, 0.999944, 0.999948, 0.999952, 0.999956,
+	0.999959, 0.999962, 0.999965, 0.999968, 0.999970,
+	0.999973, 0.999975, 0.999977, 0.999978, 0.999980,
+	0.999982, 0.999983, 0.999984, 0.999986, 0.999987,
+	0.999988, 0.999989, 0.999990, 0.999990, 0.999991,
+	0.999992, 0.999992, 0.999993, 0.999994, 0.999994,
+	0.999994, 0.999995, 0.999995, 0.999996, 0.999996,
+	0.999996, 0.999997, 0.999997, 0.999997, 0.999997,
+	0.999997, 0.999998, 0.999998, 0.999998, 0.999998,
+	0.999998, 0.999998, 0.999999, 0.999999, 0.999999,
+	0.999999, 0.999999, 0.999999, 0.999999, 0.999999,
+	0.999999, 0.999999, 0.999999, 0.999999, 0.999999,
+	1.000000, 1.000000, 1.000000, 1.000000, 1.000000,
+	1.000000, 1.000000, 1.000000, 1.000000, 1.000000,
+	1.000000,
+}
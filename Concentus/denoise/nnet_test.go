@@ -0,0 +1,66 @@
+package denoise
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+type testWeightBlob struct {
+	buf bytes.Buffer
+	rng *rand.Rand
+}
+
+func (b *testWeightBlob) array(name string, typ int, data []byte) {
+	var hdr [weight_block_size]byte
+	copy(hdr[:], "DNNw")
+	block := (len(data) + weight_block_size - 1) / weight_block_size * weight_block_size
+	binary.LittleEndian.PutUint32(hdr[8:], uint32(typ))
+	binary.LittleEndian.PutUint32(hdr[12:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(hdr[16:], uint32(block))
+	copy(hdr[20:], name)
+	b.buf.Write(hdr[:])
+	b.buf.Write(data)
+	b.buf.Write(make([]byte, block-len(data)))
+}
+
+func (b *testWeightBlob) floats(name string, n int, scale float64) {
+	data := make([]byte, 4*n)
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(float32(scale*(2*b.rng.Float64()-1))))
+	}
+	b.array(name, WeightTypeFloat, data)
+}
+
+func TestParseWeights(t *testing.T) {
+	b := &testWeightBlob{rng: rand.New(rand.NewSource(1))}
+	b.floats("first", 3, 1)
+	b.array("second", WeightTypeInt8, []byte{1, 0xFF, 2, 0x80, 3})
+	arrays, err := ParseWeights(b.buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arrays) != 2 || len(weight_floats(arrays["first"])) != 3 {
+		t.Fatalf("got %d arrays", len(arrays))
+	}
+	for i, want := range []int8{1, -1, 2, -128, 3} {
+		if got := weight_int8(arrays["second"]); got[i] != want {
+			t.Fatalf("int8 array %v", got)
+		}
+	}
+	if weight_floats(arrays["second"]) != nil {
+		t.Fatal("int8 array read as floats")
+	}
+
+	/* A block size past the end of the blob */
+	blob := append([]byte(nil), b.buf.Bytes()...)
+	binary.LittleEndian.PutUint32(blob[16:], uint32(len(blob)))
+	if _, err := ParseWeights(blob); err == nil {
+		t.Fatal("overlong array accepted")
+	}
+	if _, err := ParseWeights([]byte("DNNw")); err == nil {
+		t.Fatal("truncated blob accepted")
+	}
+}
@@ -0,0 +1,312 @@
+package denoise
+
+import "math"
+
+/* The float pitch analysis of RNNoise (pitch.c, from the float build of
+   CELT): a 2x decimated, whitened copy of the input is searched for the
+   best period, which remove_doubling then checks against its submultiples. */
+
+func celt_inner_prod(x []float32, y []float32, N int) float32 {
+	sum := float32(0)
+	for i := 0; i < N; i++ {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+func celt_pitch_xcorr(x []float32, y []float32, xcorr []float32, N int, max_pitch int) {
+	for i := 0; i < max_pitch; i++ {
+		xcorr[i] = celt_inner_prod(x, y[i:], N)
+	}
+}
+
+func celt_autocorr(x []float32, ac []float32, lag int, n int) {
+	for k := 0; k <= lag; k++ {
+		d := float32(0)
+		for i := k; i < n; i++ {
+			d += x[i] * x[i-k]
+		}
+		ac[k] = d
+	}
+}
+
+func celt_lpc(lpc []float32, ac []float32, p int) {
+	err := ac[0]
+	for i := range lpc[:p] {
+		lpc[i] = 0
+	}
+	if ac[0] == 0 {
+		return
+	}
+	for i := 0; i < p; i++ {
+		/* Sum up this iteration's reflection coefficient */
+		rr := float32(0)
+		for j := 0; j < i; j++ {
+			rr += lpc[j] * ac[i-j]
+		}
+		rr += ac[i+1]
+		r := -rr / err
+		/* Update LPC coefficients and total error */
+		lpc[i] = r
+		for j := 0; j < (i+1)>>1; j++ {
+			tmp1 := lpc[j]
+			tmp2 := lpc[i-1-j]
+			lpc[j] = tmp1 + r*tmp2
+			lpc[i-1-j] = tmp2 + r*tmp1
+		}
+		err = err - r*r*err
+		/* Bail out once we get 30 dB gain */
+		if err < .001*ac[0] {
+			break
+		}
+	}
+}
+
+func celt_fir5(x []float32, num *[5]float32, y []float32, N int, mem *[5]float32) {
+	mem0, mem1, mem2, mem3, mem4 := mem[0], mem[1], mem[2], mem[3], mem[4]
+	for i := 0; i < N; i++ {
+		sum := x[i]
+		sum += num[0] * mem0
+		sum += num[1] * mem1
+		sum += num[2] * mem2
+		sum += num[3] * mem3
+		sum += num[4] * mem4
+		mem4 = mem3
+		mem3 = mem2
+		mem2 = mem1
+		mem1 = mem0
+		mem0 = x[i]
+		y[i] = sum
+	}
+	*mem = [5]float32{mem0, mem1, mem2, mem3, mem4}
+}
+
+// Decimates x by two into x_lp and whitens it with a fourth order LPC
+// filter plus a zero.
+func pitch_downsample(x []float32, x_lp []float32) {
+	var ac [5]float32
+	var lpc [4]float32
+	var lpc2, mem [5]float32
+	const c1 = .8
+	half := len(x) >> 1
+	for i := 1; i < half; i++ {
+		x_lp[i] = .5 * (.5*(x[2*i-1]+x[2*i+1]) + x[2*i])
+	}
+	x_lp[0] = .5 * (.5*x[1] + x[0])
+
+	celt_autocorr(x_lp, ac[:], 4, half)
+	/* Noise floor -40 dB */
+	ac[0] *= 1.0001
+	/* Lag windowing */
+	for i := 1; i <= 4; i++ {
+		ac[i] -= ac[i] * (.008 * float32(i)) * (.008 * float32(i))
+	}
+	celt_lpc(lpc[:], ac[:], 4)
+	tmp := float32(1)
+	for i := 0; i < 4; i++ {
+		tmp = .9 * tmp
+		lpc[i] = lpc[i] * tmp
+	}
+	/* Add a zero */
+	lpc2[0] = lpc[0] + .8
+	lpc2[1] = lpc[1] + c1*lpc[0]
+	lpc2[2] = lpc[2] + c1*lpc[1]
+	lpc2[3] = lpc[3] + c1*lpc[2]
+	lpc2[4] = c1 * lpc[3]
+	celt_fir5(x_lp, &lpc2, x_lp, half, &mem)
+}
+
+func find_best_pitch(xcorr []float32, y []float32, N int, max_pitch int, best_pitch *[2]int) {
+	Syy := float32(1)
+	best_num := [2]float32{-1, -1}
+	best_den := [2]float32{0, 0}
+	best_pitch[0] = 0
+	best_pitch[1] = 1
+	for j := 0; j < N; j++ {
+		Syy += y[j] * y[j]
+	}
+	for i := 0; i < max_pitch; i++ {
+		if xcorr[i] > 0 {
+			/* Considering the range of xcorr16, this should avoid both
+			   underflows and overflows (inf) when squaring xcorr16 */
+			xcorr16 := xcorr[i] * 1e-12
+			num := xcorr16 * xcorr16
+			if num*best_den[1] > best_num[1]*Syy {
+				if num*best_den[0] > best_num[0]*Syy {
+					best_num[1] = best_num[0]
+					best_den[1] = best_den[0]
+					best_pitch[1] = best_pitch[0]
+					best_num[0] = num
+					best_den[0] = Syy
+					best_pitch[0] = i
+				} else {
+					best_num[1] = num
+					best_den[1] = Syy
+					best_pitch[1] = i
+				}
+			}
+		}
+		Syy += y[i+N]*y[i+N] - y[i]*y[i]
+		Syy = max(1, Syy)
+	}
+}
+
+// Returns the lag in [0, max_pitch) of y best matching x_lp over N samples,
+// both at the rate of pitch_downsample.
+func pitch_search(x_lp []float32, y []float32, N int, max_pitch int) int {
+	lag := N + max_pitch
+	x_lp4 := make([]float32, N>>2)
+	y_lp4 := make([]float32, lag>>2)
+	xcorr := make([]float32, max_pitch>>1)
+	var best_pitch [2]int
+
+	/* Downsample by 2 again */
+	for j := 0; j < N>>2; j++ {
+		x_lp4[j] = x_lp[2*j]
+	}
+	for j := 0; j < lag>>2; j++ {
+		y_lp4[j] = y[2*j]
+	}
+
+	/* Coarse search with 4x decimation */
+	celt_pitch_xcorr(x_lp4, y_lp4, xcorr, N>>2, max_pitch>>2)
+	find_best_pitch(xcorr, y_lp4, N>>2, max_pitch>>2, &best_pitch)
+
+	/* Finer search with 2x decimation */
+	for i := 0; i < max_pitch>>1; i++ {
+		xcorr[i] = 0
+		if abs(i-2*best_pitch[0]) > 2 && abs(i-2*best_pitch[1]) > 2 {
+			continue
+		}
+		xcorr[i] = max(-1, celt_inner_prod(x_lp, y[i:], N>>1))
+	}
+	find_best_pitch(xcorr, y, N>>1, max_pitch>>1, &best_pitch)
+
+	/* Refine by pseudo-interpolation */
+	offset := 0
+	if best_pitch[0] > 0 && best_pitch[0] < (max_pitch>>1)-1 {
+		a := xcorr[best_pitch[0]-1]
+		b := xcorr[best_pitch[0]]
+		c := xcorr[best_pitch[0]+1]
+		if c-a > .7*(b-a) {
+			offset = 1
+		} else if a-c > .7*(b-c) {
+			offset = -1
+		}
+	}
+	return 2*best_pitch[0] - offset
+}
+
+func compute_pitch_gain(xy float32, xx float32, yy float32) float32 {
+	return xy / float32(math.Sqrt(float64(1+xx*yy)))
+}
+
+var second_check = [16]int{0, 0, 3, 2, 3, 2, 5, 2, 3, 2, 3, 2, 5, 2, 3, 2}
+
+// Checks the period *T0_ found by pitch_search in x, which holds maxperiod
+// samples of history before the N of the frame, against its submultiples,
+// and returns the pitch gain of the period it settles on.
+func remove_doubling(x []float32, maxperiod int, minperiod int, N int, T0_ *int, prev_period int, prev_gain float32) float32 {
+	minperiod0 := minperiod
+	maxperiod /= 2
+	minperiod /= 2
+	*T0_ /= 2
+	prev_period /= 2
+	N /= 2
+	/* x[base+i] is sample i of the frame, x[base-T:] the frame T back */
+	base := maxperiod
+	if *T0_ >= maxperiod {
+		*T0_ = maxperiod - 1
+	}
+	T := *T0_
+	T0 := T
+	yy_lookup := make([]float32, maxperiod+1)
+	xx := celt_inner_prod(x[base:], x[base:], N)
+	xy := celt_inner_prod(x[base:], x[base-T0:], N)
+	yy_lookup[0] = xx
+	yy := xx
+	for i := 1; i <= maxperiod; i++ {
+		yy = yy + x[base-i]*x[base-i] - x[base+N-i]*x[base+N-i]
+		yy_lookup[i] = max(0, yy)
+	}
+	yy = yy_lookup[T0]
+	best_xy := xy
+	best_yy := yy
+	g0 := compute_pitch_gain(xy, xx, yy)
+	g := g0
+	/* Look for any pitch at T/k */
+	for k := 2; k <= 15; k++ {
+		T1 := (2*T0 + k) / (2 * k)
+		if T1 < minperiod {
+			break
+		}
+		/* Look for another strong correlation at T1b */
+		var T1b int
+		if k == 2 {
+			if T1+T0 > maxperiod {
+				T1b = T0
+			} else {
+				T1b = T0 + T1
+			}
+		} else {
+			T1b = (2*second_check[k]*T0 + k) / (2 * k)
+		}
+		xy = celt_inner_prod(x[base:], x[base-T1:], N)
+		xy2 := celt_inner_prod(x[base:], x[base-T1b:], N)
+		xy = .5 * (xy + xy2)
+		yy = .5 * (yy_lookup[T1] + yy_lookup[T1b])
+		g1 := compute_pitch_gain(xy, xx, yy)
+		var cont float32
+		if abs(T1-prev_period) <= 1 {
+			cont = prev_gain
+		} else if abs(T1-prev_period) <= 2 && 5*k*k < T0 {
+			cont = .5 * prev_gain
+		}
+		thresh := max(.3, .7*g0-cont)
+		/* Bias against very high pitch (very short period) to avoid
+		   false-positives due to short-term correlation */
+		if T1 < 3*minperiod {
+			thresh = max(.4, .85*g0-cont)
+		} else if T1 < 2*minperiod {
+			thresh = max(.5, .9*g0-cont)
+		}
+		if g1 > thresh {
+			best_xy = xy
+			best_yy = yy
+			T = T1
+			g = g1
+		}
+	}
+	best_xy = max(0, best_xy)
+	var pg float32
+	if best_yy <= best_xy {
+		pg = 1
+	} else {
+		pg = best_xy / (best_yy + 1)
+	}
+	var xcorr [3]float32
+	for k := 0; k < 3; k++ {
+		xcorr[k] = celt_inner_prod(x[base:], x[base-(T+k-1):], N)
+	}
+	offset := 0
+	if xcorr[2]-xcorr[0] > .7*(xcorr[1]-xcorr[0]) {
+		offset = 1
+	} else if xcorr[0]-xcorr[2] > .7*(xcorr[1]-xcorr[2]) {
+		offset = -1
+	}
+	if pg > g {
+		pg = g
+	}
+	*T0_ = 2*T + offset
+	if *T0_ < minperiod0 {
+		*T0_ = minperiod0
+	}
+	return pg
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,74 @@
+package denoise
+
+import "errors"
+
+// Preprocessor runs a Suppressor on each channel of interleaved 48 kHz
+// input. It implements opus.Preprocessor, so that an encoder can denoise
+// its input; frames must then be a multiple of 10 ms, and the lookahead
+// grows by 10 ms.
+type Preprocessor struct {
+	model       *Model
+	suppressors []*Suppressor
+	frame       [FrameSize]int16
+}
+
+// NewPreprocessor creates a Preprocessor for input at Fs, which must be
+// 48 kHz, running model on every channel, or the embedded model when model
+// is nil.
+func NewPreprocessor(model *Model, Fs int, channels int) (*Preprocessor, error) {
+	if Fs != 48000 {
+		return nil, errors.New("denoise: noise suppression runs at 48 kHz")
+	}
+	if channels < 1 {
+		return nil, errors.New("denoise: invalid channel count")
+	}
+	if model == nil {
+		model = DefaultModel()
+	}
+	p := &Preprocessor{model: model}
+	for c := 0; c < channels; c++ {
+		p.suppressors = append(p.suppressors, NewSuppressor(model))
+	}
+	return p, nil
+}
+
+func (p *Preprocessor) GetModel() *Model {
+	return p.model
+}
+
+func (p *Preprocessor) SampleRate() int {
+	return 48000
+}
+
+func (p *Preprocessor) Channels() int {
+	return len(p.suppressors)
+}
+
+func (p *Preprocessor) FrameSize() int {
+	return FrameSize
+}
+
+func (p *Preprocessor) Delay() int {
+	return FrameSize
+}
+
+// Process denoises FrameSize samples per channel of interleaved input in
+// place.
+func (p *Preprocessor) Process(pcm []int16) {
+	C := len(p.suppressors)
+	for c, ns := range p.suppressors {
+		for i := 0; i < FrameSize; i++ {
+			p.frame[i] = pcm[i*C+c]
+		}
+		ns.ProcessFrame(p.frame[:], 0, p.frame[:], 0)
+		for i := 0; i < FrameSize; i++ {
+			pcm[i*C+c] = p.frame[i]
+		}
+	}
+}
+
+func (p *Preprocessor) Reset() {
+	for _, ns := range p.suppressors {
+		ns.Reset()
+	}
+}
@@ -0,0 +1,79 @@
+// Package fft is a mixed radix complex FFT for the sizes the analysis
+// packages use, which only have 2, 3 and 5 as factors. It is decimation in
+// time as in KissFFT, and like the CELT FFT it scales its output by 1/N.
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// State holds the factors and twiddles of one transform size.
+type State struct {
+	n       int
+	factors []int
+	twiddle []complex128
+}
+
+// New creates the transform of size n, which must only have 2, 3 and 5 as
+// factors.
+func New(n int) *State {
+	st := &State{n: n, twiddle: make([]complex128, n)}
+	for i := range st.twiddle {
+		st.twiddle[i] = cmplx.Rect(1, -2*math.Pi*float64(i)/float64(n))
+	}
+	m := n
+	for _, p := range []int{2, 3, 5} {
+		for m%p == 0 {
+			st.factors = append(st.factors, p)
+			m /= p
+		}
+	}
+	if n < 1 || m != 1 {
+		panic("fft: unsupported size")
+	}
+	return st
+}
+
+// Size returns the size of the transform.
+func (st *State) Size() int {
+	return st.n
+}
+
+func (st *State) work(out []complex128, in []complex128, stride int, n int, stage int) {
+	if stage == len(st.factors) {
+		out[0] = in[0]
+		return
+	}
+	p := st.factors[stage]
+	m := n / p
+	for k := 0; k < p; k++ {
+		st.work(out[k*m:], in[k*stride:], stride*p, m, stage+1)
+	}
+	/* Generic butterfly: out[q*m+j] is the sum over k of the k-th sub
+	   transform at j, rotated by W_n^(k*(q*m+j)) */
+	fstride := st.n / n
+	var t [5]complex128
+	for j := 0; j < m; j++ {
+		for k := 0; k < p; k++ {
+			t[k] = out[k*m+j]
+		}
+		for q := 0; q < p; q++ {
+			var sum complex128
+			for k := 0; k < p; k++ {
+				sum += t[k] * st.twiddle[(k*(q*m+j)*fstride)%st.n]
+			}
+			out[q*m+j] = sum
+		}
+	}
+}
+
+// Forward computes the forward transform of in into out, scaled by 1/N.
+// in and out must not overlap.
+func (st *State) Forward(in []complex128, out []complex128) {
+	st.work(out, in, 1, st.n, 0)
+	scale := complex(1/float64(st.n), 0)
+	for i := range out[:st.n] {
+		out[i] *= scale
+	}
+}
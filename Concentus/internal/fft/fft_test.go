@@ -0,0 +1,33 @@
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestForwardMatchesDFT(t *testing.T) {
+	for _, n := range []int{1, 2, 5, 60, 120, 240, 480, 960} {
+		st := New(n)
+		in := make([]complex128, n)
+		seed := uint32(3)
+		for i := range in {
+			seed = seed*1664525 + 1013904223
+			re := float64(int32(seed) >> 16)
+			seed = seed*1664525 + 1013904223
+			in[i] = complex(re, float64(int32(seed)>>16))
+		}
+		out := make([]complex128, n)
+		st.Forward(in, out)
+		for k := 0; k < n; k++ {
+			var want complex128
+			for j := 0; j < n; j++ {
+				want += in[j] * cmplx.Rect(1, -2*math.Pi*float64(k*j%n)/float64(n))
+			}
+			want /= complex(float64(n), 0)
+			if cmplx.Abs(out[k]-want) > 1e-6*math.Max(1, cmplx.Abs(want)) {
+				t.Fatalf("size %d bin %d: got %v, want %v", n, k, out[k], want)
+			}
+		}
+	}
+}
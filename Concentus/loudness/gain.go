@@ -0,0 +1,41 @@
+package loudness
+
+import (
+	"concentus/opus"
+	"errors"
+	"math"
+)
+
+// ReferenceLoudness is the EBU R128 target the R128 comments normalise to.
+const ReferenceLoudness = -23.0
+
+// Converts dB to Q8, the unit of the output gain and the R128 comments.
+func q8(dB float64) int {
+	return max(-32768, min(32767, int(math.Round(dB*256))))
+}
+
+// Normalize sets the output gain of head so that a track whose integrated
+// loudness is track plays at target, for instance -16 LUFS for podcasts. It
+// also sets R128_TRACK_GAIN in tags, so that players that honour it play the
+// track at the R128 reference instead. Any R128_ALBUM_GAIN is removed, since
+// it would be relative to the old output gain.
+func Normalize(head *opus.OpusHead, tags *opus.OpusTags, track float64, target float64) error {
+	if math.IsInf(track, 0) || math.IsNaN(track) {
+		return errors.New("loudness: no loudness measured")
+	}
+	head.OutputGain = q8(target - track)
+	tags.SetR128TrackGain(q8(ReferenceLoudness - target))
+	tags.Remove("R128_ALBUM_GAIN")
+	return nil
+}
+
+// SetAlbumGain sets R128_ALBUM_GAIN in tags for a track of an album whose
+// integrated loudness, over all its tracks, is album. It accounts for the
+// output gain already in head, so it is called after Normalize.
+func SetAlbumGain(head *opus.OpusHead, tags *opus.OpusTags, album float64) error {
+	if math.IsInf(album, 0) || math.IsNaN(album) {
+		return errors.New("loudness: no loudness measured")
+	}
+	tags.SetR128AlbumGain(q8(ReferenceLoudness - (album + float64(head.OutputGain)/256)))
+	return nil
+}
@@ -0,0 +1,219 @@
+package loudness
+
+import (
+	"bytes"
+	"concentus/ogg"
+	"concentus/opus"
+	"io"
+	"math"
+	"testing"
+)
+
+// A sine at the given level in dBFS, the same on every channel.
+func sine(freq float64, dBFS float64, phase float64, seconds float64, channels int) []float32 {
+	n := int(seconds * 48000)
+	amp := math.Pow(10, dBFS/20)
+	out := make([]float32, n*channels)
+	for i := 0; i < n; i++ {
+		x := float32(amp * math.Sin(2*math.Pi*freq*float64(i)/48000+phase))
+		for c := 0; c < channels; c++ {
+			out[i*channels+c] = x
+		}
+	}
+	return out
+}
+
+func near(t *testing.T, what string, got float64, want float64, tolerance float64) {
+	t.Helper()
+	if math.Abs(got-want) > tolerance {
+		t.Errorf("%s %.2f, want %.2f", what, got, want)
+	}
+}
+
+func TestMeterSine(t *testing.T) {
+	/* The reference of BS.1770: a 1 kHz sine at -23 dBFS on both channels of
+	   a stereo signal measures -23 LUFS, and a mono one 3 dB less */
+	m, _ := NewMeter(48000, 2)
+	m.WriteFloat(sine(1000, -23, 0, 10, 2))
+	near(t, "integrated", m.Integrated(), -23, 0.1)
+	near(t, "momentary", m.Momentary(), -23, 0.1)
+	near(t, "short-term", m.ShortTerm(), -23, 0.1)
+
+	m, _ = NewMeter(48000, 1)
+	m.WriteFloat(sine(1000, -23, 0, 10, 1))
+	near(t, "mono integrated", m.Integrated(), -26, 0.1)
+
+	m, _ = NewMeter(48000, 1)
+	m.WriteFloat(sine(1000, -23, 0, 0.3, 1))
+	if !math.IsInf(m.Momentary(), -1) || !math.IsInf(m.Integrated(), -1) {
+		t.Errorf("loudness measured over 300 ms")
+	}
+}
+
+func TestMeterGating(t *testing.T) {
+	/* Silence and quiet passages do not lower the integrated loudness */
+	m, _ := NewMeter(48000, 2)
+	m.WriteFloat(sine(1000, -23, 0, 10, 2))
+	m.WriteFloat(make([]float32, 20*48000*2))
+	m.WriteFloat(sine(1000, -43, 0, 10, 2))
+	near(t, "integrated", m.Integrated(), -23, 0.1)
+	near(t, "momentary", m.Momentary(), -43, 0.1)
+}
+
+func TestMeterChannelWeights(t *testing.T) {
+	/* The same sine on the front or the rear pair of a quadraphonic signal,
+	   and on the LFE of a 5.1 one */
+	for _, tc := range []struct {
+		name     string
+		channels int
+		active   []int
+		want     float64
+	}{
+		{"quad front", 4, []int{0, 1}, -23},
+		{"quad rear", 4, []int{2, 3}, -23 + 10*math.Log10(1.41)},
+		{"5.1 surround", 6, []int{3, 4}, -23 + 10*math.Log10(1.41)},
+		{"5.1 LFE", 6, []int{5}, math.Inf(-1)},
+	} {
+		mono := sine(1000, -23, 0, 10, 1)
+		pcm := make([]float32, len(mono)*tc.channels)
+		for i, x := range mono {
+			for _, c := range tc.active {
+				pcm[i*tc.channels+c] = x
+			}
+		}
+		m, _ := NewMeter(48000, tc.channels)
+		m.WriteFloat(pcm)
+		if math.IsInf(tc.want, -1) {
+			if !math.IsInf(m.Integrated(), -1) {
+				t.Errorf("%s: integrated %.2f, want silence", tc.name, m.Integrated())
+			}
+			continue
+		}
+		near(t, tc.name+" integrated", m.Integrated(), tc.want, 0.1)
+	}
+}
+
+func TestMeterTruePeak(t *testing.T) {
+	/* A 12 kHz sine sampled at 48 kHz, 45 degrees off its peaks, has its
+	   samples 3 dB below its true peak */
+	m, _ := NewMeter(48000, 1)
+	m.WriteFloat(sine(12000, -6, math.Pi/4, 1, 1))
+	near(t, "sample peak", m.SamplePeak(), -9, 0.1)
+	near(t, "true peak", m.TruePeak(), -6, 0.5)
+}
+
+func encode(t *testing.T, pcm []int16, gain float64) (*opus.OpusHead, [][]byte) {
+	enc, err := opus.NewOpusEncoder(48000, 1, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(opus.MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(32000)
+	frame := make([]int16, 960)
+	buf := make([]byte, 1275)
+	var packets [][]byte
+	for i := 0; i+960 <= len(pcm); i += 960 {
+		for j := range frame {
+			frame[j] = int16(float64(pcm[i+j]) * gain)
+		}
+		n, err := enc.Encode(frame, 0, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:n]...))
+	}
+	return enc.GetOpusHead(), packets
+}
+
+func TestNormalize(t *testing.T) {
+	/* Speech-band programme at -30 LUFS normalised to -16 for a podcast */
+	pcm := make([]int16, 10*48000)
+	for i := range pcm {
+		n := float64(i)
+		env := 0.6 + 0.4*math.Sin(2*math.Pi*3*n/48000)
+		pcm[i] = int16(1000 * env * (math.Sin(2*math.Pi*300*n/48000) + 0.5*math.Sin(2*math.Pi*1200*n/48000)))
+	}
+	head, packets := encode(t, pcm, 1)
+	measured, err := MeasurePackets(head, packets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := opus.NewOpusTags()
+	if err := Normalize(head, tags, measured.Integrated(), -16); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetAlbumGain(head, tags, -16-float64(head.OutputGain)/256); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := tags.GetR128TrackGain(); g != -7*256 {
+		t.Errorf("R128_TRACK_GAIN %d", g)
+	}
+	if g, _ := tags.GetR128AlbumGain(); g != -7*256 {
+		t.Errorf("R128_ALBUM_GAIN %d", g)
+	}
+
+	/* The output gain alone plays at -16, the track gain at -23 */
+	normalised, _ := MeasurePackets(head, packets)
+	near(t, "normalised", normalised.Integrated(), -16, 0.5)
+
+	var file bytes.Buffer
+	w, err := ogg.NewWriterWithTags(&file, head, tags, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range packets {
+		w.WritePacket(p)
+	}
+	w.Close()
+	for _, tc := range []struct {
+		mode ogg.GainMode
+		want float64
+	}{{ogg.HeaderGain, -16}, {ogg.TrackGain, -23}, {ogg.AlbumGain, -23}} {
+		r, err := ogg.NewReader(bytes.NewReader(file.Bytes()), 48000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := r.SetGainMode(tc.mode); err != nil {
+			t.Fatal(err)
+		}
+		m, _ := NewMeter(48000, 1)
+		out := make([]int16, 4800)
+		for {
+			n, err := r.Read(out)
+			m.Write(out[:n])
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+		}
+		near(t, "decoded", m.Integrated(), tc.want, 0.5)
+	}
+}
+
+func TestOpusTags(t *testing.T) {
+	tags := opus.NewOpusTags()
+	tags.Set("TITLE", "Episode 1")
+	tags.SetR128TrackGain(-1792)
+	tags.Comments = append(tags.Comments, "r128_album_gain=+512")
+	parsed, err := opus.ParseOpusTags(tags.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Vendor != tags.Vendor || len(parsed.Comments) != 3 {
+		t.Fatalf("parsed %+v", parsed)
+	}
+	if v, _ := parsed.Get("title"); v != "Episode 1" {
+		t.Errorf("TITLE %q", v)
+	}
+	if g, ok := parsed.GetR128TrackGain(); !ok || g != -1792 {
+		t.Errorf("R128_TRACK_GAIN %d", g)
+	}
+	if g, ok := parsed.GetR128AlbumGain(); !ok || g != 512 {
+		t.Errorf("R128_ALBUM_GAIN %d", g)
+	}
+	if _, err := opus.ParseOpusTags(tags.Marshal()[:20]); err == nil {
+		t.Errorf("truncated tags parsed")
+	}
+}
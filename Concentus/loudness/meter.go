@@ -0,0 +1,254 @@
+// Package loudness measures loudness as specified by ITU-R BS.1770-4 and
+// EBU R128, and sets the output gain and R128 comments of Opus streams from
+// the measurement.
+//
+// Loudness is in LUFS, true peak in dBTP. Measurements that need more audio
+// than was given return negative infinity.
+package loudness
+
+import (
+	"concentus/opus"
+	"errors"
+	"math"
+)
+
+const (
+	subBlock       = 10 // per second: integration runs in 100 ms steps
+	momentaryBlock = 4  // sub-blocks in 400 ms
+	shortTermBlock = 30 // sub-blocks in 3 s
+
+	absoluteGate = -70.0 // LUFS
+	relativeGate = -10.0 // LU below the absolutely gated loudness
+)
+
+// A biquad in transposed direct form II.
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+	z1, z2             float64
+}
+
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.z1
+	f.z1 = f.b1*x - f.a1*y + f.z2
+	f.z2 = f.b2*x - f.a2*y
+	return y
+}
+
+// The K-weighting filter of BS.1770: a high shelf modelling the head,
+// then the RLB high pass. The coefficients are derived for any sample rate
+// from the analog prototypes, matching the published ones at 48 kHz.
+func kWeighting(Fs int) [2]biquad {
+	f0, G, Q := 1681.974450955533, 3.999843853973347, 0.7071752369554196
+	K := math.Tan(math.Pi * f0 / float64(Fs))
+	Vh := math.Pow(10, G/20)
+	Vb := math.Pow(Vh, 0.4996667741545416)
+	a0 := 1 + K/Q + K*K
+	shelf := biquad{
+		b0: (Vh + Vb*K/Q + K*K) / a0,
+		b1: 2 * (K*K - Vh) / a0,
+		b2: (Vh - Vb*K/Q + K*K) / a0,
+		a1: 2 * (K*K - 1) / a0,
+		a2: (1 - K/Q + K*K) / a0,
+	}
+	f0, Q = 38.13547087602444, 0.5003270373238773
+	K = math.Tan(math.Pi * f0 / float64(Fs))
+	a0 = 1 + K/Q + K*K
+	highPass := biquad{
+		b0: 1, b1: -2, b2: 1,
+		a1: 2 * (K*K - 1) / a0,
+		a2: (1 - K/Q + K*K) / a0,
+	}
+	return [2]biquad{shelf, highPass}
+}
+
+// Channel weights for the Vorbis channel order used by Opus mapping family
+// 1: surround channels count 1.41 (+1.5 dB) and the LFE not at all.
+func channelWeights(channels int) []float64 {
+	w := make([]float64, channels)
+	for c := range w {
+		w[c] = 1
+	}
+	switch channels {
+	case 4: /* L R RL RR */
+		w[2], w[3] = 1.41, 1.41
+	case 5: /* L C R SL SR */
+		w[3], w[4] = 1.41, 1.41
+	case 6: /* L C R SL SR LFE */
+		w[3], w[4], w[5] = 1.41, 1.41, 0
+	case 7: /* L C R SL SR RC LFE */
+		w[3], w[4], w[5], w[6] = 1.41, 1.41, 1.41, 0
+	case 8: /* L C R SL SR RL RR LFE */
+		w[3], w[4], w[5], w[6], w[7] = 1.41, 1.41, 1.41, 1.41, 0
+	}
+	return w
+}
+
+// Meter measures the loudness of interleaved PCM.
+type Meter struct {
+	fs       int
+	channels int
+	weights  []float64
+	filters  [][2]biquad
+
+	/* The weighted mean square of the current sub-block, and of the last
+	   ones, most recent last */
+	sum       float64
+	count     int
+	subBlocks []float64
+	blocks    []float64 // mean square of every 400 ms gating block
+
+	peak     *truePeak
+	samplePk float64
+}
+
+// NewMeter creates a meter for the given sample rate and channel count.
+func NewMeter(Fs int, channels int) (*Meter, error) {
+	if Fs < 8000 || Fs%subBlock != 0 || channels < 1 || channels > 255 {
+		return nil, errors.New("loudness: unsupported format")
+	}
+	m := &Meter{
+		fs:       Fs,
+		channels: channels,
+		weights:  channelWeights(channels),
+		filters:  make([][2]biquad, channels),
+		peak:     newTruePeak(Fs, channels),
+	}
+	for c := range m.filters {
+		m.filters[c] = kWeighting(Fs)
+	}
+	return m, nil
+}
+
+// Write measures 16-bit samples.
+func (m *Meter) Write(pcm []int16) {
+	frame := make([]float64, m.channels)
+	for i := 0; i+m.channels <= len(pcm); i += m.channels {
+		for c := range frame {
+			frame[c] = float64(pcm[i+c]) / 32768
+		}
+		m.writeFrame(frame)
+	}
+}
+
+// WriteFloat measures float samples, full scale at 1.
+func (m *Meter) WriteFloat(pcm []float32) {
+	frame := make([]float64, m.channels)
+	for i := 0; i+m.channels <= len(pcm); i += m.channels {
+		for c := range frame {
+			frame[c] = float64(pcm[i+c])
+		}
+		m.writeFrame(frame)
+	}
+}
+
+func (m *Meter) writeFrame(frame []float64) {
+	m.peak.process(frame)
+	for c, x := range frame {
+		m.samplePk = math.Max(m.samplePk, math.Abs(x))
+		if m.weights[c] == 0 {
+			continue
+		}
+		y := m.filters[c][1].process(m.filters[c][0].process(x))
+		m.sum += m.weights[c] * y * y
+	}
+	m.count++
+	if m.count < m.fs/subBlock {
+		return
+	}
+	m.subBlocks = append(m.subBlocks, m.sum/float64(m.count))
+	if len(m.subBlocks) > shortTermBlock {
+		m.subBlocks = m.subBlocks[1:]
+	}
+	m.sum, m.count = 0, 0
+	if n := len(m.subBlocks); n >= momentaryBlock {
+		m.blocks = append(m.blocks, mean(m.subBlocks[n-momentaryBlock:]))
+	}
+}
+
+func mean(x []float64) float64 {
+	s := 0.0
+	for _, v := range x {
+		s += v
+	}
+	return s / float64(len(x))
+}
+
+func lufs(meanSquare float64) float64 {
+	if meanSquare <= 0 {
+		return math.Inf(-1)
+	}
+	return -0.691 + 10*math.Log10(meanSquare)
+}
+
+// Momentary returns the loudness of the last 400 ms.
+func (m *Meter) Momentary() float64 {
+	if len(m.subBlocks) < momentaryBlock {
+		return math.Inf(-1)
+	}
+	return lufs(mean(m.subBlocks[len(m.subBlocks)-momentaryBlock:]))
+}
+
+// ShortTerm returns the loudness of the last 3 s.
+func (m *Meter) ShortTerm() float64 {
+	if len(m.subBlocks) < shortTermBlock {
+		return math.Inf(-1)
+	}
+	return lufs(mean(m.subBlocks))
+}
+
+// Integrated returns the gated loudness of everything measured so far.
+func (m *Meter) Integrated() float64 {
+	gated := func(threshold float64) float64 {
+		sum, n := 0.0, 0
+		for _, b := range m.blocks {
+			if lufs(b) > threshold {
+				sum += b
+				n++
+			}
+		}
+		if n == 0 {
+			return 0
+		}
+		return sum / float64(n)
+	}
+	absolute := gated(absoluteGate)
+	if absolute == 0 {
+		return math.Inf(-1)
+	}
+	return lufs(gated(lufs(absolute) + relativeGate))
+}
+
+// TruePeak returns the highest inter-sample peak over all channels.
+func (m *Meter) TruePeak() float64 {
+	return 20 * math.Log10(m.peak.max)
+}
+
+// SamplePeak returns the highest sample over all channels, in dBFS.
+func (m *Meter) SamplePeak() float64 {
+	return 20 * math.Log10(m.samplePk)
+}
+
+// MeasurePackets decodes an Opus stream described by head, without its
+// pre-skip and at its output gain, and returns a meter over the result.
+func MeasurePackets(head *opus.OpusHead, packets [][]byte) (*Meter, error) {
+	dec, err := head.CreateMSDecoder(48000)
+	if err != nil {
+		return nil, err
+	}
+	m, err := NewMeter(48000, head.Channels)
+	if err != nil {
+		return nil, err
+	}
+	pcm := make([]int16, 5760*head.Channels)
+	skip := head.PreSkip
+	for _, p := range packets {
+		n, err := dec.DecodeMultistream(p, 0, len(p), pcm, 0, 5760, false)
+		if err != nil {
+			return nil, err
+		}
+		drop := min(skip, n)
+		skip -= drop
+		m.Write(pcm[drop*head.Channels : n*head.Channels])
+	}
+	return m, nil
+}
@@ -0,0 +1,65 @@
+package loudness
+
+import (
+	"math"
+)
+
+const truePeakTaps = 12 // per phase
+
+// Estimates inter-sample peaks by oversampling to at least 192 kHz with a
+// polyphase windowed-sinc interpolator, as BS.1770 Annex 2 describes.
+type truePeak struct {
+	factor  int
+	phases  [][]float64
+	history [][]float64 // per channel, most recent first
+	max     float64
+}
+
+func newTruePeak(Fs int, channels int) *truePeak {
+	factor := 1
+	for Fs*factor < 192000 {
+		factor *= 2
+	}
+	t := &truePeak{factor: factor, history: make([][]float64, channels)}
+	for c := range t.history {
+		t.history[c] = make([]float64, truePeakTaps)
+	}
+	n := truePeakTaps * factor
+	center := float64(n-1) / 2
+	for p := 0; p < factor; p++ {
+		phase := make([]float64, truePeakTaps)
+		sum := 0.0
+		for j := range phase {
+			k := float64(j*factor+p) - center
+			x := k / float64(factor)
+			sinc := 1.0
+			if x != 0 {
+				sinc = math.Sin(math.Pi*x) / (math.Pi * x)
+			}
+			window := 0.5 + 0.5*math.Cos(2*math.Pi*k/float64(n+1))
+			phase[j] = sinc * window
+			sum += phase[j]
+		}
+		for j := range phase {
+			phase[j] /= sum
+		}
+		t.phases = append(t.phases, phase)
+	}
+	return t
+}
+
+func (t *truePeak) process(frame []float64) {
+	for c, x := range frame {
+		h := t.history[c]
+		copy(h[1:], h[:len(h)-1])
+		h[0] = x
+		for _, phase := range t.phases {
+			y := 0.0
+			for j, coef := range phase {
+				y += coef * h[j]
+			}
+			t.max = math.Max(t.max, math.Abs(y))
+		}
+		t.max = math.Max(t.max, math.Abs(x))
+	}
+}
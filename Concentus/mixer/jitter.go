@@ -0,0 +1,90 @@
+package mixer
+
+const (
+	/* Packets further ahead than this are taken as a restarted stream */
+	maxSeqJump = 1000
+
+	/* After this many frames without a packet the participant is idle: it
+	   rebuffers and is no longer concealed */
+	idleFrames = 50
+)
+
+// What the jitter buffer has for the current frame.
+const (
+	frameSilent = iota // not playing out
+	framePacket        // packet holds the frame
+	frameLost          // conceal, with FEC from packet when it is not nil
+)
+
+// Reorders packets by sequence number and plays them out after a fixed
+// delay.
+type jitterBuffer struct {
+	packets map[uint16][]byte
+	next    uint16
+	playing bool
+	delay   int // frames buffered before playout starts
+	lost    int // consecutive frames missing
+}
+
+func newJitterBuffer(delay int) *jitterBuffer {
+	return &jitterBuffer{packets: make(map[uint16][]byte), delay: delay}
+}
+
+func (j *jitterBuffer) push(seq uint16, packet []byte) {
+	if j.playing {
+		ahead := int(int16(seq - j.next))
+		if ahead < 0 {
+			/* Too late, its frame was already played or concealed */
+			return
+		}
+		if ahead > maxSeqJump {
+			j.reset()
+		}
+	}
+	j.packets[seq] = packet
+}
+
+func (j *jitterBuffer) reset() {
+	clear(j.packets)
+	j.playing = false
+	j.lost = 0
+}
+
+// Returns the packet for the next frame, or the packet after it to draw
+// FEC from when the frame is lost.
+func (j *jitterBuffer) pop() (int, []byte) {
+	if !j.playing {
+		if len(j.packets) <= j.delay {
+			return frameSilent, nil
+		}
+		/* Start from the oldest packet */
+		first := true
+		for seq := range j.packets {
+			if first || int16(seq-j.next) < 0 {
+				j.next = seq
+				first = false
+			}
+		}
+		j.playing = true
+	}
+
+	/* Catch up when the buffer grows well past the delay, as after a burst */
+	for len(j.packets) > 2*j.delay+2 {
+		delete(j.packets, j.next)
+		j.next++
+	}
+
+	seq := j.next
+	j.next++
+	if packet, ok := j.packets[seq]; ok {
+		delete(j.packets, seq)
+		j.lost = 0
+		return framePacket, packet
+	}
+	j.lost++
+	if j.lost > idleFrames {
+		j.reset()
+		return frameSilent, nil
+	}
+	return frameLost, j.packets[seq+1]
+}
@@ -0,0 +1,65 @@
+package mixer
+
+const (
+	limitThreshold = 0.95 // of full scale
+	limitRelease   = 0.05 // gain recovered per frame
+)
+
+// A peak limiter with a gain that ramps across each frame, followed by a
+// soft clipper for what the ramp lets through.
+type limiter struct {
+	gain float32
+}
+
+func newLimiter() *limiter {
+	return &limiter{gain: 1}
+}
+
+// Converts a float frame, full scale at 1, to 16-bit samples.
+func (l *limiter) process(in []float32, out []int16) {
+	peak := float32(0)
+	for _, x := range in {
+		peak = max(peak, x, -x)
+	}
+	target := float32(1)
+	if peak > limitThreshold {
+		target = limitThreshold / peak
+	}
+	/* Attack at once, release slowly */
+	if target > l.gain {
+		target = min(target, l.gain+limitRelease)
+	}
+	step := (target - l.gain) / float32(len(in))
+	g := l.gain
+	for i, x := range in {
+		g += step
+		out[i] = toInt16(softClip(x * g))
+	}
+	l.gain = target
+}
+
+// Identity below the threshold, then a smooth knee that never exceeds
+// full scale.
+func softClip(x float32) float32 {
+	const knee = 1 - limitThreshold
+	switch {
+	case x > limitThreshold:
+		d := (x - limitThreshold) / knee
+		return limitThreshold + knee*d/(1+d)
+	case x < -limitThreshold:
+		d := (-x - limitThreshold) / knee
+		return -limitThreshold - knee*d/(1+d)
+	}
+	return x
+}
+
+func toInt16(x float32) int16 {
+	v := x * 32768
+	if v >= 32767 {
+		return 32767
+	}
+	if v <= -32768 {
+		return -32768
+	}
+	return int16(v)
+}
@@ -0,0 +1,335 @@
+// Package mixer mixes the audio of conference participants.
+//
+// Each participant sends Opus packets, which are reordered in a jitter
+// buffer and decoded, with FEC or PLC filling in for lost packets. Once per
+// frame, Mix picks the loudest speakers, sums them in float and encodes one
+// mix per distinct audience: every speaker gets the others without
+// themselves, and all the other participants, who hear the same thing,
+// share a single encoder and a single packet.
+package mixer
+
+import (
+	"concentus/opus"
+	"errors"
+	"sort"
+)
+
+const (
+	defaultMaxSpeakers = 3
+	defaultJitterDelay = 2 // frames
+
+	/* A speaker stays selected this many frames after its VAD drops, so
+	   that pauses between words do not cut it out */
+	speakerHangover = 10
+
+	/* A speaker's encoder is kept running on the shared mix for this many
+	   frames after it stops being mixed, so that speaking again soon after
+	   does not restart its listener's stream from a fresh encoder */
+	encoderHangover = 50
+
+	levelSmoothing = 0.3
+)
+
+// EncoderFactory creates the encoders for the mixes.
+type EncoderFactory func(Fs int, channels int) (*opus.OpusEncoder, error)
+
+// DefaultEncoder creates a speech encoder at 24 kbit/s.
+func DefaultEncoder(Fs int, channels int) (*opus.OpusEncoder, error) {
+	enc, err := opus.NewOpusEncoder(Fs, channels, opus.OPUS_APPLICATION_VOIP)
+	if err != nil {
+		return nil, err
+	}
+	enc.SetForceMode(opus.MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(24000)
+	return enc, nil
+}
+
+type participant struct {
+	id      string
+	dec     *opus.OpusDecoder
+	jitter  *jitterBuffer
+	pcm     []int16
+	frame   []float32 // this frame, full scale at 1
+	level   float64   // smoothed mean square
+	voice   bool
+	hold    int // frames of hangover left
+	speaker bool
+
+	/* Only used while the participant is a speaker, and for
+	   encoderHangover frames afterwards */
+	enc     *opus.OpusEncoder
+	limiter *limiter
+	idle    int // frames since it was last a speaker
+}
+
+// Output is an encoded mix and the participants it goes to.
+type Output struct {
+	Listeners []string
+	Packet    []byte
+}
+
+// Mixer mixes participants in frames of a fixed duration. It is not safe
+// for concurrent use.
+type Mixer struct {
+	fs          int
+	channels    int
+	frameSize   int
+	maxSpeakers int
+	delay       int
+	newEncoder  EncoderFactory
+
+	participants []*participant
+	byID         map[string]*participant
+
+	shared        *opus.OpusEncoder
+	sharedLimiter *limiter
+	mix           []float32
+	out           []float32
+	pcm           []int16
+	packet        []byte
+}
+
+// NewMixer creates a mixer for the given sample rate and channel count,
+// producing one packet per frameSize samples per channel. All participants
+// must send packets of that duration.
+func NewMixer(Fs int, channels int, frameSize int) (*Mixer, error) {
+	if channels != 1 && channels != 2 {
+		return nil, opus.ErrBadArg
+	}
+	if frameSize <= 0 || frameSize*400%Fs != 0 || frameSize > Fs*60/1000 {
+		return nil, errors.New("mixer: invalid frame size")
+	}
+	m := &Mixer{
+		fs:            Fs,
+		channels:      channels,
+		frameSize:     frameSize,
+		maxSpeakers:   defaultMaxSpeakers,
+		delay:         defaultJitterDelay,
+		newEncoder:    DefaultEncoder,
+		byID:          make(map[string]*participant),
+		sharedLimiter: newLimiter(),
+		mix:           make([]float32, frameSize*channels),
+		out:           make([]float32, frameSize*channels),
+		pcm:           make([]int16, frameSize*channels),
+		packet:        make([]byte, 1275),
+	}
+	return m, nil
+}
+
+// SetMaxSpeakers sets how many of the loudest active participants are mixed.
+func (m *Mixer) SetMaxSpeakers(value int) {
+	m.maxSpeakers = max(1, value)
+}
+
+// SetJitterDelay sets how many frames each participant buffers before
+// playing out. It applies to participants added afterwards.
+func (m *Mixer) SetJitterDelay(frames int) {
+	m.delay = max(0, frames)
+}
+
+// SetEncoderFactory sets how the encoders of the mixes are created. It
+// applies to encoders created afterwards.
+func (m *Mixer) SetEncoderFactory(value EncoderFactory) {
+	m.newEncoder = value
+}
+
+// Add adds a participant.
+func (m *Mixer) Add(id string) error {
+	if _, ok := m.byID[id]; ok {
+		return errors.New("mixer: participant already added")
+	}
+	dec, err := opus.NewOpusDecoder(m.fs, m.channels)
+	if err != nil {
+		return err
+	}
+	p := &participant{
+		id:     id,
+		dec:    dec,
+		jitter: newJitterBuffer(m.delay),
+		pcm:    make([]int16, m.frameSize*m.channels),
+		frame:  make([]float32, m.frameSize*m.channels),
+	}
+	m.participants = append(m.participants, p)
+	m.byID[id] = p
+	return nil
+}
+
+// Remove removes a participant.
+func (m *Mixer) Remove(id string) {
+	p, ok := m.byID[id]
+	if !ok {
+		return
+	}
+	delete(m.byID, id)
+	for i, q := range m.participants {
+		if q == p {
+			m.participants = append(m.participants[:i], m.participants[i+1:]...)
+			break
+		}
+	}
+}
+
+// Push queues a packet from a participant. seq is its sequence number, as
+// carried by RTP. The packet is kept, so it must not be modified afterwards.
+func (m *Mixer) Push(id string, seq uint16, packet []byte) error {
+	p, ok := m.byID[id]
+	if !ok {
+		return errors.New("mixer: unknown participant")
+	}
+	if n := opus.GetNumSamples(packet, 0, len(packet), m.fs); n < 0 {
+		return opus.ErrorFromCode(n)
+	} else if n != m.frameSize {
+		return errors.New("mixer: packet duration does not match the frame size")
+	}
+	p.jitter.push(seq, packet)
+	return nil
+}
+
+// Speakers returns the participants mixed in the last frame.
+func (m *Mixer) Speakers() []string {
+	var ids []string
+	for _, p := range m.participants {
+		if p.speaker {
+			ids = append(ids, p.id)
+		}
+	}
+	return ids
+}
+
+// Decodes the next frame of a participant into p.frame and updates its
+// voice activity and level. A packet that fails to decode is concealed like
+// a lost one, so that one bad sender cannot stop the mix.
+func (m *Mixer) decode(p *participant) {
+	state, packet := p.jitter.pop()
+	var err error
+	switch state {
+	case framePacket:
+		_, err = p.dec.Decode(packet, 0, len(packet), p.pcm, 0, m.frameSize, false)
+		p.voice = p.dec.GetVoiceActivity()
+	case frameLost:
+		/* Keep the previous voice activity across the gap */
+		if packet != nil {
+			_, err = p.dec.DecodeFEC(packet, 0, len(packet), p.pcm, 0, m.frameSize)
+		} else {
+			_, err = p.dec.DecodeLost(p.pcm, 0, m.frameSize)
+		}
+	default:
+		clear(p.pcm)
+		p.voice = false
+	}
+	if err != nil {
+		if _, err = p.dec.DecodeLost(p.pcm, 0, m.frameSize); err != nil {
+			clear(p.pcm)
+		}
+		p.voice = false
+	}
+	energy := 0.0
+	for i, s := range p.pcm {
+		p.frame[i] = float32(s) / 32768
+		energy += float64(p.frame[i]) * float64(p.frame[i])
+	}
+	p.level += levelSmoothing * (energy/float64(len(p.pcm)) - p.level)
+	if p.voice {
+		p.hold = speakerHangover
+	} else if p.hold > 0 {
+		p.hold--
+	}
+}
+
+// Mix advances by one frame: it decodes every participant, mixes the
+// loudest speakers and encodes the mixes. Listeners with the same mix share
+// an Output. Speakers are encoded with their own encoder, which they keep
+// while they stay speakers and for 50 frames afterwards; the shared encoder
+// carries everyone else. A speaker's first packets after that come from a
+// new encoder, which its listener hears as a short discontinuity, as with
+// any switch between streams.
+func (m *Mixer) Mix() ([]Output, error) {
+	for _, p := range m.participants {
+		m.decode(p)
+	}
+
+	/* The loudest participants with voice activity, or in their hangover */
+	var candidates []*participant
+	for _, p := range m.participants {
+		if p.voice || p.hold > 0 {
+			candidates = append(candidates, p)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].level > candidates[j].level
+	})
+	if len(candidates) > m.maxSpeakers {
+		candidates = candidates[:m.maxSpeakers]
+	}
+	for _, p := range m.participants {
+		p.speaker = false
+	}
+	clear(m.mix)
+	for _, p := range candidates {
+		p.speaker = true
+		for i, x := range p.frame {
+			m.mix[i] += x
+		}
+	}
+
+	var outputs []Output
+	var others []string
+	for _, p := range m.participants {
+		if !p.speaker {
+			others = append(others, p.id)
+			if p.enc == nil {
+				continue
+			}
+			/* It hears the shared mix, which already leaves it out */
+			p.idle++
+			if p.idle > encoderHangover {
+				p.enc, p.limiter = nil, nil
+			} else if _, err := m.encode(p.enc, p.limiter, m.mix); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		p.idle = 0
+		if p.enc == nil {
+			enc, err := m.newEncoder(m.fs, m.channels)
+			if err != nil {
+				return nil, err
+			}
+			p.enc = enc
+			p.limiter = newLimiter()
+		}
+		for i := range m.out {
+			m.out[i] = m.mix[i] - p.frame[i]
+		}
+		packet, err := m.encode(p.enc, p.limiter, m.out)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, Output{[]string{p.id}, packet})
+	}
+	if len(others) > 0 {
+		if m.shared == nil {
+			enc, err := m.newEncoder(m.fs, m.channels)
+			if err != nil {
+				return nil, err
+			}
+			m.shared = enc
+		}
+		packet, err := m.encode(m.shared, m.sharedLimiter, m.mix)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, Output{others, packet})
+	}
+	return outputs, nil
+}
+
+func (m *Mixer) encode(enc *opus.OpusEncoder, l *limiter, mix []float32) ([]byte, error) {
+	l.process(mix, m.pcm)
+	n, err := enc.Encode(m.pcm, 0, m.frameSize, m.packet, 0, len(m.packet))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), m.packet[:n]...), nil
+}
@@ -0,0 +1,314 @@
+package mixer
+
+import (
+	"bytes"
+	"concentus/opus"
+	"math"
+	"slices"
+	"testing"
+)
+
+const testFrame = 960
+
+// Sends a tone, modulated like syllables so that it reads as speech, or
+// silence when freq is 0.
+type testSource struct {
+	enc   *opus.OpusEncoder
+	freq  float64
+	amp   float64
+	t     int
+	seq   uint16
+	frame []int16
+	buf   []byte
+}
+
+func newTestSource(t *testing.T, freq float64, amp float64) *testSource {
+	enc, err := DefaultEncoder(48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSource{enc: enc, freq: freq, amp: amp, frame: make([]int16, testFrame), buf: make([]byte, 1275)}
+}
+
+func (s *testSource) next(t *testing.T) []byte {
+	for i := range s.frame {
+		n := float64(s.t + i)
+		env := 0.6 + 0.4*math.Sin(2*math.Pi*4*n/48000)
+		s.frame[i] = int16(s.amp * env * math.Sin(2*math.Pi*s.freq*n/48000))
+	}
+	s.t += testFrame
+	n, err := s.enc.Encode(s.frame, 0, testFrame, s.buf, 0, len(s.buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.seq++
+	return append([]byte(nil), s.buf[:n]...)
+}
+
+// Power of pcm at a frequency, by the Goertzel algorithm.
+func tonePower(pcm []int16, freq float64) float64 {
+	c := 2 * math.Cos(2*math.Pi*freq/48000)
+	var s1, s2 float64
+	for _, x := range pcm {
+		s1, s2 = float64(x)+c*s1-s2, s1
+	}
+	return (s1*s1 + s2*s2 - c*s1*s2) / float64(len(pcm)*len(pcm))
+}
+
+// Runs a conference and decodes what each listener receives.
+func runConference(t *testing.T, m *Mixer, sources map[string]*testSource, frames int) map[string][]int16 {
+	decoders := map[string]*opus.OpusDecoder{}
+	heard := map[string][]int16{}
+	pcm := make([]int16, testFrame)
+	ids := make([]string, 0, len(sources))
+	for id := range sources {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for f := 0; f < frames; f++ {
+		for _, id := range ids {
+			s := sources[id]
+			if err := m.Push(id, s.seq, s.next(t)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		outputs, err := m.Mix()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := 0
+		for _, o := range outputs {
+			for _, id := range o.Listeners {
+				got++
+				if decoders[id] == nil {
+					decoders[id], _ = opus.NewOpusDecoder(48000, 1)
+				}
+				n, err := decoders[id].Decode(o.Packet, 0, len(o.Packet), pcm, 0, testFrame, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				heard[id] = append(heard[id], pcm[:n]...)
+			}
+		}
+		if got != len(sources) {
+			t.Fatalf("frame %d: %d listeners served", f, got)
+		}
+	}
+	return heard
+}
+
+func TestMixerMinusOne(t *testing.T) {
+	m, err := NewMixer(48000, 1, testFrame)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := map[string]*testSource{
+		"a": newTestSource(t, 400, 8000),
+		"b": newTestSource(t, 1000, 6000),
+		"c": newTestSource(t, 0, 0),
+		"d": newTestSource(t, 0, 0),
+	}
+	for _, id := range []string{"a", "b", "c", "d"} {
+		m.Add(id)
+	}
+	heard := runConference(t, m, sources, 60)
+	if s := m.Speakers(); !slices.Equal(s, []string{"a", "b"}) {
+		t.Fatalf("speakers %v", s)
+	}
+
+	tail := func(id string) []int16 { return heard[id][len(heard[id])-20*testFrame:] }
+	for _, tc := range []struct {
+		id          string
+		hears       []float64
+		doesNotHear []float64
+	}{
+		{"a", []float64{1000}, []float64{400}},
+		{"b", []float64{400}, []float64{1000}},
+		{"c", []float64{400, 1000}, nil},
+		{"d", []float64{400, 1000}, nil},
+	} {
+		pcm := tail(tc.id)
+		for _, f := range tc.hears {
+			if p := tonePower(pcm, f); p < 1e5 {
+				t.Errorf("%s does not hear %v Hz (power %.0f)", tc.id, f, p)
+			}
+		}
+		for _, f := range tc.doesNotHear {
+			if p := tonePower(pcm, f); p > 1e4 {
+				t.Errorf("%s hears itself at %v Hz (power %.0f)", tc.id, f, p)
+			}
+		}
+	}
+
+	/* The silent participants share one packet */
+	outputs, err := m.Mix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 3 || !slices.Equal(outputs[2].Listeners, []string{"c", "d"}) {
+		t.Fatalf("outputs %+v", outputs)
+	}
+}
+
+func TestMixerLoudestSpeaker(t *testing.T) {
+	m, _ := NewMixer(48000, 1, testFrame)
+	m.SetMaxSpeakers(1)
+	sources := map[string]*testSource{
+		"a": newTestSource(t, 400, 3000),
+		"b": newTestSource(t, 1000, 12000),
+		"c": newTestSource(t, 0, 0),
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		m.Add(id)
+	}
+	heard := runConference(t, m, sources, 40)
+	if s := m.Speakers(); !slices.Equal(s, []string{"b"}) {
+		t.Fatalf("speakers %v", s)
+	}
+	pcm := heard["b"][len(heard["b"])-10*testFrame:]
+	for _, x := range pcm {
+		if x > 300 || x < -300 {
+			t.Fatalf("the only speaker hears %d", x)
+		}
+	}
+}
+
+func TestMixerJitterReordering(t *testing.T) {
+	/* Packets swapped in pairs within the jitter delay mix exactly as
+	   packets in order */
+	src := newTestSource(t, 500, 8000)
+	var packets [][]byte
+	for i := 0; i < 40; i++ {
+		packets = append(packets, src.next(t))
+	}
+	run := func(order func(i int) int, drop int) [][]byte {
+		m, _ := NewMixer(48000, 1, testFrame)
+		m.Add("a")
+		m.Add("b")
+		var out [][]byte
+		for i := range packets {
+			j := order(i)
+			if j != drop {
+				m.Push("a", uint16(60000+j), packets[j])
+			}
+			outputs, err := m.Mix()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, o := range outputs {
+				out = append(out, o.Packet)
+			}
+		}
+		return out
+	}
+	inOrder := run(func(i int) int { return i }, -1)
+	swapped := run(func(i int) int { return i ^ 1 }, -1)
+	if len(inOrder) != len(swapped) {
+		t.Fatalf("%d and %d outputs", len(inOrder), len(swapped))
+	}
+	for i := range inOrder {
+		if !bytes.Equal(inOrder[i], swapped[i]) {
+			t.Fatalf("output %d differs", i)
+		}
+	}
+	/* A lost packet is concealed and the stream carries on */
+	if lossy := run(func(i int) int { return i }, 20); len(lossy) != len(inOrder) {
+		t.Fatalf("%d outputs with a loss", len(lossy))
+	}
+}
+
+func TestMixerCorruptPacket(t *testing.T) {
+	m, _ := NewMixer(48000, 1, testFrame)
+	a := newTestSource(t, 400, 8000)
+	b := newTestSource(t, 1000, 8000)
+	m.Add("a")
+	m.Add("b")
+	/* 20 ms SILK wideband in a code 3 packet whose padding runs past the
+	   end: it has a valid duration but fails to parse */
+	corrupt := []byte{9<<3 | 3, 0x41, 0xFF, 0xFF}
+	dec, _ := opus.NewOpusDecoder(48000, 1)
+	pcm := make([]int16, testFrame)
+	if _, err := dec.Decode(corrupt, 0, len(corrupt), pcm, 0, testFrame, false); err == nil {
+		t.Fatal("the corrupt packet decodes")
+	}
+	var heard []int16
+	for f := 0; f < 60; f++ {
+		m.Push("a", a.seq, a.next(t))
+		packet := b.next(t)
+		if f >= 30 && f < 35 {
+			packet = corrupt
+		}
+		if err := m.Push("b", b.seq, packet); err != nil {
+			t.Fatal(err)
+		}
+		outputs, err := m.Mix()
+		if err != nil {
+			t.Fatalf("frame %d: %v", f, err)
+		}
+		for _, o := range outputs {
+			if slices.Contains(o.Listeners, "a") {
+				n, err := dec.Decode(o.Packet, 0, len(o.Packet), pcm, 0, testFrame, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				heard = append(heard, pcm[:n]...)
+			}
+		}
+	}
+	/* a still hears b once its packets are good again */
+	if p := tonePower(heard[len(heard)-10*testFrame:], 1000); p < 1e5 {
+		t.Errorf("a does not hear b after the corrupt packets (power %.0f)", p)
+	}
+}
+
+func TestMixerEncoderHangover(t *testing.T) {
+	m, _ := NewMixer(48000, 1, testFrame)
+	sources := map[string]*testSource{
+		"a": newTestSource(t, 400, 8000),
+		"b": newTestSource(t, 0, 0),
+	}
+	m.Add("a")
+	m.Add("b")
+	runConference(t, m, sources, 20)
+	enc := m.byID["a"].enc
+	if enc == nil {
+		t.Fatal("the speaker has no encoder")
+	}
+	/* A pause shorter than the hangover keeps the encoder */
+	sources["a"].amp = 0
+	runConference(t, m, sources, 30)
+	if m.byID["a"].speaker {
+		t.Fatal("a silent participant is still a speaker")
+	}
+	sources["a"].amp = 8000
+	runConference(t, m, sources, 10)
+	if m.byID["a"].enc != enc {
+		t.Error("the encoder was replaced after a short pause")
+	}
+	/* A longer one releases it */
+	sources["a"].amp = 0
+	runConference(t, m, sources, speakerHangover+encoderHangover+10)
+	if m.byID["a"].enc != nil {
+		t.Error("the encoder is kept after a long pause")
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter()
+	in := make([]float32, 960)
+	out := make([]int16, 960)
+	for frame := 0; frame < 5; frame++ {
+		for i := range in {
+			in[i] = float32(3 * math.Sin(2*math.Pi*float64(i)/48))
+		}
+		l.process(in, out)
+		for i, x := range out {
+			if (in[i] > 0.01 && x <= 0) || (in[i] < -0.01 && x >= 0) {
+				t.Fatalf("frame %d sample %d: %v became %d", frame, i, in[i], x)
+			}
+		}
+	}
+	if l.gain > limitThreshold/3+1e-3 {
+		t.Fatalf("gain %v on a signal peaking at 3", l.gain)
+	}
+}
@@ -0,0 +1,131 @@
+package mp4
+
+import (
+	"concentus/opus"
+	"encoding/binary"
+	"errors"
+)
+
+var errMalformed = errors.New("mp4: malformed box")
+
+func box(typ string, payload ...[]byte) []byte {
+	size := 8
+	for _, p := range payload {
+		size += len(p)
+	}
+	b := make([]byte, 8, size)
+	binary.BigEndian.PutUint32(b, uint32(size))
+	copy(b[4:], typ)
+	for _, p := range payload {
+		b = append(b, p...)
+	}
+	return b
+}
+
+func fullBox(typ string, version byte, flags uint32, payload ...[]byte) []byte {
+	vf := []byte{version, byte(flags >> 16), byte(flags >> 8), byte(flags)}
+	return box(typ, append([][]byte{vf}, payload...)...)
+}
+
+func u16(v int) []byte {
+	return binary.BigEndian.AppendUint16(nil, uint16(v))
+}
+
+func u32(v int64) []byte {
+	return binary.BigEndian.AppendUint32(nil, uint32(v))
+}
+
+func u64(v int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(v))
+}
+
+var unityMatrix = []byte{
+	0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0x40, 0, 0, 0,
+}
+
+// The Opus Specific Box of the Opus in ISOBMFF mapping: the OpusHead fields,
+// big endian, without the magic signature.
+func dOps(head *opus.OpusHead) []byte {
+	b := []byte{0, byte(head.Channels)}
+	b = binary.BigEndian.AppendUint16(b, uint16(head.PreSkip))
+	b = binary.BigEndian.AppendUint32(b, uint32(head.InputSampleRate))
+	b = binary.BigEndian.AppendUint16(b, uint16(int16(head.OutputGain)))
+	b = append(b, byte(head.MappingFamily))
+	if head.MappingFamily != 0 {
+		b = append(b, byte(head.StreamCount), byte(head.CoupledCount))
+		b = append(b, head.ChannelMapping[:head.Channels]...)
+	}
+	return box("dOps", b)
+}
+
+// Converts a dOps payload to an OpusHead by way of the Ogg layout, which
+// carries the same fields.
+func parseDOps(b []byte) (*opus.OpusHead, error) {
+	if len(b) < 11 || b[0] != 0 {
+		return nil, errors.New("mp4: unsupported dOps box")
+	}
+	ogg := append([]byte("OpusHead"), 1, b[1])
+	ogg = binary.LittleEndian.AppendUint16(ogg, binary.BigEndian.Uint16(b[2:]))
+	ogg = binary.LittleEndian.AppendUint32(ogg, binary.BigEndian.Uint32(b[4:]))
+	ogg = binary.LittleEndian.AppendUint16(ogg, binary.BigEndian.Uint16(b[8:]))
+	ogg = append(ogg, b[10:]...)
+	return opus.ParseOpusHead(ogg)
+}
+
+type rawBox struct {
+	typ  string
+	data []byte // payload, after the header
+}
+
+// Splits a buffer into boxes.
+func parseBoxes(b []byte) ([]rawBox, error) {
+	var boxes []rawBox
+	for len(b) > 0 {
+		if len(b) < 8 {
+			return nil, errMalformed
+		}
+		size := uint64(binary.BigEndian.Uint32(b))
+		typ := string(b[4:8])
+		hdr := uint64(8)
+		if size == 1 {
+			if len(b) < 16 {
+				return nil, errMalformed
+			}
+			size = binary.BigEndian.Uint64(b[8:])
+			hdr = 16
+		} else if size == 0 {
+			size = uint64(len(b))
+		}
+		if size < hdr || size > uint64(len(b)) {
+			return nil, errMalformed
+		}
+		boxes = append(boxes, rawBox{typ, b[hdr:size]})
+		b = b[size:]
+	}
+	return boxes, nil
+}
+
+func findBox(boxes []rawBox, typ string) []byte {
+	for _, b := range boxes {
+		if b.typ == typ {
+			return b.data
+		}
+	}
+	return nil
+}
+
+// Follows a path of box types down from a buffer of boxes.
+func findPath(b []byte, path ...string) []byte {
+	for _, typ := range path {
+		boxes, err := parseBoxes(b)
+		if err != nil {
+			return nil
+		}
+		if b = findBox(boxes, typ); b == nil {
+			return nil
+		}
+	}
+	return b
+}
@@ -0,0 +1,260 @@
+package mp4
+
+import (
+	"bytes"
+	"concentus/opus"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+// An in-memory io.WriteSeeker.
+type seekBuffer struct {
+	data []byte
+	pos  int
+}
+
+func (b *seekBuffer) Write(p []byte) (int, error) {
+	if need := b.pos + len(p); need > len(b.data) {
+		b.data = append(b.data, make([]byte, need-len(b.data))...)
+	}
+	copy(b.data[b.pos:], p)
+	b.pos += len(p)
+	return len(p), nil
+}
+
+func (b *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		b.pos = int(offset)
+	case io.SeekCurrent:
+		b.pos += int(offset)
+	case io.SeekEnd:
+		b.pos = len(b.data) + int(offset)
+	}
+	return int64(b.pos), nil
+}
+
+func testEncoder(t *testing.T) *opus.OpusEncoder {
+	enc, err := opus.NewOpusEncoder(48000, 1, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(opus.MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(16000)
+	return enc
+}
+
+func testPackets(t *testing.T, enc *opus.OpusEncoder, count int) [][]byte {
+	pcm := make([]int16, 960)
+	buf := make([]byte, 1275)
+	var packets [][]byte
+	for f := 0; f < count; f++ {
+		for i := range pcm {
+			pcm[i] = int16(8000 * math.Sin(2*math.Pi*440*float64(f*960+i)/48000))
+		}
+		n, err := enc.Encode(pcm, 0, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:n]...))
+	}
+	return packets
+}
+
+func checkPackets(t *testing.T, r *Reader, packets [][]byte) {
+	t.Helper()
+	for i, want := range packets {
+		p, err := r.ReadPacket()
+		if err != nil {
+			t.Fatalf("packet %d: %v", i, err)
+		}
+		if !bytes.Equal(p.Data, want) || p.Timestamp != int64(i*960) || p.Duration != 960 {
+			t.Fatalf("packet %d: timestamp %d duration %d, %d bytes", i, p.Timestamp, p.Duration, len(p.Data))
+		}
+	}
+	if _, err := r.ReadPacket(); err != io.EOF {
+		t.Fatalf("got %v at the end of the file", err)
+	}
+}
+
+func TestMP4ProgressiveRoundTrip(t *testing.T) {
+	enc := testEncoder(t)
+	packets := testPackets(t, enc, 100)
+	head := enc.GetOpusHead()
+	for _, seekable := range []bool{true, false} {
+		var out io.Writer = &bytes.Buffer{}
+		if seekable {
+			out = &seekBuffer{}
+		}
+		w, err := NewWriter(out, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range packets[:len(packets)-1] {
+			if err := w.WritePacket(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.WritePacketTrimmed(packets[len(packets)-1], 720); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		var data []byte
+		if seekable {
+			data = out.(*seekBuffer).data
+		} else {
+			data = out.(*bytes.Buffer).Bytes()
+		}
+
+		r, err := NewReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("seekable %v: %v", seekable, err)
+		}
+		want := int64(len(packets)*960 - head.PreSkip - 720)
+		if got := r.Head(); got.Channels != 1 || got.PreSkip != head.PreSkip || r.PreSkip() != head.PreSkip || r.Duration() != want {
+			t.Fatalf("seekable %v: head %+v, pre-skip %d, duration %d", seekable, got, r.PreSkip(), r.Duration())
+		}
+		checkPackets(t, r, packets)
+
+		/* The 80 ms pre-roll is four 20 ms packets */
+		sgpd := findPath(data, "moov", "trak", "mdia", "minf", "stbl", "sgpd")
+		if len(sgpd) != 18 || string(sgpd[4:8]) != "roll" || int16(binary.BigEndian.Uint16(sgpd[16:])) != -4 {
+			t.Fatalf("seekable %v: sgpd % x", seekable, sgpd)
+		}
+	}
+}
+
+func TestMP4DecodeTrimsEdits(t *testing.T) {
+	enc := testEncoder(t)
+	packets := testPackets(t, enc, 50)
+	out := &seekBuffer{}
+	w, _ := NewWriter(out, enc.GetOpusHead())
+	for _, p := range packets[:len(packets)-1] {
+		w.WritePacket(p)
+	}
+	w.WritePacketTrimmed(packets[len(packets)-1], 720)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(bytes.NewReader(out.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := r.Head().CreateDecoder(16000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pcm := make([]int16, 5760)
+	total := 0
+	for {
+		n, err := r.DecodePacket(dec, pcm)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += n
+	}
+	want := (len(packets)*960 - enc.GetOpusHead().PreSkip - 720) / 3
+	if total != want {
+		t.Fatalf("decoded %d samples, want %d", total, want)
+	}
+
+	/* Seeking starts 80 ms early so the decoder converges */
+	ts, err := r.Seek(48000, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := r.ReadPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := int64(48000 + r.PreSkip())
+	if ts > target-preRoll || ts+960 <= target-preRoll || p.Timestamp != ts {
+		t.Fatalf("seek to %d landed at %d, next packet at %d", target, ts, p.Timestamp)
+	}
+}
+
+func TestMP4Fragmented(t *testing.T) {
+	enc := testEncoder(t)
+	packets := testPackets(t, enc, 120)
+	head := enc.GetOpusHead()
+
+	/* An HLS-style stream: an init segment and 1 s media segments */
+	var init bytes.Buffer
+	fw, err := NewFragmentWriter(&init, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var segments []*bytes.Buffer
+	for _, p := range packets {
+		if err := fw.WritePacket(p); err != nil {
+			t.Fatal(err)
+		}
+		if fw.Pending() >= 48000 {
+			seg := &bytes.Buffer{}
+			if err := fw.WriteFragment(seg); err != nil {
+				t.Fatal(err)
+			}
+			segments = append(segments, seg)
+		}
+	}
+	seg := &bytes.Buffer{}
+	fw.WriteFragment(seg)
+	segments = append(segments, seg)
+	empty := &bytes.Buffer{}
+	fw.WriteFragment(empty)
+	if len(segments) != 3 || seg.Len() == 0 || empty.Len() != 0 {
+		t.Fatalf("%d segments, the last of %d bytes", len(segments), seg.Len())
+	}
+
+	if tfdt := findPath(segments[1].Bytes(), "moof", "traf", "tfdt"); binary.BigEndian.Uint64(tfdt[4:]) != 48000 {
+		t.Fatalf("second fragment decodes from %d", binary.BigEndian.Uint64(tfdt[4:]))
+	}
+	stream := append([]byte(nil), init.Bytes()...)
+	for _, s := range segments {
+		stream = append(stream, s.Bytes()...)
+	}
+	r, err := NewReader(bytes.NewReader(stream))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PreSkip() != head.PreSkip || r.Duration() != 0 {
+		t.Fatalf("pre-skip %d, duration %d", r.PreSkip(), r.Duration())
+	}
+	checkPackets(t, r, packets)
+}
+
+func TestMP4MultichannelHead(t *testing.T) {
+	streams := opus.BoxedValueInt{}
+	coupled := opus.BoxedValueInt{}
+	mapping := make([]int16, 6)
+	ms, err := opus.CreateSurroundOpusMSEncoder(48000, 6, 1, &streams, &coupled, mapping, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	w, _ := NewWriter(&out, ms.GetOpusHead())
+	/* Any packet will do, the track is only checked for its header */
+	w.WritePacket(testPackets(t, testEncoder(t), 1)[0])
+	w.Close()
+	r, err := NewReader(bytes.NewReader(out.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := r.Head()
+	if got.Channels != 6 || got.MappingFamily != 1 || got.StreamCount != 4 || got.CoupledCount != 2 ||
+		!bytes.Equal(got.ChannelMapping, []byte{0, 4, 1, 2, 3, 5}) {
+		t.Fatalf("head %+v", got)
+	}
+	if _, err := got.CreateMSDecoder(48000); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,483 @@
+package mp4
+
+import (
+	"concentus/opus"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+)
+
+// Packet is an Opus packet read from a file.
+type Packet struct {
+	Data      []byte
+	Timestamp int64 // decode time in 48 kHz samples, pre-skip included
+	Duration  int   // in 48 kHz samples
+}
+
+type sample struct {
+	offset   int64
+	size     int
+	time     int64 // media timescale
+	duration int64
+}
+
+// Reader demuxes the first Opus track of an MP4 file. Progressive and
+// fragmented files are both indexed when the reader is created, so an HLS
+// or DASH stream can be read by concatenating its initialization segment
+// and media segments.
+type Reader struct {
+	r     io.ReadSeeker
+	head  *opus.OpusHead
+	track uint32
+
+	movieScale   int64
+	scale        int64 // media timescale
+	mediaStart   int64 // media timescale
+	presentation int64 // media timescale, 0 when not known
+	trexDuration uint32
+	trexSize     uint32
+
+	samples []sample
+	next    int
+}
+
+// NewReader indexes the samples of the first Opus track in r.
+func NewReader(r io.ReadSeeker) (*Reader, error) {
+	rd := &Reader{r: r, movieScale: timescale, scale: timescale}
+	pos := int64(0)
+	for {
+		hdr := make([]byte, 16)
+		if _, err := io.ReadFull(r, hdr[:8]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			return nil, err
+		}
+		size := int64(binary.BigEndian.Uint32(hdr))
+		typ := string(hdr[4:8])
+		hdrLen := int64(8)
+		if size == 1 {
+			if _, err := io.ReadFull(r, hdr[8:]); err != nil {
+				return nil, io.ErrUnexpectedEOF
+			}
+			size = int64(binary.BigEndian.Uint64(hdr[8:]))
+			hdrLen = 16
+		}
+		if size == 0 {
+			/* Runs to the end of the file, which only mdat may do */
+			break
+		}
+		if size < hdrLen {
+			return nil, errMalformed
+		}
+		switch typ {
+		case "moov", "moof":
+			b := make([]byte, size-hdrLen)
+			if _, err := io.ReadFull(r, b); err != nil {
+				return nil, io.ErrUnexpectedEOF
+			}
+			var err error
+			if typ == "moov" {
+				err = rd.readMoov(b)
+			} else if rd.head != nil {
+				err = rd.readMoof(b, pos)
+			}
+			if err != nil {
+				return nil, err
+			}
+		default:
+			if _, err := r.Seek(pos+size, io.SeekStart); err != nil {
+				return nil, err
+			}
+		}
+		pos += size
+	}
+	if rd.head == nil {
+		return nil, errors.New("mp4: no Opus track")
+	}
+	return rd, nil
+}
+
+// Head returns the Opus header of the track.
+func (rd *Reader) Head() *opus.OpusHead {
+	return rd.head
+}
+
+// PreSkip returns the number of 48 kHz samples the edit list skips at the
+// start of the media.
+func (rd *Reader) PreSkip() int {
+	return int(rd.toSamples(rd.mediaStart))
+}
+
+// Duration returns the presented length of the track in 48 kHz samples, or
+// 0 when the file does not give it, as in fragmented streams.
+func (rd *Reader) Duration() int64 {
+	return rd.toSamples(rd.presentation)
+}
+
+func (rd *Reader) toSamples(t int64) int64 {
+	return t * 48000 / rd.scale
+}
+
+func (rd *Reader) readMoov(b []byte) error {
+	if mvhd := findPath(b, "mvhd"); len(mvhd) >= 24 {
+		rd.movieScale = int64(binary.BigEndian.Uint32(mvhd[12:]))
+		if mvhd[0] == 1 {
+			rd.movieScale = int64(binary.BigEndian.Uint32(mvhd[20:]))
+		}
+	}
+	boxes, err := parseBoxes(b)
+	if err != nil {
+		return err
+	}
+	for _, trak := range boxes {
+		if trak.typ != "trak" {
+			continue
+		}
+		stbl := findPath(trak.data, "mdia", "minf", "stbl")
+		head, err := opusEntry(findPath(stbl, "stsd"))
+		if err != nil {
+			return err
+		}
+		if head == nil {
+			continue
+		}
+		tkhd := findPath(trak.data, "tkhd")
+		mdhd := findPath(trak.data, "mdia", "mdhd")
+		if len(tkhd) < 24 || len(mdhd) < 24 {
+			return errMalformed
+		}
+		rd.head = head
+		if tkhd[0] == 1 {
+			rd.track = binary.BigEndian.Uint32(tkhd[20:])
+			rd.scale = int64(binary.BigEndian.Uint32(mdhd[20:]))
+		} else {
+			rd.track = binary.BigEndian.Uint32(tkhd[12:])
+			rd.scale = int64(binary.BigEndian.Uint32(mdhd[12:]))
+		}
+		if rd.scale == 0 || rd.movieScale == 0 {
+			return errMalformed
+		}
+		rd.mediaStart = int64(head.PreSkip) * rd.scale / 48000
+		rd.readEdits(findPath(trak.data, "edts", "elst"))
+		rd.readTrex(findPath(b, "mvex"))
+		return rd.readSampleTables(stbl)
+	}
+	return nil
+}
+
+// Returns the header of an Opus sample entry, or nil for other codecs.
+func opusEntry(stsd []byte) (*opus.OpusHead, error) {
+	if len(stsd) < 8 {
+		return nil, nil
+	}
+	entries, err := parseBoxes(stsd[8:])
+	if err != nil || len(entries) == 0 || entries[0].typ != "Opus" {
+		return nil, err
+	}
+	/* The AudioSampleEntry fields come before the child boxes */
+	if len(entries[0].data) < 28 {
+		return nil, errMalformed
+	}
+	dops := findPath(entries[0].data[28:], "dOps")
+	if dops == nil {
+		return nil, errors.New("mp4: Opus sample entry without dOps")
+	}
+	return parseDOps(dops)
+}
+
+// Takes the start and length from the first edit that is not empty.
+func (rd *Reader) readEdits(elst []byte) {
+	if len(elst) < 8 {
+		return
+	}
+	count := int(binary.BigEndian.Uint32(elst[4:]))
+	b := elst[8:]
+	for i := 0; i < count; i++ {
+		var duration, mediaTime int64
+		if elst[0] == 1 {
+			if len(b) < 20 {
+				return
+			}
+			duration = int64(binary.BigEndian.Uint64(b))
+			mediaTime = int64(binary.BigEndian.Uint64(b[8:]))
+			b = b[20:]
+		} else {
+			if len(b) < 12 {
+				return
+			}
+			duration = int64(binary.BigEndian.Uint32(b))
+			mediaTime = int64(int32(binary.BigEndian.Uint32(b[4:])))
+			b = b[12:]
+		}
+		if mediaTime >= 0 {
+			rd.mediaStart = mediaTime
+			rd.presentation = duration * rd.scale / rd.movieScale
+			return
+		}
+	}
+}
+
+func (rd *Reader) readTrex(mvex []byte) {
+	boxes, _ := parseBoxes(mvex)
+	for _, b := range boxes {
+		if b.typ == "trex" && len(b.data) >= 24 && binary.BigEndian.Uint32(b.data[4:]) == rd.track {
+			rd.trexDuration = binary.BigEndian.Uint32(b.data[12:])
+			rd.trexSize = binary.BigEndian.Uint32(b.data[16:])
+		}
+	}
+}
+
+func (rd *Reader) readSampleTables(stbl []byte) error {
+	stts := findPath(stbl, "stts")
+	stsc := findPath(stbl, "stsc")
+	stsz := findPath(stbl, "stsz")
+	var offsets []int64
+	if stco := findPath(stbl, "stco"); len(stco) >= 8 {
+		n := int(binary.BigEndian.Uint32(stco[4:]))
+		if len(stco) < 8+4*n {
+			return errMalformed
+		}
+		for i := 0; i < n; i++ {
+			offsets = append(offsets, int64(binary.BigEndian.Uint32(stco[8+4*i:])))
+		}
+	} else if co64 := findPath(stbl, "co64"); len(co64) >= 8 {
+		n := int(binary.BigEndian.Uint32(co64[4:]))
+		if len(co64) < 8+8*n {
+			return errMalformed
+		}
+		for i := 0; i < n; i++ {
+			offsets = append(offsets, int64(binary.BigEndian.Uint64(co64[8+8*i:])))
+		}
+	}
+	if len(stts) < 8 || len(stsc) < 8 || len(stsz) < 12 {
+		return errMalformed
+	}
+	count := int(binary.BigEndian.Uint32(stsz[8:]))
+	fixed := int(binary.BigEndian.Uint32(stsz[4:]))
+	if fixed == 0 && len(stsz) < 12+4*count {
+		return errMalformed
+	}
+	samples := make([]sample, count)
+	for i := range samples {
+		samples[i].size = fixed
+		if fixed == 0 {
+			samples[i].size = int(binary.BigEndian.Uint32(stsz[12+4*i:]))
+		}
+	}
+
+	/* Durations and decode times */
+	n := int(binary.BigEndian.Uint32(stts[4:]))
+	if len(stts) < 8+8*n {
+		return errMalformed
+	}
+	s, time := 0, int64(0)
+	for i := 0; i < n; i++ {
+		run := int(binary.BigEndian.Uint32(stts[8+8*i:]))
+		delta := int64(binary.BigEndian.Uint32(stts[12+8*i:]))
+		for j := 0; j < run && s < count; j++ {
+			samples[s].time = time
+			samples[s].duration = delta
+			time += delta
+			s++
+		}
+	}
+
+	/* Offsets, chunk by chunk */
+	n = int(binary.BigEndian.Uint32(stsc[4:]))
+	if len(stsc) < 8+12*n {
+		return errMalformed
+	}
+	s = 0
+	for i := 0; i < n; i++ {
+		first := int(binary.BigEndian.Uint32(stsc[8+12*i:]))
+		perChunk := int(binary.BigEndian.Uint32(stsc[12+12*i:]))
+		last := len(offsets)
+		if i+1 < n {
+			last = int(binary.BigEndian.Uint32(stsc[20+12*i:])) - 1
+		}
+		for c := first; c <= last && c >= 1 && c <= len(offsets); c++ {
+			pos := offsets[c-1]
+			for j := 0; j < perChunk && s < count; j++ {
+				samples[s].offset = pos
+				pos += int64(samples[s].size)
+				s++
+			}
+		}
+	}
+	if s != count {
+		return errMalformed
+	}
+	rd.samples = samples
+	return nil
+}
+
+// Appends the samples of the track in a movie fragment that starts at
+// moofPos in the file.
+func (rd *Reader) readMoof(b []byte, moofPos int64) error {
+	boxes, err := parseBoxes(b)
+	if err != nil {
+		return err
+	}
+	for _, traf := range boxes {
+		if traf.typ != "traf" {
+			continue
+		}
+		children, err := parseBoxes(traf.data)
+		if err != nil {
+			return err
+		}
+		tfhd := findBox(children, "tfhd")
+		if len(tfhd) < 8 || binary.BigEndian.Uint32(tfhd[4:]) != rd.track {
+			continue
+		}
+		flags := binary.BigEndian.Uint32(tfhd) & 0xFFFFFF
+		base := moofPos
+		duration, size := rd.trexDuration, rd.trexSize
+		p := tfhd[8:]
+		field := func(n int) uint64 {
+			if len(p) < n {
+				return 0
+			}
+			v := uint64(0)
+			for _, c := range p[:n] {
+				v = v<<8 | uint64(c)
+			}
+			p = p[n:]
+			return v
+		}
+		if flags&0x01 != 0 {
+			base = int64(field(8))
+		}
+		if flags&0x02 != 0 {
+			field(4) /* sample description index */
+		}
+		if flags&0x08 != 0 {
+			duration = uint32(field(4))
+		}
+		if flags&0x10 != 0 {
+			size = uint32(field(4))
+		}
+
+		time := int64(0)
+		if n := len(rd.samples); n > 0 {
+			time = rd.samples[n-1].time + rd.samples[n-1].duration
+		}
+		if tfdt := findBox(children, "tfdt"); len(tfdt) >= 8 {
+			if tfdt[0] == 1 && len(tfdt) >= 12 {
+				time = int64(binary.BigEndian.Uint64(tfdt[4:]))
+			} else {
+				time = int64(binary.BigEndian.Uint32(tfdt[4:]))
+			}
+		}
+
+		pos := base
+		for _, trun := range children {
+			if trun.typ != "trun" {
+				continue
+			}
+			if len(trun.data) < 8 {
+				return errMalformed
+			}
+			p = trun.data[8:]
+			flags := binary.BigEndian.Uint32(trun.data) & 0xFFFFFF
+			count := int(binary.BigEndian.Uint32(trun.data[4:]))
+			if flags&0x01 != 0 {
+				pos = base + int64(int32(field(4)))
+			}
+			if flags&0x04 != 0 {
+				field(4) /* first sample flags */
+			}
+			for i := 0; i < count; i++ {
+				if len(p) == 0 && flags&0xF00 != 0 {
+					return errMalformed
+				}
+				s := sample{offset: pos, time: time, duration: int64(duration), size: int(size)}
+				if flags&0x100 != 0 {
+					s.duration = int64(field(4))
+				}
+				if flags&0x200 != 0 {
+					s.size = int(field(4))
+				}
+				if flags&0x400 != 0 {
+					field(4)
+				}
+				if flags&0x800 != 0 {
+					field(4)
+				}
+				rd.samples = append(rd.samples, s)
+				pos += int64(s.size)
+				time += s.duration
+			}
+		}
+	}
+	return nil
+}
+
+// ReadPacket returns the next packet of the track, or io.EOF after the last.
+func (rd *Reader) ReadPacket() (*Packet, error) {
+	if rd.next >= len(rd.samples) {
+		return nil, io.EOF
+	}
+	s := rd.samples[rd.next]
+	if _, err := rd.r.Seek(s.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data := make([]byte, s.size)
+	if _, err := io.ReadFull(rd.r, data); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	rd.next++
+	return &Packet{data, rd.toSamples(s.time), int(rd.toSamples(s.duration))}, nil
+}
+
+// Seek positions the reader so that decoding from the next packet leaves the
+// decoder converged by timestamp, a position in 48 kHz samples after the
+// pre-skip: it starts at least 80 ms earlier, as the roll-recovery group
+// requires. Only io.SeekStart is supported. Returns the decode time of the
+// next packet, pre-skip included.
+func (rd *Reader) Seek(timestamp int64, whence int) (int64, error) {
+	if whence != io.SeekStart || timestamp < 0 {
+		return 0, errors.New("mp4: unsupported seek")
+	}
+	target := (timestamp + int64(rd.PreSkip()) - preRoll) * rd.scale / 48000
+	i := sort.Search(len(rd.samples), func(i int) bool {
+		return rd.samples[i].time+rd.samples[i].duration > target
+	})
+	if i == len(rd.samples) {
+		rd.next = i
+		return 0, io.EOF
+	}
+	rd.next = i
+	return rd.toSamples(rd.samples[i].time), nil
+}
+
+// DecodePacket reads the next packet and decodes it with dec, which must
+// have been created for the track, for instance with Head().CreateDecoder.
+// Samples outside the edit list, the pre-skip and the padding of the final
+// packet, are removed. Returns the number of samples per channel left in
+// pcm, which may be zero.
+func (rd *Reader) DecodePacket(dec *opus.OpusDecoder, pcm []int16) (int, error) {
+	p, err := rd.ReadPacket()
+	if err != nil {
+		return 0, err
+	}
+	channels := rd.head.Channels
+	n, err := dec.Decode(p.Data, 0, len(p.Data), pcm, 0, len(pcm)/channels, false)
+	if err != nil {
+		return 0, err
+	}
+	Fs := int64(dec.GetSampleRate())
+	start := int64(rd.PreSkip())
+	skip := int(max(0, start-p.Timestamp) * Fs / 48000)
+	end := n
+	if rd.presentation > 0 {
+		end = min(n, int((start+rd.Duration()-p.Timestamp)*Fs/48000))
+	}
+	if skip >= end {
+		return 0, nil
+	}
+	copy(pcm, pcm[skip*channels:end*channels])
+	return end - skip, nil
+}
@@ -0,0 +1,389 @@
+// Package mp4 reads and writes Opus audio in ISO Base Media (MP4) files,
+// following the Opus in ISOBMFF encapsulation.
+//
+// Both layouts are supported: progressive files with a single moov and mdat,
+// and fragmented files made of an initialization segment followed by
+// moof/mdat fragments, as used for HLS and DASH. The media timescale is
+// 48 kHz; the pre-skip is signalled with an edit list and the 80 ms decoder
+// pre-roll with a roll-recovery sample group.
+package mp4
+
+import (
+	"concentus/opus"
+	"errors"
+	"io"
+)
+
+const (
+	timescale = 48000
+	trackID   = 1
+	preRoll   = 3840 // 80 ms at 48 kHz
+)
+
+// Writer muxes Opus packets into a progressive MP4 file. When the
+// destination is an io.WriteSeeker, packets are written as they arrive and
+// the moov box follows the media data; otherwise packets are held in memory
+// and Close writes the moov box first.
+type Writer struct {
+	w    io.Writer
+	ws   io.WriteSeeker
+	head *opus.OpusHead
+	pos  int64
+
+	mdatPos   int64 // where the mdat box starts, when streaming
+	data      []byte
+	sizes     []int
+	durations []int
+	samples   int64 // 48 kHz samples written so far
+	discard   int   // trimmed from the end of the last packet
+	closed    bool
+}
+
+// NewWriter writes the file header for an Opus track described by head,
+// which is usually obtained with GetOpusHead from an OpusEncoder or
+// OpusMSEncoder.
+func NewWriter(w io.Writer, head *opus.OpusHead) (*Writer, error) {
+	wr := &Writer{w: w, head: head}
+	if ws, ok := w.(io.WriteSeeker); ok {
+		wr.ws = ws
+		b := ftyp(false)
+		wr.mdatPos = int64(len(b))
+		/* 64-bit size, patched by Close */
+		b = append(b, 0, 0, 0, 1, 'm', 'd', 'a', 't', 0, 0, 0, 0, 0, 0, 0, 0)
+		if err := wr.write(b); err != nil {
+			return nil, err
+		}
+	}
+	return wr, nil
+}
+
+func (wr *Writer) write(b []byte) error {
+	n, err := wr.w.Write(b)
+	wr.pos += int64(n)
+	return err
+}
+
+// WritePacket appends a packet. Its duration is read from the TOC.
+func (wr *Writer) WritePacket(packet []byte) error {
+	return wr.WritePacketTrimmed(packet, 0)
+}
+
+// WritePacketTrimmed appends the final packet of the stream, whose last
+// discard samples, at 48 kHz, are not part of the stream. The edit list
+// excludes them from the presentation.
+func (wr *Writer) WritePacketTrimmed(packet []byte, discard int) error {
+	if wr.closed {
+		return errors.New("mp4: writer is closed")
+	}
+	if wr.discard != 0 {
+		return errors.New("mp4: only the final packet may be trimmed")
+	}
+	duration, err := packetDuration(packet, discard)
+	if err != nil {
+		return err
+	}
+	if wr.ws != nil {
+		if err := wr.write(packet); err != nil {
+			return err
+		}
+	} else {
+		wr.data = append(wr.data, packet...)
+	}
+	wr.sizes = append(wr.sizes, len(packet))
+	wr.durations = append(wr.durations, duration)
+	wr.samples += int64(duration)
+	wr.discard = discard
+	return nil
+}
+
+func packetDuration(packet []byte, discard int) (int, error) {
+	duration := opus.GetNumSamples(packet, 0, len(packet), timescale)
+	if duration < 0 {
+		return 0, opus.ErrorFromCode(duration)
+	}
+	if discard < 0 || discard > duration {
+		return 0, errors.New("mp4: discard exceeds the packet duration")
+	}
+	return duration, nil
+}
+
+// Close writes the sample tables, and the media data when it was held in
+// memory. It does not close the underlying writer.
+func (wr *Writer) Close() error {
+	if wr.closed {
+		return nil
+	}
+	wr.closed = true
+	presentation := wr.samples - int64(wr.head.PreSkip) - int64(wr.discard)
+	if presentation < 0 {
+		presentation = 0
+	}
+	if wr.ws != nil {
+		end := wr.pos
+		if _, err := wr.ws.Seek(wr.mdatPos+8, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := wr.ws.Write(u64(end - wr.mdatPos)); err != nil {
+			return err
+		}
+		if _, err := wr.ws.Seek(end, io.SeekStart); err != nil {
+			return err
+		}
+		return wr.write(wr.moov(wr.mdatPos+16, presentation))
+	}
+
+	/* The chunk offset depends on the size of the moov box, which does
+	   not depend on the offset itself */
+	b := ftyp(false)
+	moovSize := len(wr.moov(0, presentation))
+	b = append(b, wr.moov(int64(len(b)+moovSize+8), presentation)...)
+	b = append(b, box("mdat", wr.data)...)
+	wr.data = nil
+	return wr.write(b)
+}
+
+// All samples go in a single chunk starting at offset.
+func (wr *Writer) moov(offset int64, presentation int64) []byte {
+	tables := [][]byte{
+		stsd(wr.head),
+		stts(wr.durations),
+		fullBox("stsc", 0, 0, u32(1), u32(1), u32(int64(len(wr.sizes))), u32(1)),
+		stsz(wr.sizes),
+		fullBox("co64", 0, 0, u32(1), u64(offset)),
+	}
+	stbl := box("stbl", append(tables, rollGroups(wr.durations, 1)...)...)
+	return box("moov",
+		mvhd(presentation),
+		trak(presentation, wr.samples, stbl, int64(wr.head.PreSkip)),
+	)
+}
+
+func ftyp(fragmented bool) []byte {
+	if fragmented {
+		return box("ftyp", []byte("iso6"), u32(0), []byte("iso6isommp41Opus"))
+	}
+	return box("ftyp", []byte("isom"), u32(0x200), []byte("isomiso2mp41Opus"))
+}
+
+func mvhd(duration int64) []byte {
+	b := u32(0) /* creation time */
+	b = append(b, u32(0)...)
+	b = append(b, u32(timescale)...)
+	b = append(b, u32(duration)...)
+	b = append(b, u32(0x00010000)...) /* rate 1.0 */
+	b = append(b, u16(0x0100)...)     /* volume 1.0 */
+	b = append(b, make([]byte, 10)...)
+	b = append(b, unityMatrix...)
+	b = append(b, make([]byte, 24)...)
+	b = append(b, u32(trackID+1)...)
+	return fullBox("mvhd", 0, 0, b)
+}
+
+// A track with an edit list that skips the first skip samples of the media
+// and presents duration samples.
+func trak(duration int64, mediaDuration int64, stbl []byte, skip int64) []byte {
+	tkhd := u32(0)
+	tkhd = append(tkhd, u32(0)...)
+	tkhd = append(tkhd, u32(trackID)...)
+	tkhd = append(tkhd, u32(0)...)
+	tkhd = append(tkhd, u32(duration)...)
+	tkhd = append(tkhd, make([]byte, 8)...)
+	tkhd = append(tkhd, u16(0)...)      /* layer */
+	tkhd = append(tkhd, u16(1)...)      /* alternate group */
+	tkhd = append(tkhd, u16(0x0100)...) /* volume */
+	tkhd = append(tkhd, u16(0)...)
+	tkhd = append(tkhd, unityMatrix...)
+	tkhd = append(tkhd, make([]byte, 8)...) /* width and height */
+
+	elst := u32(1)
+	elst = append(elst, u32(duration)...)
+	elst = append(elst, u32(skip)...)
+	elst = append(elst, u16(1)...)
+	elst = append(elst, u16(0)...)
+
+	mdhd := u32(0)
+	mdhd = append(mdhd, u32(0)...)
+	mdhd = append(mdhd, u32(timescale)...)
+	mdhd = append(mdhd, u32(mediaDuration)...)
+	mdhd = append(mdhd, u16(0x55C4)...) /* "und" */
+	mdhd = append(mdhd, u16(0)...)
+
+	hdlr := u32(0)
+	hdlr = append(hdlr, []byte("soun")...)
+	hdlr = append(hdlr, make([]byte, 12)...)
+	hdlr = append(hdlr, []byte("SoundHandler\x00")...)
+
+	dinf := box("dinf", fullBox("dref", 0, 0, u32(1), fullBox("url ", 0, 1)))
+	minf := box("minf", fullBox("smhd", 0, 0, u16(0), u16(0)), dinf, stbl)
+	mdia := box("mdia", fullBox("mdhd", 0, 0, mdhd), fullBox("hdlr", 0, 0, hdlr), minf)
+	return box("trak",
+		fullBox("tkhd", 0, 3, tkhd),
+		box("edts", fullBox("elst", 0, 0, elst)),
+		mdia,
+	)
+}
+
+// The Opus sample entry, an AudioSampleEntry carrying a dOps box.
+func stsd(head *opus.OpusHead) []byte {
+	b := make([]byte, 6)
+	b = append(b, u16(1)...) /* data reference index */
+	b = append(b, make([]byte, 8)...)
+	b = append(b, u16(head.Channels)...)
+	b = append(b, u16(16)...)
+	b = append(b, make([]byte, 4)...)
+	b = append(b, u32(timescale<<16)...)
+	return fullBox("stsd", 0, 0, u32(1), box("Opus", b, dOps(head)))
+}
+
+func stts(durations []int) []byte {
+	var entries []byte
+	count := 0
+	for i, d := range durations {
+		count++
+		if i == len(durations)-1 || durations[i+1] != d {
+			entries = append(entries, u32(int64(count))...)
+			entries = append(entries, u32(int64(d))...)
+			count = 0
+		}
+	}
+	return fullBox("stts", 0, 0, u32(int64(len(entries)/8)), entries)
+}
+
+func stsz(sizes []int) []byte {
+	b := u32(0)
+	b = append(b, u32(int64(len(sizes)))...)
+	for _, s := range sizes {
+		b = append(b, u32(int64(s))...)
+	}
+	return fullBox("stsz", 0, 0, b)
+}
+
+// The number of packets to decode ahead of a sample for the decoder state to
+// converge, as a (negative) roll distance.
+func rollDistance(durations []int) int {
+	shortest := 0
+	for _, d := range durations {
+		if shortest == 0 || d < shortest {
+			shortest = d
+		}
+	}
+	if shortest == 0 {
+		return 0
+	}
+	return -((preRoll + shortest - 1) / shortest)
+}
+
+// A roll-recovery sample group covering all the samples, referring to the
+// description at index. It is left out for empty tracks.
+func rollGroups(durations []int, index int) [][]byte {
+	if len(durations) == 0 {
+		return nil
+	}
+	sgpd := fullBox("sgpd", 1, 0, []byte("roll"), u32(2), u32(1), u16(rollDistance(durations)))
+	sbgp := fullBox("sbgp", 0, 0, []byte("roll"), u32(1), u32(int64(len(durations))), u32(int64(index)))
+	return [][]byte{sgpd, sbgp}
+}
+
+// FragmentWriter muxes Opus packets into a fragmented MP4 stream. The
+// initialization segment is written by NewFragmentWriter; each call to
+// WriteFragment then writes the pending packets as one moof/mdat pair, so a
+// segmenter can send every fragment to its own file or request.
+type FragmentWriter struct {
+	head       *opus.OpusHead
+	sequence   int
+	decodeTime int64 // 48 kHz samples in the previous fragments
+	data       []byte
+	sizes      []int
+	durations  []int
+	pending    int64
+}
+
+// NewFragmentWriter writes the initialization segment for an Opus track
+// described by head to w. Since the length of the stream is not known, its
+// edit list only skips the pre-skip and runs to the end of the media.
+func NewFragmentWriter(w io.Writer, head *opus.OpusHead) (*FragmentWriter, error) {
+	stbl := box("stbl",
+		stsd(head),
+		fullBox("stts", 0, 0, u32(0)),
+		fullBox("stsc", 0, 0, u32(0)),
+		fullBox("stsz", 0, 0, u32(0), u32(0)),
+		fullBox("stco", 0, 0, u32(0)),
+	)
+	trex := u32(trackID)
+	trex = append(trex, u32(1)...) /* sample description index */
+	trex = append(trex, make([]byte, 12)...)
+	moov := box("moov",
+		mvhd(0),
+		trak(0, 0, stbl, int64(head.PreSkip)),
+		box("mvex", fullBox("trex", 0, 0, trex)),
+	)
+	if _, err := w.Write(append(ftyp(true), moov...)); err != nil {
+		return nil, err
+	}
+	return &FragmentWriter{head: head}, nil
+}
+
+// WritePacket queues a packet for the next fragment.
+func (fw *FragmentWriter) WritePacket(packet []byte) error {
+	duration, err := packetDuration(packet, 0)
+	if err != nil {
+		return err
+	}
+	fw.data = append(fw.data, packet...)
+	fw.sizes = append(fw.sizes, len(packet))
+	fw.durations = append(fw.durations, duration)
+	fw.pending += int64(duration)
+	return nil
+}
+
+// Pending returns the duration of the queued packets, in 48 kHz samples,
+// which lets the caller cut fragments of a target length.
+func (fw *FragmentWriter) Pending() int64 {
+	return fw.pending
+}
+
+// WriteFragment writes the queued packets to w as a fragment. Nothing is
+// written when no packets are queued.
+func (fw *FragmentWriter) WriteFragment(w io.Writer) error {
+	if len(fw.sizes) == 0 {
+		return nil
+	}
+	fw.sequence++
+
+	/* tfhd flags: default-base-is-moof; trun flags: data offset, sample
+	   duration and sample size present */
+	trun := func(dataOffset int64) []byte {
+		b := u32(int64(len(fw.sizes)))
+		b = append(b, u32(dataOffset)...)
+		for i := range fw.sizes {
+			b = append(b, u32(int64(fw.durations[i]))...)
+			b = append(b, u32(int64(fw.sizes[i]))...)
+		}
+		return fullBox("trun", 0, 0x000301, b)
+	}
+	moof := func(dataOffset int64) []byte {
+		traf := [][]byte{
+			fullBox("tfhd", 0, 0x020000, u32(trackID)),
+			fullBox("tfdt", 1, 0, u64(fw.decodeTime)),
+			trun(dataOffset),
+		}
+		/* The group description is local to the fragment */
+		traf = append(traf, rollGroups(fw.durations, 0x10001)...)
+		return box("moof",
+			fullBox("mfhd", 0, 0, u32(int64(fw.sequence))),
+			box("traf", traf...),
+		)
+	}
+	size := len(moof(0))
+	b := moof(int64(size + 8))
+	b = append(b, box("mdat", fw.data)...)
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+	fw.decodeTime += fw.pending
+	fw.data = nil
+	fw.sizes = fw.sizes[:0]
+	fw.durations = fw.durations[:0]
+	fw.pending = 0
+	return nil
+}
@@ -0,0 +1,142 @@
+package netsim
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Voiced syllables at a gliding pitch with noisy gaps, like testSpeechSignal
+// in package opus.
+func testSpeech(samples int) []int16 {
+	out := make([]int16, samples)
+	phase, lp := 0.0, 0.0
+	rng := rand.New(rand.NewSource(1))
+	for i := range out {
+		t := float64(i) / 48000
+		f0 := 140 + 40*math.Sin(2*math.Pi*0.7*t)
+		phase += 2 * math.Pi * f0 / 48000
+		voiced := 0.0
+		for h := 1; float64(h)*f0 < 3800; h++ {
+			voiced += math.Sin(float64(h)*phase) / float64(h)
+		}
+		lp = 0.7*lp + 0.3*(2*rng.Float64()-1)
+		env := math.Sin(2 * math.Pi * 3 * t)
+		out[i] = int16(6000*math.Max(0, env)*voiced + 3000*math.Max(0, -env)*lp)
+	}
+	return out
+}
+
+func TestGilbertElliott(t *testing.T) {
+	for _, c := range []struct{ loss, burst float64 }{{0.1, 1}, {0.1, 3}, {0.3, 5}} {
+		g := NewGilbertElliott(c.loss, c.burst)
+		rng := rand.New(rand.NewSource(1))
+		lost, bursts, run := 0, 0, 0
+		const n = 200000
+		for i := 0; i < n; i++ {
+			if g.Lost(rng) {
+				lost++
+				if run == 0 {
+					bursts++
+				}
+				run++
+			} else {
+				run = 0
+			}
+		}
+		rate := float64(lost) / n
+		burst := float64(lost) / float64(bursts)
+		if math.Abs(rate-c.loss) > 0.01 || math.Abs(burst-c.burst) > 0.1*c.burst {
+			t.Errorf("loss %.2f burst %.1f: measured %.3f and %.2f", c.loss, c.burst, rate, burst)
+		}
+	}
+	if NewGilbertElliott(0, 2).Lost(rand.New(rand.NewSource(1))) {
+		t.Error("lossless channel lost a packet")
+	}
+}
+
+func TestTrace(t *testing.T) {
+	n := Network{Loss: 0.1, Burst: 2, JitterMs: 15, Reorder: 0.05, BufferMs: 40, Seed: 7}
+	a := n.Trace(1000, 20)
+	b := n.Trace(1000, 20)
+	late, reordered := 0, 0
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatal("trace is not reproducible")
+		}
+		if !a[i].Lost && !a[i].Arrives(n.BufferMs) {
+			late++
+		}
+		if i > 0 && a[i].Delay+20 < a[i-1].Delay {
+			reordered++
+		}
+	}
+	if late == 0 || reordered == 0 {
+		t.Fatalf("%d late and %d reordered packets", late, reordered)
+	}
+
+	/* Changing the jitter leaves the losses where they were */
+	n.JitterMs = 0
+	for i, c := range n.Trace(1000, 20) {
+		if c.Lost != a[i].Lost {
+			t.Fatal("losses depend on the jitter")
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	pcm := testSpeech(4 * 48000)
+	c := DefaultConfig()
+	clean, err := Run(pcm, c, Network{BufferMs: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clean.Lost+clean.Late+clean.Recovered+clean.Concealed != 0 || clean.Frames != 200 {
+		t.Fatalf("lossless run: %+v", clean)
+	}
+	if math.Abs(clean.Bitrate-24000) > 4000 || clean.Goodput != clean.Bitrate {
+		t.Fatalf("%.0f bit/s sent, %.0f received", clean.Bitrate, clean.Goodput)
+	}
+
+	lossy := Network{Loss: 0.2, Burst: 1, BufferMs: 40, Seed: 1}
+	plc, err := Run(pcm, c, lossy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.InbandFEC = true
+	c.PacketLossPercent = 20
+	fec, err := Run(pcm, c, lossy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("MOS %.2f clean, %.2f with PLC, %.2f with FEC (%d of %d recovered), %.0f bit/s", clean.MOS, plc.MOS, fec.MOS, fec.Recovered, fec.Lost, fec.Bitrate)
+	if plc.Recovered != 0 || plc.Concealed != plc.Lost || plc.Lost == 0 {
+		t.Fatalf("run without FEC: %+v", plc)
+	}
+	if fec.Lost != plc.Lost || fec.Recovered+fec.Concealed != fec.Lost || fec.Recovered < fec.Lost/2 {
+		t.Fatalf("run with FEC: %+v", fec)
+	}
+	if plc.MOS >= clean.MOS {
+		t.Fatalf("MOS %.2f clean, %.2f with PLC", clean.MOS, plc.MOS)
+	}
+
+	/* FEC needs the next packet a frame before it is due. Without the
+	   buffering the same stream is only concealed, and sounds worse. */
+	late, err := Run(pcm, c, Network{Loss: 0.2, Burst: 1, BufferMs: 10, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if late.Recovered != 0 || late.Lost != fec.Lost {
+		t.Fatalf("run without buffering: %+v", late)
+	}
+	if fec.MOS <= late.MOS {
+		t.Fatalf("MOS %.2f with FEC, %.2f with PLC alone", fec.MOS, late.MOS)
+	}
+
+	var sum Result
+	sum.Add(clean)
+	sum.Add(plc)
+	if sum.Frames != 400 || sum.Lost != plc.Lost || math.Abs(sum.MOS-(clean.MOS+plc.MOS)/2) > 1e-9 {
+		t.Fatalf("sum %+v", sum)
+	}
+}
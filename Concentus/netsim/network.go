@@ -0,0 +1,97 @@
+// Package netsim simulates sending Opus packets over an impaired network,
+// to pick the encoder's loss settings for the conditions at hand.
+//
+// Packets go through a Gilbert–Elliott burst loss model, are delayed by a
+// random jitter and sometimes held back behind the next packet, and are
+// played out by a receiver with a fixed buffer: a packet that is missing at
+// its playout time is rebuilt from the in-band FEC of the packet after it
+// when that one has arrived, and concealed by the decoder's PLC otherwise.
+package netsim
+
+import (
+	"math"
+	"math/rand"
+)
+
+// GilbertElliott is the two-state burst loss model. The channel moves from
+// the good to the bad state with probability P and back with probability R
+// at each packet, and delivers packets with probability K in the good state
+// and H in the bad one.
+type GilbertElliott struct {
+	P, R float64
+	K, H float64
+	bad  bool
+}
+
+// NewGilbertElliott returns the model with the given mean loss rate and mean
+// burst length in packets, losing every packet in the bad state and none in
+// the good one. A burst length of 1 gives independent losses.
+func NewGilbertElliott(loss float64, burst float64) *GilbertElliott {
+	g := &GilbertElliott{K: 1, H: 0, R: 1}
+	if loss <= 0 {
+		return g
+	}
+	if loss >= 1 {
+		g.P, g.R = 1, 0
+		return g
+	}
+	g.R = 1 / math.Max(burst, 1)
+	g.P = math.Min(1, loss*g.R/(1-loss))
+	return g
+}
+
+// Lost advances the model by one packet and reports whether it is lost.
+func (g *GilbertElliott) Lost(rng *rand.Rand) bool {
+	if g.bad {
+		g.bad = rng.Float64() >= g.R
+	} else {
+		g.bad = rng.Float64() < g.P
+	}
+	keep := g.K
+	if g.bad {
+		keep = g.H
+	}
+	return rng.Float64() >= keep
+}
+
+// Network describes the impairments between the encoder and the decoder.
+type Network struct {
+	Loss     float64 // mean packet loss rate, 0 to 1
+	Burst    float64 // mean length of loss bursts, in packets
+	JitterMs float64 // standard deviation of the delay
+	Reorder  float64 // probability that a packet is held back by one packet
+	BufferMs float64 // playout delay of the receiver
+	Seed     int64
+}
+
+// Arrival is what happens to one packet.
+type Arrival struct {
+	Lost  bool
+	Delay float64 // ms after the packet was sent
+}
+
+// Trace draws the fate of n packets sent every frameMs.
+func (n Network) Trace(count int, frameMs float64) []Arrival {
+	rng := rand.New(rand.NewSource(n.Seed))
+	loss := NewGilbertElliott(n.Loss, n.Burst)
+	out := make([]Arrival, count)
+	for i := range out {
+		/* Draw the same numbers whatever the settings, so that runs which
+		   differ in one setting see the same network */
+		lost := loss.Lost(rng)
+		jitter := math.Abs(rng.NormFloat64()) * n.JitterMs
+		held := rng.Float64() < n.Reorder
+		out[i].Lost = lost
+		out[i].Delay = jitter
+		if held {
+			out[i].Delay += frameMs
+		}
+	}
+	return out
+}
+
+// Arrives reports whether the packet reaches the receiver no later than ms
+// after it was sent.
+func (a Arrival) Arrives(ms float64) bool {
+	return !a.Lost && a.Delay <= ms
+}
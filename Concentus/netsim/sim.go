@@ -0,0 +1,174 @@
+package netsim
+
+import (
+	"concentus/opus"
+	"concentus/quality"
+	"errors"
+)
+
+// Config holds the encoder settings under test.
+type Config struct {
+	Fs                int
+	Channels          int
+	FrameSize         int // samples per channel
+	Bitrate           int
+	Complexity        int
+	PacketLossPercent int
+	InbandFEC         bool
+	SilkOnly          bool
+}
+
+// DefaultConfig is wideband mono speech at 24 kbit/s in 20 ms frames.
+func DefaultConfig() Config {
+	return Config{
+		Fs:         48000,
+		Channels:   1,
+		FrameSize:  960,
+		Bitrate:    24000,
+		Complexity: 10,
+		SilkOnly:   true,
+	}
+}
+
+// Result sums up a run.
+type Result struct {
+	Frames    int     // frames played out
+	Lost      int     // frames whose packet the network dropped
+	Late      int     // frames whose packet arrived after its playout time
+	Recovered int     // missing frames rebuilt from the FEC of the next packet
+	Concealed int     // missing frames filled in by PLC
+	Bitrate   float64 // bits per second sent
+	Goodput   float64 // bits per second that arrived in time
+	MOS       float64 // quality.Perceptual of the decoded audio
+}
+
+// Add merges the result of another run, weighting the rates and the score
+// by duration.
+func (r *Result) Add(o Result) {
+	total := r.Frames + o.Frames
+	if total == 0 {
+		return
+	}
+	mix := func(a, b float64) float64 {
+		return (a*float64(r.Frames) + b*float64(o.Frames)) / float64(total)
+	}
+	r.Bitrate = mix(r.Bitrate, o.Bitrate)
+	r.Goodput = mix(r.Goodput, o.Goodput)
+	r.MOS = mix(r.MOS, o.MOS)
+	r.Frames = total
+	r.Lost += o.Lost
+	r.Late += o.Late
+	r.Recovered += o.Recovered
+	r.Concealed += o.Concealed
+}
+
+// Encode codes interleaved pcm with the settings of c, padding the last
+// frame with silence.
+func Encode(pcm []int16, c Config) ([][]byte, error) {
+	enc, err := opus.NewOpusEncoder(c.Fs, c.Channels, opus.OPUS_APPLICATION_VOIP)
+	if err != nil {
+		return nil, err
+	}
+	if c.SilkOnly {
+		enc.SetForceMode(opus.MODE_SILK_ONLY)
+		enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+	}
+	enc.SetBitrate(c.Bitrate)
+	enc.SetComplexity(c.Complexity)
+	enc.SetPacketLossPercent(c.PacketLossPercent)
+	enc.SetUseInbandFEC(c.InbandFEC)
+
+	var packets [][]byte
+	buf := make([]byte, 1275)
+	frame := make([]int16, c.FrameSize*c.Channels)
+	for off := 0; off < len(pcm); off += len(frame) {
+		n := copy(frame, pcm[off:])
+		clear(frame[n:])
+		ret, err := enc.Encode(frame, 0, c.FrameSize, buf, 0, len(buf))
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, append([]byte(nil), buf[:ret]...))
+	}
+	return packets, nil
+}
+
+// Receive plays the packets out through the network: a packet that is
+// missing at its playout time is rebuilt from the FEC of the next packet
+// when that has arrived, and concealed otherwise. It returns the decoded
+// audio, interleaved.
+func Receive(packets [][]byte, c Config, n Network, r *Result) ([]int16, error) {
+	dec, err := opus.NewOpusDecoder(c.Fs, c.Channels)
+	if err != nil {
+		return nil, err
+	}
+	frameMs := float64(c.FrameSize) * 1000 / float64(c.Fs)
+	trace := n.Trace(len(packets), frameMs)
+	out := make([]int16, len(packets)*c.FrameSize*c.Channels)
+	bits := 0
+	for i, packet := range packets {
+		pcm := out[i*c.FrameSize*c.Channels:]
+		r.Frames++
+		if trace[i].Arrives(n.BufferMs) {
+			bits += 8 * len(packet)
+			if _, err := dec.Decode(packet, 0, len(packet), pcm, 0, c.FrameSize, false); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if trace[i].Lost {
+			r.Lost++
+		} else {
+			r.Late++
+		}
+		/* The next packet is due a frame later, so it must have been early */
+		var report opus.OpusConcealment
+		if i+1 < len(packets) && trace[i+1].Arrives(n.BufferMs-frameMs) {
+			next := packets[i+1]
+			report, err = dec.DecodeFEC(next, 0, len(next), pcm, 0, c.FrameSize)
+		} else {
+			report, err = dec.DecodeLost(pcm, 0, c.FrameSize)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if report.FEC {
+			r.Recovered++
+		} else {
+			r.Concealed++
+		}
+	}
+	seconds := float64(len(packets)) * frameMs / 1000
+	if seconds > 0 {
+		r.Goodput = float64(bits) / seconds
+	}
+	return out, nil
+}
+
+// Run encodes pcm with c, sends it through n and scores the decoded audio
+// against pcm.
+func Run(pcm []int16, c Config, n Network) (Result, error) {
+	var r Result
+	if c.Channels <= 0 || c.FrameSize <= 0 || len(pcm) < c.FrameSize*c.Channels {
+		return r, errors.New("netsim: input shorter than one frame")
+	}
+	packets, err := Encode(pcm, c)
+	if err != nil {
+		return r, err
+	}
+	bits := 0
+	for _, p := range packets {
+		bits += 8 * len(p)
+	}
+	r.Bitrate = float64(bits) * float64(c.Fs) / float64(len(packets)*c.FrameSize)
+	out, err := Receive(packets, c, n, &r)
+	if err != nil {
+		return r, err
+	}
+	score, err := quality.Perceptual(pcm, out[:len(pcm)], c.Channels, c.Fs)
+	if err != nil {
+		return r, err
+	}
+	r.MOS = score.MOS
+	return r, nil
+}
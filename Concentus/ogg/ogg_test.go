@@ -0,0 +1,211 @@
+package ogg
+
+import (
+	"bytes"
+	"concentus/opus"
+	"io"
+	"math"
+	"testing"
+)
+
+// A chirp repeating every second, so that any misalignment shows.
+func testSignal(samples int, offset int) []int16 {
+	pcm := make([]int16, samples)
+	phase := 0.0
+	for i := 0; i < offset+samples; i++ {
+		t := float64(i%48000) / 48000
+		phase += 2 * math.Pi * (150 + 900*t) / 48000
+		if i >= offset {
+			pcm[i-offset] = int16(7000 * math.Sin(phase))
+		}
+	}
+	return pcm
+}
+
+// Writes a stream of frames 20 ms packets, the last one trimmed by discard
+// samples, and returns the number of output samples.
+func writeTestStream(t *testing.T, out io.Writer, serial uint32, frames int, discard int) int64 {
+	enc, err := opus.NewOpusEncoder(48000, 1, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(opus.MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(32000)
+	head := enc.GetOpusHead()
+	w, err := NewWriter(out, head, serial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signal := testSignal(frames*960, 0)
+	buf := make([]byte, 1275)
+	for f := 0; f < frames; f++ {
+		n, err := enc.Encode(signal, f*960, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f == frames-1 {
+			err = w.WritePacketTrimmed(buf[:n], discard)
+		} else {
+			err = w.WritePacket(buf[:n])
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return int64(frames*960 - head.PreSkip - discard)
+}
+
+func readAll(t *testing.T, r *Reader) []int16 {
+	t.Helper()
+	var out []int16
+	pcm := make([]int16, 1000)
+	for {
+		n, err := r.Read(pcm)
+		if err == io.EOF {
+			return out
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, pcm[:n]...)
+	}
+}
+
+// The error energy between a and b shifted by lag, relative to a.
+func mismatch(a []int16, b []int16, lag int) float64 {
+	var e, s float64
+	for i := 20; i < len(a)-20; i++ {
+		d := float64(a[i]) - float64(b[i+lag])
+		e += d * d
+		s += float64(a[i]) * float64(a[i])
+	}
+	return e / s
+}
+
+func TestOggSeek(t *testing.T) {
+	/* Two links of 20 s, large enough for the pages to be bisected */
+	var file bytes.Buffer
+	first := writeTestStream(t, &file, 1, 1000, 333)
+	second := writeTestStream(t, &file, 2, 1000, 0)
+
+	r, err := NewReader(bytes.NewReader(file.Bytes()), 48000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Links() != 2 || r.Length() != first+second {
+		t.Fatalf("%d links, length %d, want %d", r.Links(), r.Length(), first+second)
+	}
+	ref := readAll(t, r)
+	if int64(len(ref)) != r.Length() {
+		t.Fatalf("decoded %d samples, want %d", len(ref), r.Length())
+	}
+
+	const window = 4800
+	for _, target := range []int64{0, 1, 12345, 5*48000 + 7, first - window, first, first + 100, first + second - window} {
+		pos, err := r.Seek(target, io.SeekStart)
+		if err != nil || pos != target {
+			t.Fatalf("seek to %d: %d, %v", target, pos, err)
+		}
+		got := readAll(t, r)
+		if int64(len(got)) != r.Length()-target {
+			t.Fatalf("seek to %d: %d samples to the end, want %d", target, len(got), r.Length()-target)
+		}
+		/* The output after the pre-roll matches continuous decoding, best
+		   at no lag */
+		want := ref[target : target+window]
+		padded := append(append(make([]int16, 8), got[:window]...), make([]int16, 8)...)
+		e := mismatch(want, padded[8:], 0)
+		if e > 0.01 {
+			t.Fatalf("seek to %d: relative error %.4f", target, e)
+		}
+		for lag := -8; lag <= 8; lag++ {
+			if lag != 0 && mismatch(want, padded[8:], lag) <= e {
+				t.Fatalf("seek to %d: output is better aligned at lag %d", target, lag)
+			}
+		}
+	}
+
+	if pos, _ := r.Seek(-1000, io.SeekEnd); pos != r.Length()-1000 {
+		t.Fatalf("seek from the end landed at %d", pos)
+	}
+	pcm := make([]int16, 100)
+	n, _ := r.Read(pcm)
+	if pos, _ := r.Seek(0, io.SeekCurrent); n == 0 || pos != r.Length()-1000+int64(n) {
+		t.Fatalf("position %d after reading %d samples", pos, n)
+	}
+	if _, err := r.Seek(-1, io.SeekStart); err == nil {
+		t.Fatal("seeking before the start succeeded")
+	}
+}
+
+func TestOggResampledLength(t *testing.T) {
+	var file bytes.Buffer
+	length := writeTestStream(t, &file, 7, 100, 960-3*200)
+	r, err := NewReader(bytes.NewReader(file.Bytes()), 16000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(readAll(t, r)); int64(got) != length/3 {
+		t.Fatalf("decoded %d samples at 16 kHz, want %d", got, length/3)
+	}
+}
+
+func TestOggPageAssembly(t *testing.T) {
+	/* 1000-byte packets of 10 ms take four lacing values each, so they
+	   end up spanning pages */
+	var file bytes.Buffer
+	head := &opus.OpusHead{Version: 1, Channels: 1, StreamCount: 1, ChannelMapping: []byte{0}}
+	w, _ := NewWriter(&file, head, 9)
+	var packets [][]byte
+	for i := 0; i < 200; i++ {
+		p := bytes.Repeat([]byte{byte(i)}, 1000)
+		p[0] = 0x00 /* SILK NB 10 ms, one frame */
+		packets = append(packets, p)
+		w.WritePacket(p)
+	}
+	w.Close()
+
+	data := file.Bytes()
+	r := bytes.NewReader(data)
+	var got [][]byte
+	var partial []byte
+	continued := false
+	for pos := int64(0); pos < int64(len(data)); {
+		p, err := readPage(r, pos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pos += p.size
+		if p.seq < 2 {
+			continue
+		}
+		continued = continued || p.flags&flagContinued != 0
+		got = append(got, assemble(p, &partial)...)
+	}
+	if !continued || len(got) != len(packets) {
+		t.Fatalf("%d packets, continued pages %v", len(got), continued)
+	}
+	for i := range got {
+		if !bytes.Equal(got[i], packets[i]) {
+			t.Fatalf("packet %d differs", i)
+		}
+	}
+
+	/* Starting on a continued page drops the orphaned tail */
+	for pos := int64(0); ; {
+		p, _ := readPage(r, pos)
+		pos += p.size
+		if p.flags&flagContinued != 0 {
+			partial = nil
+			done := assemble(p, &partial)
+			if len(done) == 0 || done[0][0] != 0x00 || len(done[0]) != 1000 {
+				t.Fatalf("assembled %d packets from a continued page", len(done))
+			}
+			break
+		}
+	}
+	if p, err := findPage(r, 1); err != nil || p == nil || p.seq != 1 {
+		t.Fatalf("findPage: %v", err)
+	}
+}
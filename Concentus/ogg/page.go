@@ -0,0 +1,162 @@
+package ogg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	flagContinued = 0x01
+	flagBOS       = 0x02
+	flagEOS       = 0x04
+
+	headerSize  = 27
+	maxPageSize = headerSize + 255 + 255*255
+
+	/* The granule position of pages on which no packet ends */
+	noGranule = -1
+)
+
+var errMalformed = errors.New("ogg: malformed page")
+
+var crcTable = func() (t [256]uint32) {
+	for i := range t {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04C11DB7
+			} else {
+				r <<= 1
+			}
+		}
+		t[i] = r
+	}
+	return
+}()
+
+func crc(b []byte) uint32 {
+	c := uint32(0)
+	for _, x := range b {
+		c = c<<8 ^ crcTable[byte(c>>24)^x]
+	}
+	return c
+}
+
+type page struct {
+	offset  int64 // in the file
+	size    int64
+	flags   byte
+	granule int64
+	serial  uint32
+	seq     uint32
+	lacing  []byte
+	body    []byte
+}
+
+// Builds a page with its checksum.
+func appendPage(b []byte, flags byte, granule int64, serial uint32, seq uint32, lacing []byte, body []byte) []byte {
+	start := len(b)
+	b = append(b, "OggS"...)
+	b = append(b, 0, flags)
+	b = binary.LittleEndian.AppendUint64(b, uint64(granule))
+	b = binary.LittleEndian.AppendUint32(b, serial)
+	b = binary.LittleEndian.AppendUint32(b, seq)
+	b = append(b, 0, 0, 0, 0)
+	b = append(b, byte(len(lacing)))
+	b = append(b, lacing...)
+	b = append(b, body...)
+	binary.LittleEndian.PutUint32(b[start+22:], crc(b[start:]))
+	return b
+}
+
+// Reads and checks the page at offset.
+func readPage(r io.ReadSeeker, offset int64) (*page, error) {
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	hdr := make([]byte, headerSize, maxPageSize)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	if string(hdr[:4]) != "OggS" || hdr[4] != 0 {
+		return nil, errMalformed
+	}
+	nsegs := int(hdr[26])
+	hdr = hdr[:headerSize+nsegs]
+	if _, err := io.ReadFull(r, hdr[headerSize:]); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	bodySize := 0
+	for _, l := range hdr[headerSize:] {
+		bodySize += int(l)
+	}
+	b := hdr[:len(hdr)+bodySize]
+	if _, err := io.ReadFull(r, b[len(hdr):]); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	sum := binary.LittleEndian.Uint32(b[22:])
+	binary.LittleEndian.PutUint32(b[22:], 0)
+	if crc(b) != sum {
+		return nil, errMalformed
+	}
+	return &page{
+		offset:  offset,
+		size:    int64(len(b)),
+		flags:   b[5],
+		granule: int64(binary.LittleEndian.Uint64(b[6:])),
+		serial:  binary.LittleEndian.Uint32(b[14:]),
+		seq:     binary.LittleEndian.Uint32(b[18:]),
+		lacing:  b[headerSize : headerSize+nsegs],
+		body:    b[headerSize+nsegs:],
+	}, nil
+}
+
+// Finds the first valid page starting at or after from, or returns nil when
+// the end of the file is reached first.
+func findPage(r io.ReadSeeker, from int64) (*page, error) {
+	buf := make([]byte, 65536)
+	for {
+		if _, err := r.Seek(from, io.SeekStart); err != nil {
+			return nil, err
+		}
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, err
+		}
+		for i := 0; i+4 <= n; {
+			j := bytes.Index(buf[i:n], []byte("OggS"))
+			if j < 0 {
+				break
+			}
+			if p, err := readPage(r, from+int64(i+j)); err == nil {
+				return p, nil
+			}
+			i += j + 1
+		}
+		if n < len(buf) {
+			return nil, nil
+		}
+		from += int64(n - 3)
+	}
+}
+
+// Splits the body of a page into packet fragments. The first one continues
+// a packet from the previous page when the page has flagContinued; the last
+// one is continued on the next page when complete is false.
+func (p *page) segments() (fragments [][]byte, complete bool) {
+	start, pos := 0, 0
+	complete = true
+	for i, l := range p.lacing {
+		pos += int(l)
+		if l < 255 {
+			fragments = append(fragments, p.body[start:pos])
+			start = pos
+		} else if i == len(p.lacing)-1 {
+			fragments = append(fragments, p.body[start:pos])
+			complete = false
+		}
+	}
+	return
+}
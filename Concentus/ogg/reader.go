@@ -0,0 +1,483 @@
+package ogg
+
+import (
+	"concentus/opus"
+	"errors"
+	"io"
+)
+
+const (
+	/* Decoding starts this long before a seek target, so that the decoder
+	   has converged by the time the target is reached */
+	preRoll = 3840
+
+	/* Below this many bytes, bisection gives way to a linear scan */
+	bisectMin = 65536
+)
+
+// A logical stream of a chained file.
+type link struct {
+	offset     int64 // of the BOS page
+	dataOffset int64 // of the first audio page
+	end        int64 // after the last page
+	serial     uint32
+	head       *opus.OpusHead
+	tags       *opus.OpusTags
+	begin      int64 // granule position of the first audio sample
+	last       int64 // granule position of the last page
+	pcmOffset  int64 // output samples in the previous links
+}
+
+// First and last granule positions of the output.
+func (l *link) start() int64 {
+	return l.begin + int64(l.head.PreSkip)
+}
+
+func (l *link) length() int64 {
+	return max(0, l.last-l.start())
+}
+
+// GainMode selects the gain Reader applies on top of the output gain of the
+// identification header.
+type GainMode int
+
+const (
+	HeaderGain GainMode = iota // the output gain alone
+	TrackGain                  // plus R128_TRACK_GAIN, normalising tracks to -23 LUFS
+	AlbumGain                  // plus R128_ALBUM_GAIN, or the track gain without one
+)
+
+type packet struct {
+	data []byte
+	end  int64 // granule position
+}
+
+// Reader decodes an Ogg Opus file, which may be chained, to PCM. Seeking
+// is sample accurate: Seek bisects the pages by granule position, decodes
+// from 80 ms before the target and discards the output up to it.
+//
+// Only files where the Opus stream is not multiplexed with other streams
+// are supported, and each link must have a mapping that OpusDecoder can
+// decode, that is mono or stereo.
+type Reader struct {
+	r     io.ReadSeeker
+	rate  int
+	links []*link
+
+	gain    GainMode
+	cur     int
+	dec     *opus.OpusDecoder
+	pos     int64 // next page to read
+	queue   []packet
+	granule int64 // of the last page read, noGranule if unknown
+	partial []byte
+	target  int64 // granule position output starts at, after a seek
+
+	pcm      []int16 // decoded samples not returned yet
+	position int64   // output samples returned so far, over all links
+}
+
+// NewReader opens an Ogg Opus file for decoding at the sample rate Fs. The
+// links of a chained file are located by bisection, without reading the
+// whole file.
+func NewReader(r io.ReadSeeker, Fs int) (*Reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	rd := &Reader{r: r, rate: Fs}
+	offset, pcm := int64(0), int64(0)
+	for offset < size {
+		l, err := rd.readLink(offset, size)
+		if err != nil {
+			return nil, err
+		}
+		l.pcmOffset = pcm
+		pcm += l.length()
+		rd.links = append(rd.links, l)
+		offset = l.end
+	}
+	if len(rd.links) == 0 {
+		return nil, errors.New("ogg: no Opus stream")
+	}
+	if err := rd.openLink(0); err != nil {
+		return nil, err
+	}
+	return rd, nil
+}
+
+// Reads the headers of the link starting at offset and finds its end.
+func (rd *Reader) readLink(offset int64, size int64) (*link, error) {
+	p, err := readPage(rd.r, offset)
+	if err != nil {
+		return nil, err
+	}
+	fragments, complete := p.segments()
+	if p.flags&flagBOS == 0 || len(fragments) != 1 || !complete {
+		return nil, errors.New("ogg: missing Opus identification header")
+	}
+	head, err := opus.ParseOpusHead(fragments[0])
+	if err != nil {
+		return nil, err
+	}
+	l := &link{offset: offset, serial: p.serial, head: head}
+
+	/* The comment header may span several pages */
+	pos := p.offset + p.size
+	var tags []byte
+	for {
+		p, err = readPage(rd.r, pos)
+		if err != nil {
+			return nil, err
+		}
+		pos += p.size
+		if p.serial != l.serial {
+			return nil, errors.New("ogg: multiplexed streams are not supported")
+		}
+		fragments, complete = p.segments()
+		tags = append(tags, fragments[0]...)
+		if len(fragments) > 1 || complete {
+			if len(fragments) > 1 {
+				return nil, errors.New("ogg: malformed comment header")
+			}
+			break
+		}
+	}
+	if l.tags, err = opus.ParseOpusTags(tags); err != nil {
+		return nil, err
+	}
+	l.dataOffset = pos
+
+	/* The granule position of the first page with a complete packet, less
+	   the duration of the packets ending on it, is where the stream starts */
+	var total int64
+	var partial []byte
+	for pos < size {
+		p, err = readPage(rd.r, pos)
+		if err != nil {
+			return nil, err
+		}
+		if p.serial != l.serial {
+			break
+		}
+		pos += p.size
+		for _, packet := range assemble(p, &partial) {
+			total += int64(opus.GetNumSamples(packet, 0, len(packet), 48000))
+		}
+		if p.granule != noGranule {
+			l.begin = p.granule - total
+			if l.begin < 0 {
+				if p.flags&flagEOS == 0 {
+					return nil, errors.New("ogg: first page ends before its packets")
+				}
+				l.begin = 0
+			}
+			break
+		}
+	}
+	l.last = l.begin
+
+	/* The pages of a link are contiguous: bisect for the first page of
+	   another stream, then finish with a linear scan */
+	lo, hi := l.dataOffset, size
+	for hi-lo > bisectMin {
+		mid := lo + (hi-lo)/2
+		p, err := findPage(rd.r, mid)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil || p.offset >= hi || p.serial != l.serial {
+			hi = mid
+			continue
+		}
+		if p.granule != noGranule {
+			l.last = p.granule
+		}
+		lo = p.offset + p.size
+	}
+	for lo < size {
+		p, err := readPage(rd.r, lo)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if p.serial != l.serial {
+			break
+		}
+		if p.granule != noGranule {
+			l.last = p.granule
+		}
+		lo += p.size
+		if p.flags&flagEOS != 0 {
+			break
+		}
+	}
+	l.end = lo
+	return l, nil
+}
+
+// Starts decoding a link from its first audio page.
+func (rd *Reader) openLink(i int) error {
+	l := rd.links[i]
+	dec, err := l.head.CreateDecoder(rd.rate)
+	if err != nil {
+		return err
+	}
+	rd.cur = i
+	rd.dec = dec
+	if err := rd.applyGain(); err != nil {
+		return err
+	}
+	rd.restart(l.dataOffset, l.begin, l.start())
+	return nil
+}
+
+// Resumes reading at the page at pos, which follows a page with the given
+// granule position.
+func (rd *Reader) restart(pos int64, granule int64, target int64) {
+	rd.pos = pos
+	rd.granule = granule
+	rd.queue = rd.queue[:0]
+	rd.partial = nil
+	rd.target = target
+	rd.pcm = nil
+}
+
+// Head returns the identification header of the link being decoded.
+func (rd *Reader) Head() *opus.OpusHead {
+	return rd.links[rd.cur].head
+}
+
+// Tags returns the comment header of the link being decoded.
+func (rd *Reader) Tags() *opus.OpusTags {
+	return rd.links[rd.cur].tags
+}
+
+// SetGainMode selects which gain is applied to the output, from now on and
+// for the links that follow.
+func (rd *Reader) SetGainMode(mode GainMode) error {
+	rd.gain = mode
+	return rd.applyGain()
+}
+
+func (rd *Reader) applyGain() error {
+	l := rd.links[rd.cur]
+	gain := l.head.OutputGain
+	track, hasTrack := l.tags.GetR128TrackGain()
+	album, hasAlbum := l.tags.GetR128AlbumGain()
+	switch {
+	case rd.gain == AlbumGain && hasAlbum:
+		gain += album
+	case rd.gain != HeaderGain && hasTrack:
+		gain += track
+	}
+	return rd.dec.SetGain(max(-32768, min(32767, gain)))
+}
+
+// Links returns the number of links in a chained file.
+func (rd *Reader) Links() int {
+	return len(rd.links)
+}
+
+// Length returns the number of output samples in the file, at 48 kHz,
+// over all links.
+func (rd *Reader) Length() int64 {
+	l := rd.links[len(rd.links)-1]
+	return l.pcmOffset + l.length()
+}
+
+// Returns the packets ending on a page. partial holds the start of a packet
+// continued on the next page; without it, the end of a packet continued from
+// a page that was not read, as after a seek, is dropped.
+func assemble(p *page, partial *[]byte) [][]byte {
+	fragments, complete := p.segments()
+	if p.flags&flagContinued == 0 {
+		*partial = nil
+	} else if *partial == nil && len(fragments) > 0 {
+		fragments = fragments[1:]
+		if len(fragments) == 0 {
+			return nil
+		}
+	}
+	var done [][]byte
+	for i, f := range fragments {
+		data := append(*partial, f...)
+		*partial = nil
+		if i == len(fragments)-1 && !complete {
+			*partial = data
+		} else {
+			done = append(done, data)
+		}
+	}
+	return done
+}
+
+// Returns the next packet of the current link, or io.EOF at its end.
+func (rd *Reader) nextPacket() (packet, error) {
+	l := rd.links[rd.cur]
+	for len(rd.queue) == 0 {
+		if rd.pos >= l.end {
+			return packet{}, io.EOF
+		}
+		p, err := readPage(rd.r, rd.pos)
+		if err != nil {
+			return packet{}, err
+		}
+		rd.pos += p.size
+		if p.serial != l.serial {
+			continue
+		}
+		done := assemble(p, &rd.partial)
+		if p.granule == noGranule {
+			continue
+		}
+		packets := make([]packet, len(done))
+		if p.flags&flagEOS != 0 && rd.granule != noGranule {
+			/* The final page may be trimmed, count from the previous one */
+			end := rd.granule
+			for i := range done {
+				end += int64(opus.GetNumSamples(done[i], 0, len(done[i]), 48000))
+				packets[i] = packet{done[i], end}
+			}
+		} else {
+			/* The granule position is that of the last packet ending here */
+			end := p.granule
+			for i := len(done) - 1; i >= 0; i-- {
+				packets[i] = packet{done[i], end}
+				end -= int64(opus.GetNumSamples(done[i], 0, len(done[i]), 48000))
+			}
+		}
+		rd.granule = p.granule
+		rd.queue = append(rd.queue, packets...)
+	}
+	p := rd.queue[0]
+	rd.queue = rd.queue[1:]
+	return p, nil
+}
+
+// Read decodes into pcm, interleaved, and returns the number of samples per
+// channel. Output never spans two links, whose channel counts may differ;
+// check Head after each call. Returns io.EOF at the end of the file.
+func (rd *Reader) Read(pcm []int16) (int, error) {
+	for len(rd.pcm) == 0 {
+		if err := rd.decodeNext(); err != nil {
+			return 0, err
+		}
+	}
+	channels := rd.Head().Channels
+	n := min(len(pcm)/channels, len(rd.pcm)/channels)
+	copy(pcm, rd.pcm[:n*channels])
+	rd.pcm = rd.pcm[n*channels:]
+	rd.position += int64(n * 48000 / rd.rate)
+	return n, nil
+}
+
+// Decodes a packet into rd.pcm, keeping only what is part of the output.
+func (rd *Reader) decodeNext() error {
+	p, err := rd.nextPacket()
+	if err == io.EOF {
+		if rd.cur+1 == len(rd.links) {
+			return io.EOF
+		}
+		return rd.openLink(rd.cur + 1)
+	}
+	if err != nil {
+		return err
+	}
+	l := rd.links[rd.cur]
+	channels := l.head.Channels
+	buf := make([]int16, rd.rate*120/1000*channels)
+	n, err := rd.dec.Decode(p.data, 0, len(p.data), buf, 0, len(buf)/channels, false)
+	if err != nil {
+		return err
+	}
+	start := p.end - int64(opus.GetNumSamples(p.data, 0, len(p.data), 48000))
+	from := max(start, rd.target)
+	to := min(p.end, l.last)
+	if from >= to {
+		return nil
+	}
+	skip := int((from - start) * int64(rd.rate) / 48000)
+	stop := min(n, int((to-start)*int64(rd.rate)/48000))
+	rd.pcm = buf[skip*channels : stop*channels]
+	rd.position = l.pcmOffset + from - l.start()
+	return nil
+}
+
+// Seek moves to an output sample position, in samples at 48 kHz over all
+// links, and returns the new position. Seeking past the end positions the
+// reader at the end.
+func (rd *Reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += rd.position
+	case io.SeekEnd:
+		offset += rd.Length()
+	}
+	if offset < 0 {
+		return 0, errors.New("ogg: seek before the start of the file")
+	}
+	offset = min(offset, rd.Length())
+	i := len(rd.links) - 1
+	for i > 0 && rd.links[i].pcmOffset > offset {
+		i--
+	}
+	if i != rd.cur {
+		if err := rd.openLink(i); err != nil {
+			return 0, err
+		}
+	}
+	l := rd.links[i]
+	target := l.start() + offset - l.pcmOffset
+	pos, granule, err := rd.bisect(l, target-preRoll)
+	if err != nil {
+		return 0, err
+	}
+	rd.dec.ResetState()
+	rd.restart(pos, granule, target)
+	rd.position = offset
+	return offset, nil
+}
+
+// Finds where to start reading so that the first packet decoded starts at
+// or before granule: just after the last page that ends at or before it.
+// Returns that position and the granule position of the page before it.
+func (rd *Reader) bisect(l *link, granule int64) (int64, int64, error) {
+	best, bestGranule := l.dataOffset, l.begin
+	lo, hi := l.dataOffset, l.end
+	for hi-lo > bisectMin {
+		mid := lo + (hi-lo)/2
+		p, err := findPage(rd.r, mid)
+		if err != nil {
+			return 0, 0, err
+		}
+		if p == nil || p.offset >= hi {
+			hi = mid
+			continue
+		}
+		if p.granule != noGranule && p.granule > granule {
+			hi = mid
+			continue
+		}
+		lo = p.offset + p.size
+		if p.granule != noGranule {
+			best, bestGranule = lo, p.granule
+		}
+	}
+	for lo < hi {
+		p, err := readPage(rd.r, lo)
+		if err != nil {
+			return 0, 0, err
+		}
+		lo += p.size
+		if p.granule != noGranule {
+			if p.granule > granule {
+				break
+			}
+			best, bestGranule = lo, p.granule
+		}
+	}
+	return best, bestGranule, nil
+}
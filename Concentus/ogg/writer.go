@@ -0,0 +1,161 @@
+// Package ogg reads and writes Opus audio in Ogg files, as specified by
+// RFC 7845.
+//
+// Positions are counted in samples at 48 kHz. Granule positions include the
+// pre-skip; the positions used by Reader do not, so position 0 is the first
+// sample of decoded output.
+package ogg
+
+import (
+	"concentus/opus"
+	"errors"
+	"io"
+)
+
+const (
+	/* A page is written once it holds this much audio, bounding both the
+	   muxing delay and the granularity of seeking */
+	maxPageDuration = 48000
+)
+
+// Writer muxes Opus packets into a logical Ogg stream. Several streams may
+// be written one after the other to the same destination to make a chained
+// file, as long as their serial numbers differ.
+type Writer struct {
+	w      io.Writer
+	serial uint32
+	seq    uint32
+
+	lacing   []byte
+	body     []byte
+	flags    byte  // of the page being built
+	duration int   // audio on the page being built
+	ended    int64 // granule position of the last packet ending on the page
+	granule  int64 // 48 kHz samples written so far, pre-skip included
+	closed   bool
+}
+
+// NewWriter writes the identification and comment headers for an Opus
+// stream described by head, which is usually obtained with GetOpusHead from
+// the encoder.
+func NewWriter(w io.Writer, head *opus.OpusHead, serial uint32) (*Writer, error) {
+	return NewWriterWithTags(w, head, opus.NewOpusTags(), serial)
+}
+
+// NewWriterWithTags is NewWriter with the given comment header, which may
+// for instance carry R128 gains.
+func NewWriterWithTags(w io.Writer, head *opus.OpusHead, tags *opus.OpusTags, serial uint32) (*Writer, error) {
+	wr := &Writer{w: w, serial: serial, ended: noGranule}
+	b := appendPage(nil, flagBOS, 0, serial, 0, lacingFor(head.Marshal()), head.Marshal())
+	wr.seq = 1
+
+	/* The comment header gets pages of its own, as many as it needs */
+	data := tags.Marshal()
+	lacing := lacingFor(data)
+	flags := byte(0)
+	for len(lacing) > 0 {
+		n := min(len(lacing), 255)
+		size := 0
+		for _, l := range lacing[:n] {
+			size += int(l)
+		}
+		b = appendPage(b, flags, 0, serial, wr.seq, lacing[:n], data[:size])
+		wr.seq++
+		lacing, data = lacing[n:], data[size:]
+		flags = flagContinued
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	return wr, nil
+}
+
+// The lacing values of a packet.
+func lacingFor(packet []byte) []byte {
+	lacing := make([]byte, len(packet)/255+1)
+	for i := range lacing {
+		lacing[i] = 255
+	}
+	lacing[len(lacing)-1] = byte(len(packet) % 255)
+	return lacing
+}
+
+// WritePacket appends a packet. Its duration is read from the TOC.
+func (wr *Writer) WritePacket(packet []byte) error {
+	return wr.writePacket(packet, 0, false)
+}
+
+// WritePacketTrimmed appends the final packet of the stream and closes the
+// stream. The last discard samples of the packet, at 48 kHz, are not part
+// of the stream; the granule position of the final page excludes them.
+func (wr *Writer) WritePacketTrimmed(packet []byte, discard int) error {
+	return wr.writePacket(packet, discard, true)
+}
+
+func (wr *Writer) writePacket(packet []byte, discard int, last bool) error {
+	if wr.closed {
+		return errors.New("ogg: writer is closed")
+	}
+	duration := opus.GetNumSamples(packet, 0, len(packet), 48000)
+	if duration < 0 {
+		return opus.ErrorFromCode(duration)
+	}
+	if discard < 0 || discard > duration {
+		return errors.New("ogg: discard exceeds the packet duration")
+	}
+	lacing := lacingFor(packet)
+	for len(lacing) > 0 {
+		if len(wr.lacing) == 255 {
+			/* The packet goes on over the next page */
+			if err := wr.flush(); err != nil {
+				return err
+			}
+			wr.flags = flagContinued
+		}
+		n := min(len(lacing), 255-len(wr.lacing))
+		size := 0
+		for _, l := range lacing[:n] {
+			size += int(l)
+		}
+		wr.lacing = append(wr.lacing, lacing[:n]...)
+		wr.body = append(wr.body, packet[:size]...)
+		lacing, packet = lacing[n:], packet[size:]
+	}
+	wr.granule += int64(duration)
+	wr.duration += duration
+	wr.ended = wr.granule
+	if last {
+		wr.closed = true
+		wr.flags |= flagEOS
+		wr.ended -= int64(discard)
+		return wr.flush()
+	}
+	if wr.duration >= maxPageDuration || len(wr.lacing) == 255 {
+		return wr.flush()
+	}
+	return nil
+}
+
+func (wr *Writer) flush() error {
+	b := appendPage(nil, wr.flags, wr.ended, wr.serial, wr.seq, wr.lacing, wr.body)
+	wr.seq++
+	wr.lacing = wr.lacing[:0]
+	wr.body = wr.body[:0]
+	wr.flags = 0
+	wr.duration = 0
+	wr.ended = noGranule
+	_, err := wr.w.Write(b)
+	return err
+}
+
+// Close writes the last page, marked as the end of the stream. It does not
+// close the underlying writer.
+func (wr *Writer) Close() error {
+	if wr.closed {
+		return nil
+	}
+	wr.closed = true
+	wr.flags |= flagEOS
+	wr.ended = wr.granule
+	return wr.flush()
+}
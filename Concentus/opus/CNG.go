@@ -60,16 +60,18 @@ func silk_CNG(
 		}
 
 		max_Gain_Q16 := 0
-		//subfr := 0
+		subfr := 0
 		for i := 0; i < psDec.nb_subfr; i++ {
 			if psDecCtrl.Gains_Q16[i] > max_Gain_Q16 {
 				max_Gain_Q16 = psDecCtrl.Gains_Q16[i]
-				//	subfr = i
+				subfr = i
 			}
 		}
 
+		/* Update CNG excitation buffer with excitation from this subframe */
 		lengthToMove := (psDec.nb_subfr - 1) * psDec.subfr_length
-		copy(psCNG.CNG_exc_buf_Q14[0:lengthToMove], psCNG.CNG_exc_buf_Q14[psDec.subfr_length:psDec.subfr_length+lengthToMove])
+		copy(psCNG.CNG_exc_buf_Q14[psDec.subfr_length:psDec.subfr_length+lengthToMove], psCNG.CNG_exc_buf_Q14[0:lengthToMove])
+		copy(psCNG.CNG_exc_buf_Q14[0:psDec.subfr_length], psDec.exc_Q14[subfr*psDec.subfr_length:(subfr+1)*psDec.subfr_length])
 
 		for i := 0; i < psDec.nb_subfr; i++ {
 			gainDiff := psDecCtrl.Gains_Q16[i] - psCNG.CNG_smth_Gain_Q16
@@ -0,0 +1,140 @@
+package opus
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// Not covered: the CWRS coder of libopus/celt_cwrs.go in the parent module.
+// Its icwrs, cwrsi, encode_pulses and decode_pulses are neither checked for
+// a bijection nor against the CWRS.go tested here, because the transpiled
+// libopus package does not compile (celt_bands.go has type errors) and so no
+// test can build against it. Only its range coder is covered: that is the
+// entcode package, aliased in celt_entcode.go, whose packets the scripts of
+// EntropyCoder_test.go check this coder against.
+
+// The band sizes CELT codes with CWRS and the largest pulse count for each,
+// as in the reference's test_unit_cwrs32.
+var testCWRSN = []int{2, 3, 4, 6, 8, 9, 11, 12, 16, 18, 22, 24, 32, 36, 44, 48, 64, 72, 88, 96, 144, 176}
+var testCWRSKMax = []int{128, 128, 128, 88, 36, 26, 18, 15, 12, 11, 9, 9, 7, 7, 6, 6, 5, 5, 5, 5, 4, 4}
+
+// Size of the codebook of n dimensions and k pulses, from the recurrence
+// V(n,k) = V(n-1,k) + V(n,k-1) + V(n-1,k-1).
+func testCWRSV(n int, k int) uint64 {
+	v := make([][]uint64, n+1)
+	for i := range v {
+		v[i] = make([]uint64, k+1)
+		v[i][0] = 1
+		for j := 1; i > 0 && j <= k; j++ {
+			v[i][j] = v[i-1][j] + v[i][j-1] + v[i-1][j-1]
+		}
+	}
+	return v[n][k]
+}
+
+// Checks that y is a codeword of n dimensions and k pulses and that cwrsi
+// reported its energy.
+func testCWRSCodeword(t *testing.T, n int, k int, y []int, yy int) {
+	sum, energy := 0, 0
+	for _, p := range y[:n] {
+		sum += abs(p)
+		energy += p * p
+	}
+	if sum != k || energy != yy {
+		t.Fatalf("n=%d, k=%d: %v has %d pulses and energy %d, reported %d", n, k, y[:n], sum, energy, yy)
+	}
+}
+
+func TestCWRSBijective(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	y := make([]int, 176)
+	for d, n := range testCWRSN {
+		for k := 1; k <= testCWRSKMax[d]; k++ {
+			v := CELT_PVQ_V(n, k)
+			if uint64(v) != testCWRSV(n, k) || v >= 1<<32 {
+				t.Fatalf("n=%d, k=%d: V is %d, want %d", n, k, v, testCWRSV(n, k))
+			}
+
+			/* Index to codeword and back, for every index of the small
+			   codebooks, which makes cwrsi one-to-one onto the V codewords */
+			indices := []int64{0, v - 1}
+			if v <= 1<<16 {
+				indices = indices[:0]
+				for i := int64(0); i < v; i++ {
+					indices = append(indices, i)
+				}
+			} else {
+				for i := 0; i < 200; i++ {
+					indices = append(indices, rng.Int63n(v))
+				}
+			}
+			for _, i := range indices {
+				yy := cwrsi(n, k, i, y)
+				testCWRSCodeword(t, n, k, y, yy)
+				if got := icwrs(n, y); got != i {
+					t.Fatalf("n=%d, k=%d: index %d comes back as %d", n, k, i, got)
+				}
+			}
+
+			/* Codeword to index and back */
+			for trial := 0; trial < 50; trial++ {
+				want := make([]int, n)
+				for p := 0; p < k; p++ {
+					want[rng.Intn(n)]++
+				}
+				for j := range want {
+					if rng.Intn(2) == 1 {
+						want[j] = -want[j]
+					}
+				}
+				i := icwrs(n, want)
+				if i < 0 || i >= v {
+					t.Fatalf("n=%d, k=%d: %v has index %d of %d", n, k, want, i, v)
+				}
+				cwrsi(n, k, i, y)
+				for j := range want {
+					if y[j] != want[j] {
+						t.Fatalf("n=%d, k=%d: %v comes back as %v", n, k, want, y[:n])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCWRSPulsesRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	type band struct {
+		n, k int
+		y    []int
+	}
+	var bands []band
+	buf := make([]byte, 1<<16)
+	enc := NewEntropyCoder()
+	enc.enc_init(buf, 0, len(buf))
+	for len(bands) < 2000 {
+		d := rng.Intn(len(testCWRSN))
+		b := band{n: testCWRSN[d], k: 1 + rng.Intn(testCWRSKMax[d])}
+		b.y = make([]int, b.n)
+		cwrsi(b.n, b.k, rng.Int63n(CELT_PVQ_V(b.n, b.k)), b.y)
+		encode_pulses(b.y, b.n, b.k, enc)
+		bands = append(bands, b)
+	}
+	enc.enc_done()
+	if enc.get_error() != 0 {
+		t.Fatalf("encoder error %d", enc.get_error())
+	}
+
+	dec := NewEntropyCoder()
+	dec.dec_init(buf, 0, len(buf))
+	y := make([]int, 176)
+	for i, b := range bands {
+		yy := decode_pulses(y, b.n, b.k, dec)
+		testCWRSCodeword(t, b.n, b.k, y, yy)
+		for j := range b.y {
+			if y[j] != b.y[j] {
+				t.Fatalf("band %d: decoded %v, want %v", i, y[:b.n], b.y)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package opus
+
+import "testing"
+
+// Encodes pcm as CELT-only 20 ms frames and decodes it again, lined up with
+// the input by the encoder's look-ahead.
+func testCeltRoundTrip(tb testing.TB, pcm []int16, channels, bitrate int) []int16 {
+	enc, err := NewOpusEncoder(48000, channels, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	enc.SetForceMode(MODE_CELT_ONLY)
+	enc.SetBitrate(bitrate)
+	dec, err := NewOpusDecoder(48000, channels)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	buf := make([]byte, 1275)
+	out := make([]int16, 960*channels)
+	var decoded []int16
+	for off := 0; off+960*channels <= len(pcm); off += 960 * channels {
+		n, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if _, err := dec.Decode(buf, 0, n, out, 0, 960, false); err != nil {
+			tb.Fatal(err)
+		}
+		decoded = append(decoded, out...)
+	}
+	return decoded[enc.GetLookahead()*channels:]
+}
+
+func TestCeltRoundTrip(t *testing.T) {
+	for _, channels := range []int{1, 2} {
+		pcm := testPeriodic(channels, 48000, 97.3)
+		decoded := testCeltRoundTrip(t, pcm, channels, 64000*channels)
+		/* Skip the first frames while the band energies settle */
+		if snr := testSNR(pcm, decoded, 4800*channels, len(decoded)); snr < 20 {
+			t.Fatalf("%d channels: SNR %.1f dB", channels, snr)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package opus
+
+import (
+	"concentus/quality"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCombFilterZeroGain(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	x := make([]int, 200)
+	y := make([]int, 200)
+	for i := range x {
+		x[i] = rng.Intn(1<<16) - 1<<15
+		y[i] = rng.Intn(1<<16) - 1<<15
+	}
+	/* With both gains zero the filter is the identity, even out of place */
+	comb_filter(y, 5, x, 7, 100, 100, 64, 0, 0, 0, 0, nil, 0)
+	for i := 0; i < 64; i++ {
+		if y[5+i] != x[7+i] {
+			t.Fatalf("y[%d] is %d, want x[%d] = %d", 5+i, y[5+i], 7+i, x[7+i])
+		}
+	}
+}
+
+func TestCeltPrefilterGain(t *testing.T) {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_CELT_ONLY)
+	enc.SetBitrate(64000)
+	dec, err := NewOpusDecoder(48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/* A strongly periodic tone drives the pitch gain close to 1, which the
+	   encoder scales by 0.7 before quantizing it in steps of 3/32 */
+	pcm := testPeriodic(1, 48000, 160.5)
+	buf := make([]byte, 1275)
+	out := make([]int16, 960)
+	var decoded []int16
+	max_gain := 0
+	for off := 0; off+960 <= len(pcm); off += 960 {
+		n, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := dec.Decode(buf, 0, n, out, 0, 960, false); err != nil {
+			t.Fatal(err)
+		}
+		decoded = append(decoded, out...)
+		gain := enc.Celt_Encoder.prefilter_gain
+		if got := dec.Celt_Decoder.postfilter_gain; got != gain {
+			t.Fatalf("frame %d: post-filter gain %d, pre-filter gain %d", off/960, got, gain)
+		}
+		max_gain = IMAX(max_gain, gain)
+	}
+	if limit := int(math.Floor(0.5 + 0.7*(1<<15))); max_gain > limit || max_gain < limit/2 {
+		t.Fatalf("largest pre-filter gain %d, want up to the %d it quantizes", max_gain, limit)
+	}
+
+	/* The decoder's post-filter undoes the pre-filter */
+	q, err := quality.Perceptual(pcm, decoded, 1, 48000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.MOS < 4 {
+		t.Fatalf("MOS %.2f", q.MOS)
+	}
+}
+
+func TestCeltPrefilterShortPeriod(t *testing.T) {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_CELT_ONLY)
+	enc.SetBitrate(64000)
+
+	/* Periods below the shortest the bitstream can signal are coded as that */
+	pcm := testPeriodic(1, 9600, 14)
+	buf := make([]byte, 1275)
+	for off := 0; off+960 <= len(pcm); off += 960 {
+		if _, err := enc.Encode(pcm, off, 960, buf, 0, len(buf)); err != nil {
+			t.Fatal(err)
+		}
+		if period := enc.Celt_Encoder.prefilter_period; period < CeltConstants.COMBFILTER_MINPERIOD {
+			t.Fatalf("frame %d: pre-filter period %d", off/960, period)
+		}
+	}
+}
@@ -60,7 +60,6 @@ func hp_cutoff(input []int16, input_ptr int, cutoff_Hz int, output []int16, outp
 func dc_reject(input []int16, input_ptr int, cutoff_Hz int, output []int16, output_ptr int, hp_mem []int, len int, channels int, Fs int) {
 	var c, i int
 	var shift int
-	//	PrintFuncArgs(input, input_ptr, cutoff_Hz, output, output_ptr, hp_mem, len, channels, Fs)
 
 	/* Approximates -round(log2(4.*cutoff_Hz/Fs)) */
 	shift = celt_ilog2(Fs / (cutoff_Hz * 3))
@@ -212,7 +211,7 @@ func transient_viterbi(E []float32, E_1 []float32, N int, frame_cost int, rate i
 	return best_state
 }
 
-func optimize_framesize(x []int16, x_ptr int, len int, C int, Fs int, bitrate int, tonality int, mem []float32, buffering int) int {
+func optimize_framesize(x []int16, x_ptr int, len int, C int, Fs int, bitrate int, tonality float32, mem []float32, buffering int) int {
 	var N, pos, offset int
 	e := make([]float32, MAX_DYNAMIC_FRAMESIZE+4)
 	e_1 := make([]float32, MAX_DYNAMIC_FRAMESIZE+3)
@@ -258,7 +257,7 @@ func optimize_framesize(x []int16, x_ptr int, len int, C int, Fs int, bitrate in
 	if buffering != 0 {
 		N = IMIN(MAX_DYNAMIC_FRAMESIZE, N+2)
 	}
-	bestLM := transient_viterbi(e, e_1, N, int(1.0+0.5*float32(tonality))*(60*C+40), bitrate/400)
+	bestLM := transient_viterbi(e, e_1, N, int((1.0+0.5*tonality)*float32(60*C+40)), bitrate/400)
 	mem[0] = e[1<<bestLM]
 	if buffering != 0 {
 		mem[1] = e[(1<<bestLM)+1]
@@ -0,0 +1,247 @@
+package opus
+
+import (
+	"concentus/quality"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Encodes two seconds of speech in the given mode at 48 kHz.
+func testModePackets(tb testing.TB, mode int, channels int, bitrate int) ([]int16, [][]byte) {
+	pcm := testSpeechSignal(channels, 2*48000, 48000)
+	enc, err := NewOpusEncoder(48000, channels, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	enc.SetForceMode(mode)
+	enc.SetBitrate(bitrate)
+	if mode == MODE_SILK_ONLY {
+		enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	}
+	var packets [][]byte
+	buf := make([]byte, 1275)
+	for off := 0; off+960*channels <= len(pcm); off += 960 * channels {
+		n, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:n]...))
+	}
+	return pcm, packets
+}
+
+// Decodes packets at the given complexity, concealing every lose-th one
+// (none when lose is 0).
+func testDecodeComplexity(tb testing.TB, packets [][]byte, channels int, complexity int, lose int) []int16 {
+	dec, err := NewOpusDecoder(48000, channels)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := dec.SetComplexity(complexity); err != nil {
+		tb.Fatal(err)
+	}
+	out := make([]int16, 960*channels*len(packets))
+	for i, p := range packets {
+		if lose > 0 && i%lose == lose-1 {
+			p = nil
+		}
+		if _, err := dec.Decode(p, 0, len(p), out, 960*channels*i, 960, false); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return out
+}
+
+// Energy in dB of the first channel between two frequencies, summed over
+// Hann windowed 20 ms blocks.
+func testBandEnergy(pcm []int16, channels int, from_hz, to_hz int) float64 {
+	const n = 960
+	cos := make([]float64, n)
+	sin := make([]float64, n)
+	for i := range cos {
+		cos[i] = math.Cos(2 * math.Pi * float64(i) / n)
+		sin[i] = math.Sin(2 * math.Pi * float64(i) / n)
+	}
+	x := make([]float64, n)
+	sum := 0.0
+	for off := 0; off+n*channels <= len(pcm); off += n * channels {
+		for i := range x {
+			x[i] = float64(pcm[off+i*channels]) * (0.5 - 0.5*cos[i])
+		}
+		for k := from_hz * n / 48000; k < to_hz*n/48000; k++ {
+			re, im := 0.0, 0.0
+			for i := range x {
+				re += x[i] * cos[k*i%n]
+				im -= x[i] * sin[k*i%n]
+			}
+			sum += re*re + im*im
+		}
+	}
+	return 10 * math.Log10(sum+1)
+}
+
+func TestDecoderComplexitySetting(t *testing.T) {
+	dec, _ := NewOpusDecoder(48000, 2)
+	if dec.GetComplexity() != 10 {
+		t.Fatalf("default complexity %d", dec.GetComplexity())
+	}
+	for _, bad := range []int{-1, 11} {
+		if err := dec.SetComplexity(bad); !errors.Is(err, ErrBadArg) {
+			t.Fatalf("complexity %d: got %v", bad, err)
+		}
+	}
+	if dec.GetComplexity() != 10 {
+		t.Fatal("rejected complexity applied")
+	}
+
+	ms, err := OpusMSDecoder_create(48000, 3, 2, 1, []int16{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ms.SetComplexity(11); !errors.Is(err, ErrBadArg) {
+		t.Fatalf("multistream complexity 11: got %v", err)
+	}
+	if err := ms.SetComplexity(3); err != nil {
+		t.Fatal(err)
+	}
+	for s := 0; s < 2; s++ {
+		if c := ms.GetMultistreamDecoderState(s).GetComplexity(); c != 3 {
+			t.Fatalf("stream %d at complexity %d", s, c)
+		}
+	}
+}
+
+func TestDecoderComplexity(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		mode     int
+		channels int
+		bitrate  int
+	}{
+		{"celt", MODE_CELT_ONLY, 1, 64000},
+		{"celt-stereo", MODE_CELT_ONLY, 2, 96000},
+		{"hybrid", MODE_HYBRID, 1, 32000},
+		{"silk", MODE_SILK_ONLY, 1, 24000},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			pcm, packets := testModePackets(t, c.mode, c.channels, c.bitrate)
+			full := testDecodeComplexity(t, packets, c.channels, 10, 0)
+			lossy := testDecodeComplexity(t, packets, c.channels, 10, 7)
+			fullHF := testBandEnergy(full, c.channels, 13000, 20000)
+			fullWB := testBandEnergy(full, c.channels, 9000, 12000)
+			ref := testDecodePackets(t, packets, c.channels, 48000)
+			for i := range ref {
+				if full[i] != ref[i] {
+					t.Fatalf("complexity 10 differs from the default at sample %d", i)
+				}
+			}
+
+			prev := 5.0
+			var prevConcealed []int16
+			for complexity := 10; complexity >= 0; complexity-- {
+				out := testDecodeComplexity(t, packets, c.channels, complexity, 0)
+				score, err := quality.Perceptual(pcm, out, c.channels, 48000)
+				if err != nil {
+					t.Fatal(err)
+				}
+				hf := testBandEnergy(out, c.channels, 13000, 20000)
+				wb := testBandEnergy(out, c.channels, 9000, 12000)
+				t.Logf("complexity %2d: MOS %.2f, %.0f dB at 9-12 kHz, %.0f dB at 13-20 kHz", complexity, score.MOS, wb, hf)
+
+				same := true
+				for i := range out {
+					same = same && out[i] == full[i]
+				}
+				switch {
+				case complexity >= 8 || c.mode == MODE_SILK_ONLY:
+					if !same {
+						t.Fatalf("complexity %d changes the output", complexity)
+					}
+				case complexity == 7 && c.mode == MODE_CELT_ONLY:
+					/* Hybrid frames never carry a post-filter */
+					if same {
+						t.Fatal("the post-filter is still applied at complexity 7")
+					}
+				}
+				if c.mode != MODE_SILK_ONLY {
+					if complexity < 5 && hf > fullHF-30 {
+						t.Fatalf("complexity %d: %.0f dB at 13-20 kHz, %.0f dB in full", complexity, hf, fullHF)
+					}
+					cut := complexity < 2 || (complexity < 3 && c.mode == MODE_HYBRID)
+					if cut && wb > fullWB-30 {
+						t.Fatalf("complexity %d: %.0f dB at 9-12 kHz, %.0f dB in full", complexity, wb, fullWB)
+					}
+					/* Up to 12 kHz is kept until then */
+					if !cut && wb < fullWB-3 {
+						t.Fatalf("complexity %d: %.0f dB at 9-12 kHz, %.0f dB in full", complexity, wb, fullWB)
+					}
+				}
+				/* Cheaper levels may only lose quality, by a small margin */
+				if score.MOS > prev+0.1 || score.MOS < 2.5 {
+					t.Fatalf("complexity %d: MOS %.2f after %.2f", complexity, score.MOS, prev)
+				}
+				prev = math.Min(prev, score.MOS)
+
+				/* Concealment works at every level */
+				concealed := testDecodeComplexity(t, packets, c.channels, complexity, 7)
+				if complexity >= 8 {
+					for i := range concealed {
+						if concealed[i] != lossy[i] {
+							t.Fatalf("complexity %d conceals differently", complexity)
+						}
+					}
+				}
+				if complexity == 5 && c.mode == MODE_CELT_ONLY {
+					same = true
+					for i := range concealed {
+						same = same && concealed[i] == prevConcealed[i]
+					}
+					if same {
+						t.Fatal("pitch concealment still used at complexity 5")
+					}
+				}
+				prevConcealed = concealed
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeComplexity(b *testing.B) {
+	for _, c := range []struct {
+		name     string
+		mode     int
+		channels int
+		bitrate  int
+	}{
+		{"celt", MODE_CELT_ONLY, 2, 96000},
+		{"hybrid", MODE_HYBRID, 1, 32000},
+	} {
+		pcm, packets := testModePackets(b, c.mode, c.channels, c.bitrate)
+		for complexity := 10; complexity >= 0; complexity-- {
+			b.Run(fmt.Sprintf("%s/complexity=%d", c.name, complexity), func(b *testing.B) {
+				/* One packet in 20 is lost, for the concealment to count */
+				out := testDecodeComplexity(b, packets, c.channels, complexity, 20)
+				score, err := quality.Perceptual(pcm, out, c.channels, 48000)
+				if err != nil {
+					b.Fatal(err)
+				}
+				dec, _ := NewOpusDecoder(48000, c.channels)
+				dec.SetComplexity(complexity)
+				buf := make([]int16, 960*c.channels)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					p := packets[i%len(packets)]
+					if i%20 == 19 {
+						p = nil
+					}
+					if _, err := dec.Decode(p, 0, len(p), buf, 0, 960, false); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(score.MOS, "MOS")
+			})
+		}
+	}
+}
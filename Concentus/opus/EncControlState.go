@@ -23,6 +23,8 @@ type EncControlState struct {
 	inWBmodeWithoutVariableLP int
 	stereoWidth_Q14           int
 	switchReady               int
+	lbrrGainIncreases         int // fixed LBRR gain increase, 0 to derive it from the loss rate
+	lbrrMinRate_bps           int // bitrate above which LBRR is used, 0 for the default by bandwidth
 }
 
 func (s *EncControlState) Reset() {
@@ -48,6 +50,8 @@ func (s *EncControlState) Reset() {
 	s.inWBmodeWithoutVariableLP = 0
 	s.stereoWidth_Q14 = 0
 	s.switchReady = 0
+	s.lbrrGainIncreases = 0
+	s.lbrrMinRate_bps = 0
 }
 
 func (s *EncControlState) check_control_input() int {
@@ -70,46 +74,38 @@ func (s *EncControlState) check_control_input() int {
 		(s.minInternalSampleRate > s.desiredInternalSampleRate) ||
 		(s.maxInternalSampleRate < s.desiredInternalSampleRate) ||
 		(s.minInternalSampleRate > s.maxInternalSampleRate) {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_FS_NOT_SUPPORTED
 	}
 	if s.payloadSize_ms != 10 &&
 		s.payloadSize_ms != 20 &&
 		s.payloadSize_ms != 40 &&
 		s.payloadSize_ms != 60 {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_PACKET_SIZE_NOT_SUPPORTED
 	}
 	if s.packetLossPercentage < 0 || s.packetLossPercentage > 100 {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_LOSS_RATE
 	}
 	if s.useDTX < 0 || s.useDTX > 1 {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_DTX_SETTING
 	}
 	if s.useCBR < 0 || s.useCBR > 1 {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_CBR_SETTING
 	}
-	if s.useInBandFEC < 0 || s.useInBandFEC > 1 {
-		OpusAssert(false)
+	if s.useInBandFEC < 0 || s.useInBandFEC > 1 ||
+		s.lbrrGainIncreases < 0 || s.lbrrGainIncreases >= SilkConstants.N_LEVELS_QGAIN ||
+		s.lbrrMinRate_bps < 0 {
 		return SilkError.SILK_ENC_INVALID_INBAND_FEC_SETTING
 	}
 	if s.nChannelsAPI < 1 || s.nChannelsAPI > SilkConstants.ENCODER_NUM_CHANNELS {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_NUMBER_OF_CHANNELS_ERROR
 	}
 	if s.nChannelsInternal < 1 || s.nChannelsInternal > SilkConstants.ENCODER_NUM_CHANNELS {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_NUMBER_OF_CHANNELS_ERROR
 	}
 	if s.nChannelsInternal > s.nChannelsAPI {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_NUMBER_OF_CHANNELS_ERROR
 	}
 	if s.complexity < 0 || s.complexity > 10 {
-		OpusAssert(false)
 		return SilkError.SILK_ENC_INVALID_COMPLEXITY_SETTING
 	}
 
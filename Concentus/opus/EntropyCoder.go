@@ -1,9 +1,5 @@
 package opus
 
-import (
-	"fmt"
-)
-
 const (
 	EC_WINDOW_SIZE = 32
 	EC_UINT_BITS   = 8
@@ -33,6 +29,11 @@ type EntropyCoder struct {
 	ext         int64
 	rem         int
 	error       int
+	tracer      Tracer
+	/* Encoder symbol events, held until the packet is done so that the ones
+	   of a discarded attempt are rolled back with the coder state */
+	symbols []TraceSymbol
+	held    bool
 }
 
 func NewEntropyCoder() *EntropyCoder {
@@ -69,6 +70,7 @@ func (ec *EntropyCoder) Assign(other *EntropyCoder) {
 	ec.ext = other.ext
 	ec.rem = other.rem
 	ec.error = other.error
+	ec.symbols = append(ec.symbols[:0], other.symbols...)
 }
 
 func (ec *EntropyCoder) get_buffer() []byte {
@@ -150,6 +152,7 @@ func (ec *EntropyCoder) dec_init(_buf []byte, _buf_ptr int, _storage int) {
 	//ec.val = ec.rng - 1 - int64(ec.rem>>(EC_SYM_BITS-EC_CODE_EXTRA))
 	ec.val = CapToUInt32(ec.rng - 1 - int64(ec.rem>>(EC_SYM_BITS-EC_CODE_EXTRA)))
 	ec.error = 0
+	ec.held = false
 	ec.dec_normalize()
 
 }
@@ -179,7 +182,9 @@ func (ec *EntropyCoder) dec_update(_fl int64, _fh int64, _ft int64) {
 		ec.rng = ec.rng - s
 	}
 	ec.dec_normalize()
-	//fmt.Printf(" dec_update ec.rng:%d \r\n", ec.rng)
+	if ec.tracer != nil {
+		ec.trace("symbol", int(_fl), EC_ILOG(_ft-1))
+	}
 }
 
 func (ec *EntropyCoder) dec_bit_logp(_logp int64) int {
@@ -204,12 +209,13 @@ func (ec *EntropyCoder) dec_bit_logp(_logp int64) int {
 		ec.rng = r - s
 	}
 	ec.dec_normalize()
-	//fmt.Printf(" dec_bit_logp ec.rng:%d \r\n", ec.rng)
+	if ec.tracer != nil {
+		ec.trace("bit_logp", ret, int(_logp))
+	}
 	return ret
 }
 
 func (ec *EntropyCoder) dec_icdf(_icdf []int16, _ftb int) int {
-	//old := ec.rng
 	var t int64
 	var s = ec.rng
 	var d = ec.val
@@ -227,14 +233,14 @@ func (ec *EntropyCoder) dec_icdf(_icdf []int16, _ftb int) int {
 	ec.val = CapToUInt32(d - s)
 	ec.rng = CapToUInt32(t - s)
 
-	//fmt.Printf("dec_icdf this.rng  :%d old:%d  _ftb:%d\r\n", ec.rng, old, _ftb)
-	//fmt.Printf("dec_icdf this. nbits_total : %d\r\n", ec.nbits_total)
 	ec.dec_normalize()
+	if ec.tracer != nil {
+		ec.trace("icdf", ret, _ftb)
+	}
 	return ret
 }
 
 func (ec *EntropyCoder) dec_icdf_offset(_icdf []int16, _icdf_offset int, _ftb int) int {
-	//old := ec.rng
 	var t int64
 	var s = ec.rng
 	var d = ec.val
@@ -252,14 +258,24 @@ func (ec *EntropyCoder) dec_icdf_offset(_icdf []int16, _icdf_offset int, _ftb in
 	}
 	ec.val = CapToUInt32(d - s)
 	ec.rng = CapToUInt32(t - s)
-	//fmt.Printf("dec_icdf offset this.rng  :%d old:%d  _ftb:%d\r\n", ec.rng, old, _ftb)
-	//fmt.Printf("dec_icdf offset this. nbits_total : %d\r\n", ec.nbits_total)
 	ec.dec_normalize()
+	if ec.tracer != nil {
+		ec.trace("icdf", ret-_icdf_offset, _ftb)
+	}
 	return ret - _icdf_offset
 }
 
 func (ec *EntropyCoder) dec_uint(_ft int64) int64 {
 	_ft = CapToUInt32(_ft)
+	if ec.tracer != nil {
+		/* Traced as a single value rather than its symbol and raw bits */
+		tracer := ec.tracer
+		ec.tracer = nil
+		t := ec.dec_uint(_ft)
+		ec.tracer = tracer
+		ec.trace("uint", int(t), EC_ILOG(_ft-1))
+		return t
+	}
 	var ft int64
 	var s int64
 	var ftb int
@@ -310,6 +326,9 @@ func (ec *EntropyCoder) dec_bits(_bits int) int {
 	ec.end_window = CapToUInt32(window)
 	ec.nend_bits = available
 	ec.nbits_total = ec.nbits_total + _bits
+	if ec.tracer != nil {
+		ec.trace("bits", ret, _bits)
+	}
 	return ret
 }
 
@@ -362,6 +381,8 @@ func (ec *EntropyCoder) enc_init(_buf []byte, buf_ptr int, _size int) {
 	ec.ext = 0
 	ec.storage = _size
 	ec.error = 0
+	ec.symbols = ec.symbols[:0]
+	ec.held = true
 }
 
 func (ec *EntropyCoder) encode(_fl int64, _fh int64, _ft int64) {
@@ -377,7 +398,9 @@ func (ec *EntropyCoder) encode(_fl int64, _fh int64, _ft int64) {
 	}
 
 	ec.enc_normalize()
-	//fmt.Printf("encode this. nbits_total : %d\r\n", ec.nbits_total)
+	if ec.tracer != nil {
+		ec.trace("symbol", int(_fl), EC_ILOG(_ft-1))
+	}
 }
 
 func (ec *EntropyCoder) encode_bin(_fl int64, _fh int64, _bits int) {
@@ -392,11 +415,12 @@ func (ec *EntropyCoder) encode_bin(_fl int64, _fh int64, _bits int) {
 	}
 
 	ec.enc_normalize()
+	if ec.tracer != nil {
+		ec.trace("symbol", int(_fl), _bits)
+	}
 }
 
 func (ec *EntropyCoder) enc_bit_logp(_val int, _logp int) {
-	old := ec.rng
-
 	r := ec.rng
 	l := ec.val
 	s := r >> _logp
@@ -410,13 +434,12 @@ func (ec *EntropyCoder) enc_bit_logp(_val int, _logp int) {
 		ec.rng = r
 	}
 	ec.enc_normalize()
-	if Debug {
-		fmt.Printf("enc_bit_logp  nbits_total:%d this.rng : %d old:%d\r\n", ec.nbits_total, ec.rng, old)
+	if ec.tracer != nil {
+		ec.trace("bit_logp", _val, _logp)
 	}
 }
 
 func (ec *EntropyCoder) enc_icdf(_s int, _icdf []int16, _ftb int) {
-	old := ec.rng
 	r := CapToUInt32(ec.rng >> _ftb)
 	if _s > 0 {
 		ec.val = ec.val + CapToUInt32(ec.rng-CapToUInt32(r*int64(_icdf[_s-1])))
@@ -424,19 +447,13 @@ func (ec *EntropyCoder) enc_icdf(_s int, _icdf []int16, _ftb int) {
 	} else {
 		ec.rng = CapToUInt32(ec.rng - (r * int64(_icdf[_s])))
 	}
-	if Debug {
-
-		fmt.Printf("enc_icdf nbits_total:%d this.rng  :%d old:%d  _ftb:%d _s:%d\r\n", ec.nbits_total, ec.rng, old, _ftb, _s)
-		if old == 57496504 {
-			//	panic("eeee")
-		}
-	}
 	ec.enc_normalize()
-
+	if ec.tracer != nil {
+		ec.trace("icdf", _s, _ftb)
+	}
 }
 
 func (ec *EntropyCoder) enc_icdf_offset(_s int, _icdf []int16, icdf_ptr int, _ftb int) {
-	old := ec.rng
 	r := CapToUInt32(ec.rng >> _ftb)
 	if _s > 0 {
 		ec.val = ec.val + CapToUInt32(ec.rng-CapToUInt32(r*int64(_icdf[icdf_ptr+_s-1])))
@@ -446,19 +463,24 @@ func (ec *EntropyCoder) enc_icdf_offset(_s int, _icdf []int16, icdf_ptr int, _ft
 	}
 
 	ec.enc_normalize()
-	if Debug {
-		if ec.rng == 32345264 {
-			fmt.Printf("enc_icdf_offset nbits_total:%d this.rng  :%d old:%d  _ftb:%d _s:%d\r\n", ec.nbits_total, ec.rng, old, _ftb, _s)
-			panic("eee")
-		}
+	if ec.tracer != nil {
+		ec.trace("icdf", _s, _ftb)
 	}
-
 }
 
 func (ec *EntropyCoder) enc_uint(_fl int64, _ft int64) {
 
 	_fl = CapToUInt32(_fl)
 	_ft = CapToUInt32(_ft)
+	if ec.tracer != nil {
+		/* Traced as a single value rather than its symbol and raw bits */
+		tracer := ec.tracer
+		ec.tracer = nil
+		ec.enc_uint(_fl, _ft)
+		ec.tracer = tracer
+		ec.trace("uint", int(_fl), EC_ILOG(_ft-1))
+		return
+	}
 
 	var ft int64
 	var fl int64
@@ -476,9 +498,6 @@ func (ec *EntropyCoder) enc_uint(_fl int64, _ft int64) {
 	} else {
 		ec.encode(_fl, _fl+1, _ft+1)
 	}
-	if Debug {
-		fmt.Printf("enc_uint nbits_total:%d this.rng:%d  \r\n", ec.nbits_total, ec.rng)
-	}
 }
 
 func (ec *EntropyCoder) enc_bits(_fl int64, _bits int) {
@@ -496,8 +515,9 @@ func (ec *EntropyCoder) enc_bits(_fl int64, _bits int) {
 	ec.end_window = window
 	ec.nend_bits = used
 	ec.nbits_total += _bits
-	//fmt.Printf(" enc_bits nbits_total:%d\r\n", ec.nbits_total)
-
+	if ec.tracer != nil {
+		ec.trace("bits", int(_fl), _bits)
+	}
 }
 
 func (ec *EntropyCoder) enc_patch_initial_bits(_val int64, _nbits int) {
@@ -512,6 +532,9 @@ func (ec *EntropyCoder) enc_patch_initial_bits(_val int64, _nbits int) {
 	} else {
 		ec.error = -1
 	}
+	if ec.tracer != nil {
+		ec.trace_initial_bits(_val, _nbits)
+	}
 }
 
 func (ec *EntropyCoder) enc_shrink(_size int) {
@@ -610,4 +633,10 @@ func (ec *EntropyCoder) enc_done() {
 			}
 		}
 	}
+	if ec.tracer != nil {
+		for _, e := range ec.symbols {
+			ec.tracer.Trace(e)
+		}
+		ec.symbols = ec.symbols[:0]
+	}
 }
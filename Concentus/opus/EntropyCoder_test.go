@@ -0,0 +1,198 @@
+package opus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// One symbol of a script, coded with one of the primitives.
+type testECOp struct {
+	kind int
+	fl   int64 // the value, or the low end of its range
+	fh   int64
+	ft   int64 // the total, or the number of bits
+	icdf []int16
+}
+
+const (
+	testECEncode = iota
+	testECEncodeBin
+	testECBitLogp
+	testECIcdf
+	testECUint
+	testECBits
+	testECKinds
+)
+
+// A copy of entcode/testdata/scripts.txt in the parent module: the scripts
+// drawn by its test, with the packets its Encoder codes them into. That coder
+// follows the reference C coder line by line, and libopus aliases it.
+const testECScriptsFile = "testdata/entcode_scripts.txt"
+
+type testECScriptPacket struct {
+	patch  int64
+	packet []byte
+	ops    []testECOp
+}
+
+// Fails if the copy at local no longer matches the file of the parent module
+// it was taken from. The check is skipped when this module is built on its
+// own.
+func testFixtureMatchesParent(t *testing.T, local string, parent string) {
+	want, err := os.ReadFile(parent)
+	if os.IsNotExist(err) {
+		t.Skipf("%s: not in a checkout of the parent module", parent)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s is out of date, copy %s over it", local, parent)
+	}
+}
+
+func TestEntropyCoderScriptsFixture(t *testing.T) {
+	testFixtureMatchesParent(t, testECScriptsFile, "../../entcode/testdata/scripts.txt")
+}
+
+func testECReadScripts(t *testing.T) []testECScriptPacket {
+	data, err := os.ReadFile(testECScriptsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scripts []testECScriptPacket
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 || f[0] == "#" {
+			continue
+		}
+		if f[0] == "script" {
+			s := testECScriptPacket{}
+			if s.patch, err = strconv.ParseInt(f[1], 10, 64); err != nil {
+				t.Fatal(err)
+			}
+			if s.packet, err = hex.DecodeString(f[2]); err != nil {
+				t.Fatal(err)
+			}
+			scripts = append(scripts, s)
+			continue
+		}
+		v := make([]int64, len(f))
+		for i := range f {
+			if v[i], err = strconv.ParseInt(f[i], 10, 64); err != nil {
+				t.Fatalf("%s: %v", testECScriptsFile, err)
+			}
+		}
+		op := testECOp{kind: int(v[0]), fl: v[1], fh: v[2], ft: v[3]}
+		for _, x := range v[4:] {
+			op.icdf = append(op.icdf, int16(x))
+		}
+		s := &scripts[len(scripts)-1]
+		s.ops = append(s.ops, op)
+	}
+	if len(scripts) == 0 {
+		t.Fatalf("%s: no scripts", testECScriptsFile)
+	}
+	return scripts
+}
+
+// Codes ops, patches the first symbol to patch and shrinks the buffer to the
+// bytes tell() accounts for. Returns the packet and the tell()
+// and tell_frac() values after each symbol.
+func testECEncodeScript(t *testing.T, ops []testECOp, patch int64) ([]byte, []int, []int) {
+	enc := NewEntropyCoder()
+	buf := make([]byte, 4096)
+	enc.enc_init(buf, 0, len(buf))
+	tell := []int{enc.tell()}
+	frac := []int{enc.tell_frac()}
+	for _, op := range ops {
+		switch op.kind {
+		case testECEncode:
+			enc.encode(op.fl, op.fh, op.ft)
+		case testECEncodeBin:
+			enc.encode_bin(op.fl, op.fh, int(op.ft))
+		case testECBitLogp:
+			enc.enc_bit_logp(int(op.fl), int(op.ft))
+		case testECIcdf:
+			enc.enc_icdf(int(op.fl), op.icdf, int(op.ft))
+		case testECUint:
+			enc.enc_uint(op.fl, op.ft)
+		case testECBits:
+			enc.enc_bits(op.fl, int(op.ft))
+		}
+		tell = append(tell, enc.tell())
+		frac = append(frac, enc.tell_frac())
+	}
+	enc.enc_patch_initial_bits(patch, int(ops[0].ft))
+	size := (enc.tell() + 7) / 8
+	enc.enc_shrink(size)
+	enc.enc_done()
+	if enc.get_error() != 0 {
+		t.Fatalf("encoder error %d", enc.get_error())
+	}
+	return buf[:size], tell, frac
+}
+
+// Codes the scripts into the same bytes as the parent module, and decodes
+// them back with tell() and tell_frac() agreeing with the encoder.
+func TestEntropyCoderScripts(t *testing.T) {
+	for trial, s := range testECReadScripts(t) {
+		ops, patch := s.ops, s.patch
+		packet, tell, frac := testECEncodeScript(t, ops, patch)
+		if !bytes.Equal(packet, s.packet) {
+			t.Fatalf("script %d: coded %x, want %x", trial, packet, s.packet)
+		}
+
+		dec := NewEntropyCoder()
+		dec.dec_init(packet, 0, len(packet))
+		if dec.tell() != tell[0] || dec.tell_frac() != frac[0] {
+			t.Fatalf("trial %d: decoder starts at %d/%d bits, encoder at %d/%d", trial, dec.tell(), dec.tell_frac(), tell[0], frac[0])
+		}
+		for i, op := range ops {
+			if i == 0 {
+				op.fl, op.fh = patch, patch+1
+			}
+			want, got := op.fl, op.fl
+			switch op.kind {
+			case testECEncode, testECEncodeBin:
+				var s int64
+				ft := op.ft
+				if op.kind == testECEncode {
+					s = dec.decode(ft)
+				} else {
+					s = dec.decode_bin(int(ft))
+					ft = 1 << ft
+				}
+				if s < op.fl || s >= op.fh {
+					t.Fatalf("trial %d, symbol %d: %d outside [%d,%d)", trial, i, s, op.fl, op.fh)
+				}
+				dec.dec_update(op.fl, op.fh, ft)
+			case testECBitLogp:
+				got = int64(dec.dec_bit_logp(op.ft))
+			case testECIcdf:
+				got = int64(dec.dec_icdf(op.icdf, int(op.ft)))
+			case testECUint:
+				got = dec.dec_uint(op.ft)
+			case testECBits:
+				got = int64(dec.dec_bits(int(op.ft)))
+			}
+			if got != want {
+				t.Fatalf("trial %d, symbol %d (kind %d): decoded %d, want %d", trial, i, op.kind, got, want)
+			}
+			if dec.tell() != tell[i+1] || dec.tell_frac() != frac[i+1] {
+				t.Fatalf("trial %d, symbol %d: decoder at %d/%d bits, encoder at %d/%d", trial, i, dec.tell(), dec.tell_frac(), tell[i+1], frac[i+1])
+			}
+		}
+		if dec.get_error() != 0 {
+			t.Fatalf("trial %d: decoder error %d", trial, dec.get_error())
+		}
+	}
+}
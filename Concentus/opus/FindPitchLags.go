@@ -1,7 +1,6 @@
 package opus
 
 import (
-	"fmt"
 	"math"
 )
 
@@ -92,130 +91,3 @@ func silk_find_pitch_lags(psEnc *SilkChannelEncoder, psEncCtrl *SilkEncoderContr
 	}
 
 }
-
-func silk_find_pitch_lagsbak(psEnc *SilkChannelEncoder, psEncCtrl *SilkEncoderControl, res []int16, x []int16, x_ptr int) {
-	var buf_len, i, scale int
-	var thrhld_Q13, res_nrg int
-	var x_buf, x_buf_ptr int
-	var Wsig []int16
-	var Wsig_ptr int
-	auto_corr := make([]int, SilkConstants.MAX_FIND_PITCH_LPC_ORDER+1)
-	rc_Q15 := make([]int16, SilkConstants.MAX_FIND_PITCH_LPC_ORDER)
-	A_Q24 := make([]int, SilkConstants.MAX_FIND_PITCH_LPC_ORDER)
-	A_Q12 := make([]int16, SilkConstants.MAX_FIND_PITCH_LPC_ORDER)
-
-	/**
-	 * ***************************************
-	 */
-	/* Set up buffer lengths etc based on Fs  */
-	/**
-	 * ***************************************
-	 */
-	buf_len = psEnc.la_pitch + psEnc.frame_length + psEnc.ltp_mem_length
-
-	/* Safety check */
-	OpusAssert(buf_len >= psEnc.pitch_LPC_win_length)
-
-	x_buf = x_ptr - psEnc.ltp_mem_length
-
-	/**
-	 * **********************************
-	 */
-	/* Estimate LPC AR coefficients      */
-	/**
-	 * **********************************
-	 */
-
-	/* Calculate windowed signal */
-	Wsig = make([]int16, psEnc.pitch_LPC_win_length)
-
-	/* First LA_LTP samples */
-	x_buf_ptr = x_buf + buf_len - psEnc.pitch_LPC_win_length
-	Wsig_ptr = 0
-	silk_apply_sine_window(Wsig, Wsig_ptr, x, x_buf_ptr, 1, psEnc.la_pitch)
-
-	/* Middle un - windowed samples */
-	Wsig_ptr += psEnc.la_pitch
-	x_buf_ptr += psEnc.la_pitch
-	//System.arraycopy(x, x_buf_ptr, Wsig, Wsig_ptr, (psEnc.pitch_LPC_win_length - silk_LSHIFT(psEnc.la_pitch, 1)))
-	copy(Wsig[Wsig_ptr:], x[x_buf_ptr:(psEnc.pitch_LPC_win_length-silk_LSHIFT(psEnc.la_pitch, 1))])
-	/* Last LA_LTP samples */
-	Wsig_ptr += psEnc.pitch_LPC_win_length - silk_LSHIFT(psEnc.la_pitch, 1)
-	x_buf_ptr += psEnc.pitch_LPC_win_length - silk_LSHIFT(psEnc.la_pitch, 1)
-	silk_apply_sine_window(Wsig, Wsig_ptr, x, x_buf_ptr, 2, psEnc.la_pitch)
-
-	/* Calculate autocorrelation sequence */
-	boxed_scale := &BoxedValueInt{0}
-	silk_autocorr(auto_corr, boxed_scale, Wsig, psEnc.pitch_LPC_win_length, psEnc.pitchEstimationLPCOrder+1)
-	scale = boxed_scale.Val
-	fmt.Printf("scale:%d\r\n", scale)
-	/* Add white noise, as fraction of energy */
-	auto_corr[0] = silk_SMLAWB(auto_corr[0], auto_corr[0], ((int)(float64(TuningParameters.FIND_PITCH_WHITE_NOISE_FRACTION)*float64(int64(1)<<(16))+0.5))) + 1
-
-	/* Calculate the reflection coefficients using schur */
-	res_nrg = silk_schur(rc_Q15, auto_corr, psEnc.pitchEstimationLPCOrder)
-
-	/* Prediction gain */
-	psEncCtrl.predGain_Q16 = silk_DIV32_varQ(auto_corr[0], silk_max_int(res_nrg, 1), 16)
-
-	/* Convert reflection coefficients to prediction coefficients */
-	silk_k2a(A_Q24, rc_Q15, psEnc.pitchEstimationLPCOrder)
-
-	/* Convert From 32 bit Q24 to 16 bit Q12 coefs */
-	for i = 0; i < psEnc.pitchEstimationLPCOrder; i++ {
-		A_Q12[i] = int16(silk_SAT16(silk_RSHIFT(A_Q24[i], 12)))
-	}
-
-	/* Do BWE */
-	silk_bwexpander(A_Q12, psEnc.pitchEstimationLPCOrder, ((int)(float64(TuningParameters.FIND_PITCH_BANDWIDTH_EXPANSION)*float64(int64(1)<<(16)) + 0.5)))
-
-	/**
-	 * **************************************
-	 */
-	/* LPC analysis filtering                */
-	/**
-	 * **************************************
-	 */
-
-	silk_LPC_analysis_filter(res, 0, x, x_buf, A_Q12, 0, buf_len, psEnc.pitchEstimationLPCOrder)
-
-	if psEnc.indices.signalType != TYPE_NO_VOICE_ACTIVITY && psEnc.first_frame_after_reset == 0 {
-		/* Threshold for pitch estimator */
-		thrhld_Q13 = (int(math.Floor(0.6*float64(int64(1)<<13) + 0.5)))
-		thrhld_Q13 = silk_SMLABB(thrhld_Q13, (int(math.Floor(-0.004*float64(int64(1)<<(13)) + 0.5))), psEnc.pitchEstimationLPCOrder)
-		thrhld_Q13 = silk_SMLAWB(thrhld_Q13, (int(math.Floor(-0.1*float64(int64(1)<<(21)) + 0.5))), psEnc.speech_activity_Q8)
-		thrhld_Q13 = silk_SMLABB(thrhld_Q13, (int(math.Floor(-0.15*float64(int64(1)<<(13)) + 0.5))), silk_RSHIFT(int(psEnc.prevSignalType), 1))
-		thrhld_Q13 = silk_SMLAWB(thrhld_Q13, (int(math.Floor(-0.1*float64(int64(1)<<(14)) + 0.5))), psEnc.input_tilt_Q15)
-		thrhld_Q13 = silk_SAT16(thrhld_Q13)
-
-		/**
-		 * **************************************
-		 */
-		/* Call pitch estimator                  */
-		/**
-		 * **************************************
-		 */
-		boxed_lagIndex := &BoxedValueShort{psEnc.indices.lagIndex}
-		boxed_contourIndex := &BoxedValueByte{psEnc.indices.contourIndex}
-		boxed_LTPcorr := &BoxedValueInt{psEnc.LTPCorr_Q15}
-		if silk_pitch_analysis_core(res, psEncCtrl.pitchL, boxed_lagIndex, boxed_contourIndex,
-			boxed_LTPcorr, psEnc.prevLag, psEnc.pitchEstimationThreshold_Q16,
-			thrhld_Q13, psEnc.fs_kHz, psEnc.pitchEstimationComplexity, psEnc.nb_subfr) == 0 {
-			psEnc.indices.signalType = TYPE_VOICED
-		} else {
-			psEnc.indices.signalType = TYPE_UNVOICED
-		}
-
-		psEnc.indices.lagIndex = boxed_lagIndex.Val
-		psEnc.indices.contourIndex = boxed_contourIndex.Val
-
-		psEnc.LTPCorr_Q15 = boxed_LTPcorr.Val
-	} else {
-		MemSetLen(psEncCtrl.pitchL, 0, SilkConstants.MAX_NB_SUBFR)
-		psEnc.indices.lagIndex = 0
-		psEnc.indices.contourIndex = 0
-		psEnc.LTPCorr_Q15 = 0
-	}
-	fmt.Printf("  psEnc.indices.signalType :%d\r\n", psEnc.indices.signalType)
-
-}
@@ -990,7 +990,7 @@ func silk_ADD_LSHIFT(a, b, shift int) int {
 }
 
 func silk_ADD_LSHIFT32(a, b, shift int) int {
-	return a + int(int32(b)<<shift)
+	return int(int32(a) + int32(b)<<shift)
 }
 
 func silk_ADD_RSHIFT(a, b, shift int) int {
@@ -1,7 +1,6 @@
 package opus
 
 import (
-	"fmt"
 	"math"
 )
 
@@ -486,9 +485,6 @@ func silk_NLSF_encode(NLSFIndices []int8, pNLSF_Q15 []int16, psNLSF_CB *NLSFCode
 
 	// Decode
 	silk_NLSF_decode(pNLSF_Q15, NLSFIndices, psNLSF_CB)
-	if Debug {
-		fmt.Printf("NLSFIndices:%+v\r\n", (NLSFIndices))
-	}
 	return RD_Q25[0]
 }
 
@@ -0,0 +1,302 @@
+package opus
+
+import (
+	"math"
+	"time"
+)
+
+/* Loss and congestion control from receiver feedback. Loss reports feed a
+   smoothed loss rate, which sets the expected loss given to the encoder and
+   turns in-band FEC on and off between two thresholds, and a loss-based rate
+   controller in the manner of GCC: heavy loss cuts the bitrate, light loss
+   lets it grow. The bitrate is further capped by the bandwidth estimate, less
+   the packet headers, and when that leaves little for the payload, longer
+   frames are used so that fewer headers are sent, as far as the round trip
+   time leaves room for the extra delay. Every setting is held for a while
+   after it changes, except bitrate cuts, which apply at once. */
+
+const network_cut_interval = 300 * time.Millisecond // added to the RTT between bitrate cuts
+
+// LossReport is what an RTCP receiver report tells the sender.
+type LossReport struct {
+	Time         time.Duration // arrival of the report, on any monotonic clock
+	FractionLost float64       // packets lost since the previous report, 0 to 1
+	RTT          time.Duration // round trip time, 0 when unknown
+}
+
+// BandwidthReport is a transport-wide bandwidth estimate, as from
+// transport-cc or REMB.
+type BandwidthReport struct {
+	Time    time.Duration
+	Bitrate int // bits per second available to the stream, headers included
+}
+
+// NetworkPolicy tunes a NetworkController.
+type NetworkPolicy struct {
+	MinBitrate   int // payload bitrate bounds
+	MaxBitrate   int
+	StartBitrate int // used until the loss reports say otherwise
+
+	Headroom       float64 // fraction of the estimated bandwidth to use
+	PacketOverhead int     // bytes of IP, UDP and RTP headers per packet
+
+	LossSmoothing  float64 // weight of each report in the smoothed loss
+	CongestionLoss float64 // loss above which the bitrate is cut
+	ProbeLoss      float64 // loss below which the bitrate may grow
+	ProbeStep      float64 // growth per report
+	BitrateStep    float64 // relative change needed before a raise is applied
+
+	FEC        bool    // in-band FEC allowed at all
+	FECOnLoss  float64 // smoothed loss that turns FEC on
+	FECOffLoss float64 // smoothed loss that turns it off again
+	MaxLoss    int     // cap of the expected loss percentage
+
+	MaxFrame        OpusFramesize // longest frame, OPUS_FRAMESIZE_20_MS for no change
+	MinPayload      int           // payload bitrate below which longer frames are used
+	LatencyBudget   time.Duration // limit of half the RTT plus the frame duration
+	FrameHysteresis float64       // extra payload needed to go back to shorter frames
+
+	HoldTime time.Duration // minimum time between changes of a setting
+}
+
+// VoicePolicy suits speech: low bitrates, FEC when losses appear and long
+// frames on thin links.
+func VoicePolicy() NetworkPolicy {
+	return NetworkPolicy{
+		MinBitrate:      6000,
+		MaxBitrate:      40000,
+		StartBitrate:    24000,
+		Headroom:        0.9,
+		PacketOverhead:  40,
+		LossSmoothing:   0.3,
+		CongestionLoss:  0.1,
+		ProbeLoss:       0.02,
+		ProbeStep:       0.08,
+		BitrateStep:     0.05,
+		FEC:             true,
+		FECOnLoss:       0.02,
+		FECOffLoss:      0.005,
+		MaxLoss:         25,
+		MaxFrame:        OPUS_FRAMESIZE_60_MS,
+		MinPayload:      12000,
+		LatencyBudget:   250 * time.Millisecond,
+		FrameHysteresis: 0.25,
+		HoldTime:        2 * time.Second,
+	}
+}
+
+// MusicPolicy suits music: higher bitrates and 20 ms frames, with FEC left
+// off since CELT frames do not carry it.
+func MusicPolicy() NetworkPolicy {
+	p := VoicePolicy()
+	p.MinBitrate = 24000
+	p.MaxBitrate = 128000
+	p.StartBitrate = 64000
+	p.FEC = false
+	p.MaxFrame = OPUS_FRAMESIZE_20_MS
+	p.MaxLoss = 15
+	return p
+}
+
+// NetworkState reports the settings a NetworkController has picked.
+type NetworkState struct {
+	Loss        float64       // smoothed loss rate
+	RTT         time.Duration // latest round trip time
+	Bandwidth   int           // latest bandwidth estimate, 0 when none
+	Bitrate     int           // payload bitrate given to the encoder
+	LossPercent int           // expected loss given to the encoder
+	FEC         bool
+	Frame       OpusFramesize
+	Changes     int // settings changed since the controller was created
+}
+
+// NetworkController drives the bitrate, expected loss, in-band FEC and
+// frame duration of an OpusEncoder from receiver feedback. Reports can come
+// in any mix and at any rate; each one may change the settings. The frame
+// duration only changes under policies whose MaxFrame is above 20 ms, and
+// callers then pass GetFrameSize samples per channel to each Encode call.
+type NetworkController struct {
+	enc    *OpusEncoder
+	policy NetworkPolicy
+
+	loss         float64
+	have_loss    bool
+	rtt          time.Duration
+	bandwidth    int
+	loss_bitrate float64 // ceiling from the loss-based controller
+
+	bitrate      int
+	loss_percent int
+	fec          bool
+	frame        OpusFramesize
+	changes      int
+
+	fec_changed   time.Duration
+	frame_changed time.Duration
+	loss_changed  time.Duration
+	raised        time.Duration
+	cut           time.Duration
+	low_since     time.Duration // last time the loss was above FECOffLoss
+}
+
+// NewNetworkController takes over the bitrate, expected loss, FEC and
+// frame duration settings of enc and sets them to their starting values.
+func NewNetworkController(enc *OpusEncoder, policy NetworkPolicy) *NetworkController {
+	st := &NetworkController{
+		enc:          enc,
+		policy:       policy,
+		loss_bitrate: float64(policy.StartBitrate),
+		bitrate:      IMIN(IMAX(policy.StartBitrate, policy.MinBitrate), policy.MaxBitrate),
+		frame:        OPUS_FRAMESIZE_20_MS,
+	}
+	/* Nothing holds back the first change */
+	hold := -policy.HoldTime
+	st.fec_changed, st.frame_changed, st.loss_changed, st.raised = hold, hold, hold, hold
+	st.cut = -time.Hour
+	enc.SetBitrate(st.bitrate)
+	enc.SetPacketLossPercent(0)
+	enc.SetUseInbandFEC(false)
+	enc.SetExpertFrameDuration(st.frame)
+	return st
+}
+
+func (st *NetworkController) GetPolicy() NetworkPolicy {
+	return st.policy
+}
+
+// GetFrameSize returns the samples per channel the next Encode call should
+// be given.
+func (st *NetworkController) GetFrameSize() int {
+	return frame_size_select(3*st.enc.Fs/50, st.frame, st.enc.Fs)
+}
+
+func (st *NetworkController) GetState() NetworkState {
+	return NetworkState{
+		Loss:        st.loss,
+		RTT:         st.rtt,
+		Bandwidth:   st.bandwidth,
+		Bitrate:     st.bitrate,
+		LossPercent: st.loss_percent,
+		FEC:         st.fec,
+		Frame:       st.frame,
+		Changes:     st.changes,
+	}
+}
+
+// OnLossReport accounts for a receiver report.
+func (st *NetworkController) OnLossReport(r LossReport) {
+	p := &st.policy
+	lost := math.Max(0, math.Min(1, r.FractionLost))
+	if !st.have_loss {
+		st.loss = lost
+		st.have_loss = true
+	} else {
+		st.loss += p.LossSmoothing * (lost - st.loss)
+	}
+	if r.RTT > 0 {
+		st.rtt = r.RTT
+	}
+
+	/* The loss-based rate controller works on the smoothed loss, as voice
+	   streams send too few packets per report for the raw fraction, and
+	   gives a cut one round trip to take effect before the next */
+	if st.loss > p.CongestionLoss {
+		if r.Time-st.cut >= st.rtt+network_cut_interval {
+			st.loss_bitrate = float64(st.bitrate) * (1 - 0.5*st.loss)
+			st.cut = r.Time
+		}
+	} else if st.loss < p.ProbeLoss {
+		st.loss_bitrate = math.Min(st.loss_bitrate*(1+p.ProbeStep), float64(p.MaxBitrate))
+	}
+	st.loss_bitrate = math.Max(st.loss_bitrate, float64(p.MinBitrate))
+	st.update(r.Time)
+}
+
+// OnBandwidthReport accounts for a bandwidth estimate.
+func (st *NetworkController) OnBandwidthReport(r BandwidthReport) {
+	st.bandwidth = IMAX(r.Bitrate, 0)
+	st.update(r.Time)
+}
+
+// Payload bitrate left by the bandwidth estimate with frames of the given
+// duration, or MaxBitrate when there is no estimate.
+func (st *NetworkController) payload(frame OpusFramesize) int {
+	if st.bandwidth == 0 {
+		return st.policy.MaxBitrate
+	}
+	Fs := st.enc.Fs
+	packets := Fs / frame_size_select(3*Fs/50, frame, Fs)
+	return int(st.policy.Headroom*float64(st.bandwidth)) - 8*st.policy.PacketOverhead*packets
+}
+
+// Frame duration for the current estimate.
+func (st *NetworkController) select_frame() OpusFramesize {
+	p := &st.policy
+	frame := st.frame
+	for _, f := range []OpusFramesize{OPUS_FRAMESIZE_20_MS, OPUS_FRAMESIZE_40_MS, OPUS_FRAMESIZE_60_MS} {
+		ms := time.Duration(frame_size_select(3*48000/50, f, 48000)/48) * time.Millisecond
+		if f > p.MaxFrame || (f > OPUS_FRAMESIZE_20_MS && st.rtt/2+ms > p.LatencyBudget) {
+			break
+		}
+		need := p.MinPayload
+		/* Going back to shorter frames takes a margin */
+		if f < st.frame {
+			need = int(float64(need) * (1 + p.FrameHysteresis))
+		}
+		frame = f
+		if st.payload(f) >= need {
+			break
+		}
+	}
+	return frame
+}
+
+func (st *NetworkController) update(now time.Duration) {
+	p := &st.policy
+
+	if frame := st.select_frame(); frame != st.frame && now-st.frame_changed >= p.HoldTime {
+		st.frame = frame
+		st.frame_changed = now
+		st.enc.SetExpertFrameDuration(frame)
+		st.changes++
+	}
+
+	target := int(math.Min(st.loss_bitrate, float64(st.payload(st.frame))))
+	target = IMIN(IMAX(target, p.MinBitrate), p.MaxBitrate)
+	/* Cuts apply at once, raises only when large enough or up to the
+	   maximum, and not too often */
+	raise := float64(target) > float64(st.bitrate)*(1+p.BitrateStep) || (target == p.MaxBitrate && target > st.bitrate)
+	if target < st.bitrate || (raise && now-st.raised >= p.HoldTime/4) {
+		if target > st.bitrate {
+			st.raised = now
+		}
+		st.bitrate = target
+		st.enc.SetBitrate(target)
+		st.changes++
+	}
+
+	percent := IMIN(int(math.Ceil(100*st.loss-0.5)), p.MaxLoss)
+	if percent != st.loss_percent && (percent > st.loss_percent || now-st.loss_changed >= p.HoldTime) {
+		st.loss_percent = percent
+		st.loss_changed = now
+		st.enc.SetPacketLossPercent(percent)
+		st.changes++
+	}
+
+	/* FEC goes off once the loss has stayed low for HoldTime */
+	fec := st.fec
+	if st.loss >= p.FECOffLoss {
+		st.low_since = now
+	}
+	if st.loss >= p.FECOnLoss {
+		fec = p.FEC
+	} else if now-st.low_since >= p.HoldTime {
+		fec = false
+	}
+	if fec != st.fec && now-st.fec_changed >= p.HoldTime {
+		st.fec = fec
+		st.fec_changed = now
+		st.enc.SetUseInbandFEC(fec)
+		st.changes++
+	}
+}
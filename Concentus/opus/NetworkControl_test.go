@@ -0,0 +1,248 @@
+package opus
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// A simulated link: packets beyond the capacity are dropped, on top of a
+// random loss, and queueing adds to the round trip time. Loss reports come
+// every second and bandwidth estimates every 200 ms.
+type testLink struct {
+	capacity func(now time.Duration) int // bits per second
+	loss     func(now time.Duration) float64
+	rtt      time.Duration
+}
+
+type testNetworkStep struct {
+	Now   time.Duration
+	State NetworkState
+	Sent  int // bits per second sent over the last report, headers included
+}
+
+// Runs the controller over the link for the given duration. With pcm, the
+// packets are really encoded; without, their size follows the bitrate.
+func runNetworkSim(t *testing.T, ctrl *NetworkController, link testLink, duration time.Duration, pcm []int16, seed int64) []testNetworkStep {
+	rng := rand.New(rand.NewSource(seed))
+	enc := ctrl.enc
+	out := make([]byte, 1275)
+	var steps []testNetworkStep
+	var now, next_loss, next_bw time.Duration
+	sent, lost, bits, window := 0, 0, 0, 0
+	pos := 0
+	for now < duration {
+		frame := ctrl.GetFrameSize()
+		size := ctrl.GetState().Bitrate * frame / enc.Fs / 8
+		if pcm != nil {
+			if pos+frame > len(pcm) {
+				pos = 0
+			}
+			n, err := enc.Encode(pcm, pos, frame, out, 0, len(out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			size = n
+			pos += frame
+		}
+		packet := 8 * (size + ctrl.GetPolicy().PacketOverhead)
+		bits += packet
+		window += frame
+		sent++
+
+		/* Loss from congestion on top of the random loss */
+		rate := bits * enc.Fs / window
+		drop := link.loss(now)
+		if capacity := link.capacity(now); rate > capacity {
+			drop = 1 - (1-drop)*float64(capacity)/float64(rate)
+		}
+		if rng.Float64() < drop {
+			lost++
+		}
+		now += time.Duration(frame) * time.Second / time.Duration(enc.Fs)
+
+		if now >= next_bw {
+			/* Estimates within 5% of the truth */
+			estimate := float64(link.capacity(now)) * (0.95 + 0.1*rng.Float64())
+			ctrl.OnBandwidthReport(BandwidthReport{Time: now, Bitrate: int(estimate)})
+			next_bw += 200 * time.Millisecond
+		}
+		if now >= next_loss {
+			rtt := link.rtt
+			if rate > link.capacity(now) {
+				rtt += 100 * time.Millisecond
+			}
+			ctrl.OnLossReport(LossReport{Time: now, FractionLost: float64(lost) / float64(sent), RTT: rtt})
+			steps = append(steps, testNetworkStep{Now: now, State: ctrl.GetState(), Sent: rate})
+			sent, lost, bits, window = 0, 0, 0, 0
+			next_loss += time.Second
+		}
+	}
+	return steps
+}
+
+func newNetworkEncoder(t *testing.T) *OpusEncoder {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	return enc
+}
+
+// Capacity in steps: value[i] from time at[i] on.
+func stepCapacity(at []time.Duration, value []int) func(time.Duration) int {
+	return func(now time.Duration) int {
+		c := value[0]
+		for i, t := range at {
+			if now >= t {
+				c = value[i]
+			}
+		}
+		return c
+	}
+}
+
+func TestNetworkControlBandwidth(t *testing.T) {
+	enc := newNetworkEncoder(t)
+	ctrl := NewNetworkController(enc, VoicePolicy())
+	link := testLink{
+		capacity: stepCapacity([]time.Duration{0, 10 * time.Second, 20 * time.Second, 30 * time.Second},
+			[]int{64000, 24000, 16000, 64000}),
+		loss: func(time.Duration) float64 { return 0 },
+		rtt:  100 * time.Millisecond,
+	}
+	steps := runNetworkSim(t, ctrl, link, 40*time.Second, testSpeechSignal(1, 48000, 48000), 1)
+	at := func(sec int) testNetworkStep {
+		return steps[sec-1]
+	}
+	for _, c := range []struct {
+		sec      int
+		frame    OpusFramesize
+		min, max int
+	}{
+		/* Headroom and headers leave 13.6 kbit/s with 40 ms frames at
+		   24 kbit/s, and 9.1 kbit/s with 60 ms frames at 16 kbit/s */
+		{9, OPUS_FRAMESIZE_20_MS, 40000, 40000},
+		{19, OPUS_FRAMESIZE_40_MS, 12000, 14500},
+		{29, OPUS_FRAMESIZE_60_MS, 8000, 10000},
+		{39, OPUS_FRAMESIZE_20_MS, 36000, 40000},
+	} {
+		s := at(c.sec)
+		t.Logf("%d s: %+v, %d bit/s sent", c.sec, s.State, s.Sent)
+		if s.State.Frame != c.frame || s.State.Bitrate < c.min || s.State.Bitrate > c.max {
+			t.Errorf("%d s: %v frames at %d bit/s", c.sec, s.State.Frame, s.State.Bitrate)
+		}
+		if s.Sent > link.capacity(s.Now) {
+			t.Errorf("%d s: %d bit/s sent over a %d bit/s link", c.sec, s.Sent, link.capacity(s.Now))
+		}
+	}
+	if enc.GetExpertFrameDuration() != ctrl.GetState().Frame || enc.GetUseInbandFEC() {
+		t.Fatal("encoder settings out of step")
+	}
+
+	/* A long round trip rules out long frames */
+	ctrl = NewNetworkController(newNetworkEncoder(t), VoicePolicy())
+	link.rtt = 400 * time.Millisecond
+	steps = runNetworkSim(t, ctrl, link, 25*time.Second, nil, 1)
+	if s := steps[len(steps)-1]; s.State.Frame != OPUS_FRAMESIZE_20_MS || s.State.Bitrate != 6000 {
+		t.Fatalf("%v frames at %d bit/s with a 400 ms RTT", s.State.Frame, s.State.Bitrate)
+	}
+}
+
+func TestNetworkControlLoss(t *testing.T) {
+	ctrl := NewNetworkController(newNetworkEncoder(t), VoicePolicy())
+	link := testLink{
+		capacity: func(time.Duration) int { return 128000 },
+		loss: func(now time.Duration) float64 {
+			if now >= 10*time.Second && now < 30*time.Second {
+				return 0.05
+			}
+			return 0
+		},
+		rtt: 50 * time.Millisecond,
+	}
+	steps := runNetworkSim(t, ctrl, link, 60*time.Second, nil, 2)
+	toggles := 0
+	for i, s := range steps {
+		if i > 0 && s.State.FEC != steps[i-1].State.FEC {
+			toggles++
+		}
+	}
+	mid, end := steps[25].State, steps[len(steps)-1].State
+	t.Logf("under loss: %+v", mid)
+	t.Logf("after: %+v", end)
+	if !mid.FEC || mid.LossPercent < 3 || mid.LossPercent > 8 || mid.Bitrate != 40000 {
+		t.Errorf("under 5%% loss: %+v", mid)
+	}
+	if end.FEC || end.LossPercent != 0 || toggles != 2 {
+		t.Errorf("after the loss: %+v, FEC toggled %d times", end, toggles)
+	}
+
+	/* Loss hovering around the FEC threshold does not flap */
+	ctrl = NewNetworkController(newNetworkEncoder(t), VoicePolicy())
+	link.loss = func(now time.Duration) float64 {
+		return 0.01 + 0.02*float64(int(now/time.Second)%2)
+	}
+	steps = runNetworkSim(t, ctrl, link, 60*time.Second, nil, 3)
+	toggles = 0
+	for i := 1; i < len(steps); i++ {
+		if steps[i].State.FEC != steps[i-1].State.FEC {
+			toggles++
+		}
+	}
+	if toggles > 1 {
+		t.Errorf("FEC toggled %d times", toggles)
+	}
+
+	/* Heavy loss cuts the bitrate, at most once per round trip */
+	ctrl = NewNetworkController(newNetworkEncoder(t), VoicePolicy())
+	link.loss = func(time.Duration) float64 { return 0.2 }
+	steps = runNetworkSim(t, ctrl, link, 10*time.Second, nil, 4)
+	for i := 1; i < len(steps); i++ {
+		if steps[i].State.Bitrate < steps[i-1].State.Bitrate*7/10 {
+			t.Fatalf("bitrate cut from %d to %d", steps[i-1].State.Bitrate, steps[i].State.Bitrate)
+		}
+	}
+	if s := steps[len(steps)-1].State; s.Bitrate > 12000 || s.LossPercent < 17 || s.LossPercent > 23 || !s.FEC {
+		t.Errorf("under 20%% loss: %+v", s)
+	}
+}
+
+func TestNetworkControlMusic(t *testing.T) {
+	enc := newNetworkEncoder(t)
+	ctrl := NewNetworkController(enc, MusicPolicy())
+	link := testLink{
+		capacity: func(time.Duration) int { return 48000 },
+		loss:     func(time.Duration) float64 { return 0.05 },
+		rtt:      50 * time.Millisecond,
+	}
+	steps := runNetworkSim(t, ctrl, link, 20*time.Second, nil, 5)
+	for _, s := range steps {
+		if s.State.FEC || s.State.Frame != OPUS_FRAMESIZE_20_MS || s.State.Bitrate < 24000 {
+			t.Fatalf("%v: %+v", s.Now, s.State)
+		}
+	}
+	if s := steps[len(steps)-1].State; s.LossPercent < 3 || s.Bitrate > 28000 {
+		t.Fatalf("%+v", s)
+	}
+}
+
+func TestNetworkControlDeterministic(t *testing.T) {
+	link := testLink{
+		capacity: stepCapacity([]time.Duration{0, 5 * time.Second}, []int{64000, 20000}),
+		loss:     func(time.Duration) float64 { return 0.03 },
+		rtt:      80 * time.Millisecond,
+	}
+	a := runNetworkSim(t, NewNetworkController(newNetworkEncoder(t), VoicePolicy()), link, 20*time.Second, nil, 9)
+	b := runNetworkSim(t, NewNetworkController(newNetworkEncoder(t), VoicePolicy()), link, 20*time.Second, nil, 9)
+	if len(a) != len(b) {
+		t.Fatal("runs differ in length")
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("step %d: %+v and %+v", i, a[i], b[i])
+		}
+	}
+}
@@ -62,7 +62,7 @@ func limit_warped_coefs(coefs_syn_Q24 []int, coefs_ana_Q24 []int, lambda_Q16 int
 			coefs_ana_Q24[i] = silk_SMULWW(gain_ana_Q16, coefs_ana_Q24[i])
 		}
 
-		chirp_Q16 = (3244032) - silk_DIV32_varQ(
+		chirp_Q16 = int(math.Floor(0.99*float64(1<<16)+0.5)) - silk_DIV32_varQ(
 			silk_SMULWB(maxabs_Q24-limit_Q24, silk_SMLABB(819, 102, int(iter))),
 			silk_MUL(maxabs_Q24, int(ind+1)), 22)
 		silk_bwexpander_32(coefs_syn_Q24, order, chirp_Q16)
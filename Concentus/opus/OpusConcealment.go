@@ -0,0 +1,130 @@
+package opus
+
+import (
+	"math"
+)
+
+/* Concealed output whose last 2.5 ms is below this level counts as faded out */
+const CONCEAL_SILENCE_DB = -60
+
+/* Decoded CELT packets, which carry no VAD flag, above this level are active */
+const VOICE_ACTIVITY_DB = -55
+
+// OpusConcealment describes the audio a decoder produced in place of
+// packets that never arrived.
+type OpusConcealment struct {
+	Samples     int     // samples per channel written to the output
+	Mode        int     // codec mode that concealed the gap, MODE_UNKNOWN if nothing was decoded before it
+	FEC         bool    // the end of the gap was rebuilt from the in-band FEC of the next packet
+	Energy      float64 // output level in dB relative to full scale
+	Attenuation float64 // dB by which the output is below the last decoded packet
+	Faded       bool    // the output has faded to silence by its end
+	DTX         bool    // the gap was a pause in transmission, filled with comfort noise
+}
+
+/* Mean square of n interleaved samples */
+func opus_pcm_energy(pcm []int16, pcm_ptr int, n int) float64 {
+	if n <= 0 {
+		return 0
+	}
+	sum := 0.0
+	for i := pcm_ptr; i < pcm_ptr+n; i++ {
+		sum += float64(pcm[i]) * float64(pcm[i])
+	}
+	return sum / float64(n)
+}
+
+func opus_energy_db(energy float64) float64 {
+	return 10 * math.Log10(math.Max(energy, 1e-3)/(32768.0*32768.0))
+}
+
+// Rounds a duration up to the 2.5 ms granularity of the decoder and checks
+// that the output buffer can hold it.
+func (this *OpusDecoder) conceal_size(out_pcm []int16, out_pcm_offset int, duration int) (int, error) {
+	if duration <= 0 {
+		return 0, bad_arg("duration", "Duration must be > 0")
+	}
+	F2_5 := this.Fs / 400
+	frame_size := (duration + F2_5 - 1) / F2_5 * F2_5
+	if out_pcm_offset < 0 || len(out_pcm)-out_pcm_offset < frame_size*this.channels {
+		return 0, OpusException2("Output buffer is too small", OpusError.OPUS_BUFFER_TOO_SMALL).arg("out_pcm")
+	}
+	return frame_size, nil
+}
+
+func (this *OpusDecoder) conceal_report(out_pcm []int16, out_pcm_offset int, samples int, mode int, fec bool) OpusConcealment {
+	F2_5 := this.Fs / 400
+	energy := opus_pcm_energy(out_pcm, out_pcm_offset, samples*this.channels)
+	tail := opus_pcm_energy(out_pcm, out_pcm_offset+(samples-F2_5)*this.channels, F2_5*this.channels)
+	report := OpusConcealment{
+		Samples: samples,
+		Mode:    mode,
+		FEC:     fec,
+		Energy:  opus_energy_db(energy),
+		Faded:   opus_energy_db(tail) < CONCEAL_SILENCE_DB,
+	}
+	if this.last_energy > 0 {
+		report.Attenuation = opus_energy_db(this.last_energy) - report.Energy
+	}
+	return report
+}
+
+// DecodeLost conceals a gap of the given duration in samples per channel.
+// The duration is rounded up to a multiple of 2.5 ms, so out_pcm must have
+// room for that many samples. SILK, CELT and hybrid streams are concealed
+// with the PLC of the mode of the last packet. During a DTX pause the gap
+// is filled with comfort noise instead, as by DecodeDTX.
+func (this *OpusDecoder) DecodeLost(out_pcm []int16, out_pcm_offset int, duration int) (OpusConcealment, error) {
+	frame_size, err := this.conceal_size(out_pcm, out_pcm_offset, duration)
+	if err != nil {
+		return OpusConcealment{}, err
+	}
+	mode := this.prev_mode
+	dummy := BoxedValueInt{0}
+	ret := this.opus_decode_native(nil, 0, 0, out_pcm, out_pcm_offset, frame_size, 0, 0, &dummy, 0)
+	if ret < 0 {
+		return OpusConcealment{}, OpusException2("An error occurred during concealment", ret)
+	}
+	report := this.conceal_report(out_pcm, out_pcm_offset, ret, mode, false)
+	report.DTX = this.dtx
+	return report, nil
+}
+
+// Conceal replaces a single lost packet, assuming it had the duration of the
+// last packet decoded.
+func (this *OpusDecoder) Conceal(out_pcm []int16, out_pcm_offset int) (OpusConcealment, error) {
+	duration := this.last_packet_duration
+	if duration == 0 {
+		duration = this.Fs / 50
+	}
+	return this.DecodeLost(out_pcm, out_pcm_offset, duration)
+}
+
+// DecodeFEC conceals a gap of the given duration that ends right before
+// in_data. The span the LBRR data of in_data covers is rebuilt from it and
+// anything before that is concealed with PLC. When the packet has no FEC, or
+// the gap is shorter than its frame, the whole gap falls back to PLC. The
+// packet itself still has to be decoded normally afterwards.
+func (this *OpusDecoder) DecodeFEC(in_data []byte, in_data_offset int, len int, out_pcm []int16, out_pcm_offset int, duration int) (OpusConcealment, error) {
+	frame_size, err := this.conceal_size(out_pcm, out_pcm_offset, duration)
+	if err != nil {
+		return OpusConcealment{}, err
+	}
+	if in_data == nil || len <= 0 {
+		return this.DecodeLost(out_pcm, out_pcm_offset, duration)
+	}
+	packet_mode := GetEncoderMode(in_data, in_data_offset)
+	fec := PacketHasLBRR(in_data, in_data_offset, len) &&
+		frame_size >= GetNumSamplesPerFrame(in_data, in_data_offset, this.Fs) &&
+		this.mode != MODE_CELT_ONLY
+	mode := this.prev_mode
+	if fec {
+		mode = packet_mode
+	}
+	dummy := BoxedValueInt{0}
+	ret := this.opus_decode_native(in_data, in_data_offset, len, out_pcm, out_pcm_offset, frame_size, 1, 0, &dummy, 0)
+	if ret < 0 {
+		return OpusConcealment{}, decode_error(ret, in_data, in_data_offset, len)
+	}
+	return this.conceal_report(out_pcm, out_pcm_offset, ret, mode, fec), nil
+}
@@ -0,0 +1,256 @@
+package opus
+
+import (
+	"math"
+	"testing"
+)
+
+// A harmonic tone with the given period in samples, interleaved.
+func testPeriodic(channels, samples int, period float64) []int16 {
+	out := make([]int16, samples*channels)
+	for i := 0; i < samples; i++ {
+		s := 0.0
+		for h := 1; h <= 5; h++ {
+			s += math.Sin(2*math.Pi*float64(h*i)/period+float64(h)) / float64(h)
+		}
+		for c := 0; c < channels; c++ {
+			out[i*channels+c] = int16(6000 * s * (1 - 0.3*float64(c)))
+		}
+	}
+	return out
+}
+
+func testFECPackets(tb testing.TB, pcm []int16) [][]byte {
+	return testFECModePackets(tb, pcm, MODE_SILK_ONLY, OPUS_BANDWIDTH_WIDEBAND, 24000)
+}
+
+// Packets of the given forced mode with in-band FEC enabled, which only the
+// SILK layer of SILK-only and hybrid packets carries.
+func testFECModePackets(tb testing.TB, pcm []int16, mode, bandwidth, bitrate int) [][]byte {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	enc.SetForceMode(mode)
+	enc.SetMaxBandwidth(bandwidth)
+	enc.SetBitrate(bitrate)
+	enc.SetUseInbandFEC(true)
+	enc.SetPacketLossPercent(20)
+	var packets [][]byte
+	buf := make([]byte, 1275)
+	for off := 0; off+960 <= len(pcm); off += 960 {
+		n, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:n]...))
+	}
+	return packets
+}
+
+func TestDecodeLostBeforeFirstPacket(t *testing.T) {
+	dec, _ := NewOpusDecoder(48000, 2)
+	out := make([]int16, 2*960)
+	for i := range out {
+		out[i] = 1
+	}
+	report, err := dec.DecodeLost(out, 0, 960)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Mode != MODE_UNKNOWN || !report.Faded || report.Samples != 960 {
+		t.Errorf("got %+v", report)
+	}
+	for _, s := range out {
+		if s != 0 {
+			t.Fatal("output is not silent")
+		}
+	}
+}
+
+func TestDecodeLostFades(t *testing.T) {
+	packets := testSilkPackets(t, testSpeechSignal(1, 48000, 48000), 1, 24000)
+	dec, _ := NewOpusDecoder(48000, 1)
+	out := make([]int16, 5760)
+	for _, packet := range packets[:20] {
+		if _, err := dec.Decode(packet, 0, len(packet), out, 0, 960, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	/* Durations are rounded up to 2.5 ms */
+	report, err := dec.DecodeLost(out, 0, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Samples != 1080 || report.Mode != MODE_SILK_ONLY || report.Faded {
+		t.Errorf("first loss: got %+v", report)
+	}
+	last := report.Attenuation
+	for i := 0; i < 10; i++ {
+		if report, err = dec.Conceal(out, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !report.Faded || report.Attenuation <= last+40 {
+		t.Errorf("after 200 ms: got %+v", report)
+	}
+	if _, err := dec.DecodeLost(out, 0, 5761); err == nil {
+		t.Error("too small output buffer was accepted")
+	}
+}
+
+func TestDecodeFEC(t *testing.T) {
+	packets := testFECPackets(t, testSpeechSignal(1, 48000, 48000))
+	const lost = 20
+	if !PacketHasLBRR(packets[lost+1], 0, len(packets[lost+1])) {
+		t.Fatal("packet has no LBRR data")
+	}
+	if PacketHasLBRR(packets[0], 0, len(packets[0])) {
+		t.Fatal("first packet has LBRR data")
+	}
+
+	/* Reference output without loss */
+	dec, _ := NewOpusDecoder(48000, 1)
+	ref := make([]int16, 960)
+	for _, packet := range packets[:lost+1] {
+		dec.Decode(packet, 0, len(packet), ref, 0, 960, false)
+	}
+	distance := func(fec bool) float64 {
+		dec, _ := NewOpusDecoder(48000, 1)
+		out := make([]int16, 960)
+		for _, packet := range packets[:lost] {
+			dec.Decode(packet, 0, len(packet), out, 0, 960, false)
+		}
+		var report OpusConcealment
+		var err error
+		if fec {
+			report, err = dec.DecodeFEC(packets[lost+1], 0, len(packets[lost+1]), out, 0, 960)
+		} else {
+			report, err = dec.DecodeLost(out, 0, 960)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.FEC != fec || report.Samples != 960 {
+			t.Errorf("fec %v: got %+v", fec, report)
+		}
+		return opus_pcm_energy(testSubtract(ref, out), 0, 960)
+	}
+	if fec, plc := distance(true), distance(false); fec >= plc {
+		t.Errorf("FEC error energy %.0f is not below PLC %.0f", fec, plc)
+	}
+}
+
+func TestDecodeLostModes(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		mode      int
+		bandwidth int
+		pcm       []int16
+	}{
+		{"hybrid", MODE_HYBRID, OPUS_BANDWIDTH_FULLBAND, testSpeechSignal(1, 48000, 48000)},
+		{"CELT", MODE_CELT_ONLY, OPUS_BANDWIDTH_FULLBAND, testPeriodic(1, 48000, 160.5)},
+	} {
+		packets := testFECModePackets(t, tc.pcm, tc.mode, tc.bandwidth, 32000)
+		const lost = 20
+		dec, _ := NewOpusDecoder(48000, 1)
+		ref := make([]int16, 960)
+		for _, packet := range packets[:lost+1] {
+			dec.Decode(packet, 0, len(packet), ref, 0, 960, false)
+		}
+
+		dec, _ = NewOpusDecoder(48000, 1)
+		out := make([]int16, 960)
+		for _, packet := range packets[:lost] {
+			if _, err := dec.Decode(packet, 0, len(packet), out, 0, 960, false); err != nil {
+				t.Fatal(err)
+			}
+		}
+		report, err := dec.DecodeLost(out, 0, 960)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Samples != 960 || report.Mode != tc.mode || report.FEC || report.Faded {
+			t.Errorf("%s: first loss: got %+v", tc.name, report)
+		}
+		/* The concealment continues the signal rather than muting it */
+		if e, r := opus_pcm_energy(testSubtract(ref, out), 0, 960), opus_pcm_energy(ref, 0, 960); e >= r {
+			t.Errorf("%s: error energy %.0f is not below the signal's %.0f", tc.name, e, r)
+		}
+		last := report.Attenuation
+		for i := 0; i < 10; i++ {
+			if report, err = dec.Conceal(out, 0); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if report.Mode != tc.mode || report.Attenuation <= last+10 {
+			t.Errorf("%s: after 200 ms: got %+v", tc.name, report)
+		}
+	}
+}
+
+func TestDecodeFECModes(t *testing.T) {
+	pcm := testSpeechSignal(1, 48000, 48000)
+	const lost = 20
+	for _, tc := range []struct {
+		name      string
+		mode      int
+		bandwidth int
+		fec       bool
+	}{
+		{"hybrid", MODE_HYBRID, OPUS_BANDWIDTH_FULLBAND, true},
+		{"CELT", MODE_CELT_ONLY, OPUS_BANDWIDTH_FULLBAND, false},
+	} {
+		packets := testFECModePackets(t, pcm, tc.mode, tc.bandwidth, 32000)
+		next := packets[lost+1]
+		if PacketHasLBRR(next, 0, len(next)) != tc.fec {
+			t.Fatalf("%s: LBRR data %v, want %v", tc.name, !tc.fec, tc.fec)
+		}
+		dec, _ := NewOpusDecoder(48000, 1)
+		ref := make([]int16, 960)
+		for _, packet := range packets[:lost+1] {
+			dec.Decode(packet, 0, len(packet), ref, 0, 960, false)
+		}
+
+		conceal := func(fec bool) ([]int16, OpusConcealment) {
+			dec, _ := NewOpusDecoder(48000, 1)
+			out := make([]int16, 960)
+			for _, packet := range packets[:lost] {
+				dec.Decode(packet, 0, len(packet), out, 0, 960, false)
+			}
+			var report OpusConcealment
+			var err error
+			if fec {
+				report, err = dec.DecodeFEC(next, 0, len(next), out, 0, 960)
+			} else {
+				report, err = dec.DecodeLost(out, 0, 960)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			return out, report
+		}
+		fecOut, report := conceal(true)
+		plcOut, _ := conceal(false)
+		if report.FEC != tc.fec || report.Mode != tc.mode || report.Samples != 960 {
+			t.Errorf("%s: got %+v", tc.name, report)
+		}
+		fec := opus_pcm_energy(testSubtract(ref, fecOut), 0, 960)
+		plc := opus_pcm_energy(testSubtract(ref, plcOut), 0, 960)
+		if tc.fec && fec >= plc {
+			t.Errorf("%s: FEC error energy %.0f is not below PLC %.0f", tc.name, fec, plc)
+		}
+		/* Without LBRR data the gap is concealed exactly as a plain loss */
+		if !tc.fec && fec != plc {
+			t.Errorf("%s: FEC fallback error energy %.0f, PLC %.0f", tc.name, fec, plc)
+		}
+	}
+}
+
+func testSubtract(a, b []int16) []int16 {
+	out := make([]int16, len(a))
+	for i := range a {
+		out[i] = int16(SAT16(int(a[i]) - int(b[i])))
+	}
+	return out
+}
@@ -0,0 +1,45 @@
+package opus
+
+/* Decoding across DTX pauses. With DTX on, an encoder stops sending audio
+   while the input is background noise: it sends lone TOC bytes instead, or
+   nothing at all, leaving a jump in the RTP timestamps without a gap in the
+   sequence numbers. Rather than conceal the pause as a loss, which fades
+   out, the decoder keeps playing comfort noise shaped after the noise it
+   last decoded: the SILK CNG, fed with the spectrum and gain of the inactive
+   frames, and the background band energies that CELT tracks. The pause ends
+   with the next packet that carries audio. */
+
+// Enters or leaves a DTX pause.
+func (this *OpusDecoder) set_dtx(value bool) {
+	if !value {
+		this.dtx_samples = 0
+	}
+	this.dtx = value
+	this.Celt_Decoder.SetComfortNoise(value)
+}
+
+// DecodeDTX fills a pause in transmission of the given duration in samples
+// per channel with comfort noise, and starts a DTX pause if none is under
+// way. Use it for timestamp gaps that the sequence numbers show were not
+// losses. The duration is rounded up to a multiple of 2.5 ms, as in
+// DecodeLost. Before the first packet the output is silence.
+func (this *OpusDecoder) DecodeDTX(out_pcm []int16, out_pcm_offset int, duration int) (OpusConcealment, error) {
+	if _, err := this.conceal_size(out_pcm, out_pcm_offset, duration); err != nil {
+		return OpusConcealment{}, err
+	}
+	this.set_dtx(true)
+	return this.DecodeLost(out_pcm, out_pcm_offset, duration)
+}
+
+// GetInDTX reports whether the decoder is in a DTX pause: the last output
+// was comfort noise for a DTX packet or a DecodeDTX call, or for packets
+// lost since then.
+func (this *OpusDecoder) GetInDTX() bool {
+	return this.dtx
+}
+
+// GetDTXDuration returns the samples per channel of comfort noise produced
+// since the current DTX pause began, 0 outside of one.
+func (this *OpusDecoder) GetDTXDuration() int {
+	return this.dtx_samples
+}
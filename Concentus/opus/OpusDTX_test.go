@@ -0,0 +1,238 @@
+package opus
+
+import (
+	"math"
+	"testing"
+)
+
+// One second of speech, then background noise only, coded with DTX.
+func testDTXPackets(t *testing.T) (pcm []int16, packets [][]byte) {
+	n := 5 * 48000
+	speech := testSpeechSignal(1, 48000, 48000)
+	noise := testNoise(n, 100, 1)
+	pcm = make([]int16, n)
+	for i := range pcm {
+		v := noise[i]
+		if i < len(speech) {
+			v += float64(speech[i])
+		}
+		pcm[i] = int16(math.Max(-32768, math.Min(32767, v)))
+	}
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(24000)
+	enc.SetUseDTX(true)
+	buf := make([]byte, 1275)
+	for off := 0; off+960 <= n; off += 960 {
+		ret, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:ret]...))
+	}
+	return pcm, packets
+}
+
+func TestPacketIsDTX(t *testing.T) {
+	_, packets := testDTXPackets(t)
+	dtx := 0
+	for _, packet := range packets {
+		if PacketIsDTX(packet, 0, len(packet)) {
+			if len(packet) != 1 {
+				t.Fatalf("%d byte DTX packet", len(packet))
+			}
+			dtx++
+		}
+	}
+	if dtx < len(packets)/4 || PacketIsDTX(packets[0], 0, len(packets[0])) {
+		t.Fatalf("%d of %d packets are DTX", dtx, len(packets))
+	}
+	/* Code 1 with two empty frames */
+	if !PacketIsDTX([]byte{packets[0][0]&0xfc | 1}, 0, 1) || PacketIsDTX(nil, 0, 0) {
+		t.Fatal("misclassified packet")
+	}
+}
+
+func TestVoiceActivity(t *testing.T) {
+	/* SILK packets: the decoder follows the VAD flags */
+	_, packets := testDTXPackets(t)
+	dec, err := NewOpusDecoder(48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make([]int16, 960)
+	active := 0
+	for i, packet := range packets {
+		if _, err := dec.Decode(packet, 0, len(packet), out, 0, 960, false); err != nil {
+			t.Fatal(err)
+		}
+		if dec.GetVoiceActivity() != PacketHasVoiceActivity(packet, 0, len(packet)) {
+			t.Fatalf("packet %d: decoder and packet flags disagree", i)
+		}
+		if dec.GetVoiceActivity() {
+			active++
+		}
+	}
+	if active == 0 || active == len(packets) {
+		t.Fatalf("%d of %d SILK packets active", active, len(packets))
+	}
+
+	/* CELT packets: digital silence, noise at -70 dB and speech */
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_CELT_ONLY)
+	dec, err = NewOpusDecoder(48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	speech := testSpeechSignal(1, 10*960, 48000)
+	noise := testNoise(10*960, 10, 1)
+	faint := make([]int16, len(noise))
+	for i, v := range noise {
+		faint[i] = int16(v)
+	}
+	buf := make([]byte, 1275)
+	for _, tc := range []struct {
+		name   string
+		pcm    []int16
+		packet bool
+	}{
+		{"silence", make([]int16, 10*960), false},
+		{"noise", faint, true},
+		{"speech", speech, true},
+	} {
+		for f := 0; f < 10; f++ {
+			n, err := enc.Encode(tc.pcm, f*960, 960, buf, 0, len(buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := dec.Decode(buf, 0, n, out, 0, 960, false); err != nil {
+				t.Fatal(err)
+			}
+			/* The first frames still ring with the one before */
+			if f < 3 {
+				continue
+			}
+			if got := PacketHasVoiceActivity(buf, 0, n); got != tc.packet {
+				t.Fatalf("%s frame %d: packet activity %v", tc.name, f, got)
+			}
+			/* Clear of the threshold, the decoded level decides */
+			level := opus_energy_db(opus_pcm_energy(tc.pcm, f*960, 960))
+			if got := dec.GetVoiceActivity(); (level > VOICE_ACTIVITY_DB+10 && !got) || (level < VOICE_ACTIVITY_DB-10 && got) {
+				t.Fatalf("%s frame %d at %.0f dB: decoded activity %v", tc.name, f, level, got)
+			}
+		}
+	}
+}
+
+func TestDecodeDTX(t *testing.T) {
+	_, packets := testDTXPackets(t)
+
+	/* Level of the noise decoded from the packets that carry it, and of the
+	   comfort noise in between. The CNG plays from a smoothed spectrum and
+	   gain, a few dB below the coded noise, but does not fade out. */
+	dec, _ := NewOpusDecoder(48000, 1)
+	out := make([]int16, len(packets)*960)
+	var coded, comfort []float64
+	run := 0
+	for i, packet := range packets {
+		if _, err := dec.Decode(packet, 0, len(packet), out, i*960, 960, false); err != nil {
+			t.Fatal(err)
+		}
+		dtx := PacketIsDTX(packet, 0, len(packet))
+		if dec.GetInDTX() != dtx {
+			t.Fatalf("packet %d: in DTX %v", i, dec.GetInDTX())
+		}
+		if run = 0; dtx {
+			run = dec.GetDTXDuration()
+		}
+		if i >= 75 {
+			e := testEnergy(out, i*960, (i+1)*960)
+			if dtx {
+				comfort = append(comfort, e)
+			} else {
+				coded = append(coded, e)
+			}
+		}
+	}
+	mean := func(x []float64) float64 {
+		sum := 0.0
+		for _, v := range x {
+			sum += v
+		}
+		return sum / float64(len(x))
+	}
+	low := math.Inf(1)
+	for _, e := range comfort {
+		low = math.Min(low, e)
+	}
+	t.Logf("noise at %.1f dB, comfort noise at %.1f dB, %.1f at the lowest", mean(coded), mean(comfort), low)
+	if math.Abs(mean(comfort)-mean(coded)) > 6 || low < mean(coded)-10 {
+		t.Fatal("comfort noise does not match the background")
+	}
+	if run != 0 && run%960 != 0 {
+		t.Fatalf("DTX duration %d", run)
+	}
+
+	/* The same stream without the DTX packets, as a server would forward it */
+	dec, _ = NewOpusDecoder(48000, 1)
+	tracer := &testDTXTracer{}
+	dec.SetTracer(tracer)
+	pauses := 0
+	for i := 0; i < len(packets); {
+		packet := packets[i]
+		if !PacketIsDTX(packet, 0, len(packet)) {
+			if _, err := dec.Decode(packet, 0, len(packet), out, 0, 960, false); err != nil {
+				t.Fatal(err)
+			}
+			if dec.GetInDTX() || dec.GetDTXDuration() != 0 {
+				t.Fatal("DTX pause outlasted a packet")
+			}
+			i++
+			continue
+		}
+		gap := 0
+		for ; i < len(packets) && PacketIsDTX(packets[i], 0, len(packets[i])); i++ {
+			gap++
+		}
+		report, err := dec.DecodeDTX(out, 0, gap*960)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !report.DTX || report.Samples != gap*960 || dec.GetDTXDuration() != gap*960 {
+			t.Fatalf("gap of %d frames: %+v", gap, report)
+		}
+		if gap >= 5 && math.Abs(report.Energy-opus_energy_db(math.Pow(10, mean(coded)/10))) > 6 {
+			t.Fatalf("gap of %d frames at %.1f dB", gap, report.Energy)
+		}
+		/* A loss during the pause is concealed with comfort noise too */
+		if report, err = dec.DecodeLost(out, 0, 960); err != nil || !report.DTX || !dec.GetInDTX() {
+			t.Fatalf("loss in a pause: %+v", report)
+		}
+		pauses++
+	}
+	if pauses == 0 || tracer.dtx == 0 || tracer.lost != 0 {
+		t.Fatalf("%d pauses, %d frames traced as DTX and %d as lost", pauses, tracer.dtx, tracer.lost)
+	}
+}
+
+type testDTXTracer struct {
+	dtx, lost int
+}
+
+func (tr *testDTXTracer) Trace(event TraceEvent) {
+	if frame, ok := event.(TraceFrame); ok {
+		if frame.DTX {
+			tr.dtx++
+		}
+		if frame.Lost {
+			tr.lost++
+		}
+	}
+}
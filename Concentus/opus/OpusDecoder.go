@@ -1,9 +1,5 @@
 package opus
 
-import (
-	"errors"
-)
-
 type OpusDecoder struct {
 	channels             int
 	Fs                   int
@@ -17,6 +13,14 @@ type OpusDecoder struct {
 	prev_redundancy      int
 	last_packet_duration int
 	rangeFinal           int
+	last_energy          float64
+	voice_activity       bool
+	dtx                  bool
+	dtx_samples          int
+	tracer               Tracer
+	disable_inv          bool
+	mono_downmix         bool
+	complexity           int
 	SilkDecoder          SilkDecoder
 	Celt_Decoder         CeltDecoder
 }
@@ -38,6 +42,10 @@ func (this *OpusDecoder) partialReset() {
 	this.prev_redundancy = 0
 	this.last_packet_duration = 0
 	this.rangeFinal = 0
+	this.last_energy = 0
+	this.voice_activity = false
+	this.dtx = false
+	this.dtx_samples = 0
 }
 
 func (this *OpusDecoder) opus_decoder_init(Fs int, channels int) int {
@@ -69,6 +77,9 @@ func (this *OpusDecoder) opus_decoder_init(Fs int, channels int) int {
 	}
 
 	celt_dec.SetSignalling(0)
+	this.disable_inv = celt_dec.GetPhaseInversionDisabled()
+	this.mono_downmix = false
+	this.complexity = celt_dec.GetComplexity()
 
 	this.prev_mode = MODE_UNKNOWN
 	this.frame_size = Fs / 400
@@ -79,20 +90,17 @@ func NewOpusDecoder(Fs int, channels int) (*OpusDecoder, error) {
 	this := &OpusDecoder{}
 	var ret int
 	if Fs != 48000 && Fs != 24000 && Fs != 16000 && Fs != 12000 && Fs != 8000 {
-		return nil, errors.New("Sample rate is invalid (must be 8/12/16/24/48 Khz)")
+		return nil, bad_arg("Fs", "Sample rate is invalid (must be 8/12/16/24/48 Khz)")
 	}
 	if channels != 1 && channels != 2 {
-		return nil, errors.New("Number of channels must be 1 or 2")
+		return nil, bad_arg("channels", "Number of channels must be 1 or 2")
 	}
 	this.SilkDecoder = NewSilkDecoder()
 	this.Celt_Decoder = CeltDecoder{}
 
 	ret = this.opus_decoder_init(Fs, channels)
 	if ret != OpusError.OPUS_OK {
-		if ret == OpusError.OPUS_BAD_ARG {
-			return nil, errors.New("OPUS_BAD_ARG when creating decoder")
-		}
-		return nil, errors.New("eeee")
+		return nil, OpusException2("Error while initializing decoder", ret)
 	}
 	return this, nil
 }
@@ -134,6 +142,7 @@ func (this *OpusDecoder) opus_decode_frame(data []byte, data_ptr int, len int, p
 		audiosize = this.frame_size
 		mode = this.mode
 		dec.dec_init(data, data_ptr, len)
+		dec.tracer = this.tracer
 	} else {
 		audiosize = frame_size
 		mode = this.prev_mode
@@ -291,6 +300,12 @@ func (this *OpusDecoder) opus_decode_frame(data []byte, data_ptr int, len int, p
 			dec.storage = dec.storage - redundancy_bytes
 		}
 	}
+	if mode == MODE_HYBRID && data != nil && this.complexity < 3 {
+		/* Only the SILK layer is decoded, leaving out the CELT layer and
+		   any redundant frame */
+		mode = MODE_SILK_ONLY
+		redundancy = 0
+	}
 	if mode != MODE_CELT_ONLY {
 		start_band = 17
 	}
@@ -394,6 +409,9 @@ func (this *OpusDecoder) opus_decode_frame(data []byte, data_ptr int, len int, p
 				pcm, pcm_ptr, F2_5, this.channels, window, this.Fs)
 		}
 	}
+	if this.mono_downmix && this.channels == 2 {
+		downmix_stereo(pcm, pcm_ptr, frame_size)
+	}
 	if this.decode_gain != 0 {
 		gain := celt_exp2(int(MULT16_16_P15(QCONST16(6.48814081e-4, 25), int16(this.decode_gain))))
 		for i = pcm_ptr; i < pcm_ptr+(frame_size*this.channels); i++ {
@@ -445,6 +463,12 @@ func (this *OpusDecoder) opus_decode_native(data []byte, data_ptr int, len int,
 		}
 		OpusAssert(pcm_count == frame_size)
 		this.last_packet_duration = pcm_count
+		if this.dtx {
+			this.dtx_samples += pcm_count
+		}
+		if this.tracer != nil {
+			this.trace_frame(this.prev_mode, 0, pcm_count, true, false)
+		}
 		return pcm_count
 	} else if len < 0 {
 		return OpusError.OPUS_BAD_ARG
@@ -493,6 +517,9 @@ func (this *OpusDecoder) opus_decode_native(data []byte, data_ptr int, len int,
 			return ret
 		} else {
 			this.last_packet_duration = frame_size
+			if this.tracer != nil {
+				this.trace_frame(this.mode, int(size[0]), packet_frame_size, false, true)
+			}
 			return frame_size
 		}
 	}
@@ -506,6 +533,16 @@ func (this *OpusDecoder) opus_decode_native(data []byte, data_ptr int, len int,
 	this.frame_size = packet_frame_size
 	this.stream_channels = packet_stream_channels
 
+	/* A packet without audio starts or continues a DTX pause, any other
+	   packet ends it. Losses during a pause leave it going. */
+	dtx := true
+	for i = 0; i < count; i++ {
+		if size[i] > 1 {
+			dtx = false
+		}
+	}
+	this.set_dtx(dtx)
+
 	nb_samples = 0
 	for i = 0; i < count; i++ {
 		ret := this.opus_decode_frame(data, data_ptr, int(size[i]), pcm_out, pcm_out_ptr+(nb_samples*this.channels), frame_size-nb_samples, 0)
@@ -513,17 +550,37 @@ func (this *OpusDecoder) opus_decode_native(data []byte, data_ptr int, len int,
 			return ret
 		}
 		OpusAssert(ret == packet_frame_size)
+		if this.tracer != nil {
+			this.trace_frame(this.mode, int(size[i]), ret, false, false)
+		}
 		data_ptr += int(size[i])
 		nb_samples += ret
 	}
 	this.last_packet_duration = nb_samples
+	if this.dtx {
+		this.dtx_samples += nb_samples
+	}
 
 	return nb_samples
 }
 
+// Wraps an error code returned by opus_decode_native for the packet of len
+// bytes at data_ptr. Packet errors point at the fault the framing checks find,
+// if any.
+func decode_error(code int, data []byte, data_ptr int, len int) *OpusException {
+	err := OpusException2("An error occurred during decoding", code)
+	switch code {
+	case OpusError.OPUS_BUFFER_TOO_SMALL:
+		err.arg("frame_size")
+	case OpusError.OPUS_INVALID_PACKET:
+		err.at_fault(data, data_ptr, len)
+	}
+	return err
+}
+
 func (this *OpusDecoder) Decode(in_data []byte, in_data_offset int, len int, out_pcm []int16, out_pcm_offset int, frame_size int, decode_fec bool) (int, error) {
 	if frame_size <= 0 {
-		return 0, errors.New("Frame size must be > 0")
+		return 0, bad_arg("frame_size", "Frame size must be > 0")
 	}
 
 	dummy := BoxedValueInt{0}
@@ -534,10 +591,17 @@ func (this *OpusDecoder) Decode(in_data []byte, in_data_offset int, len int, out
 	ret := this.opus_decode_native(in_data, in_data_offset, len, out_pcm, out_pcm_offset, frame_size, decode_fec_int, 0, &dummy, 0)
 
 	if ret < 0 {
-		if ret == OpusError.OPUS_BAD_ARG {
-			return 0, errors.New("OPUS_BAD_ARG while decoding")
+		return 0, decode_error(ret, in_data, in_data_offset, len)
+	}
+	if len > 0 && in_data != nil && !decode_fec && !this.dtx {
+		this.last_energy = opus_pcm_energy(out_pcm, out_pcm_offset, ret*this.channels)
+		if GetEncoderMode(in_data, in_data_offset) == MODE_CELT_ONLY {
+			this.voice_activity = opus_energy_db(this.last_energy) > VOICE_ACTIVITY_DB
+		} else {
+			this.voice_activity = PacketHasVoiceActivity(in_data, in_data_offset, len)
 		}
-		return 0, errors.New("An error occurred during decoding")
+	} else if this.dtx {
+		this.voice_activity = false
 	}
 
 	return ret, nil
@@ -559,6 +623,30 @@ func (this *OpusDecoder) DecodeBytes(in_data []byte, in_data_offset int, len int
 	return decSamples, nil
 }
 
+func (this *OpusDecoder) trace_frame(mode int, bytes int, frame_size int, lost bool, fec bool) {
+	this.tracer.Trace(TraceFrame{
+		Mode:       mode,
+		Bandwidth:  this.bandwidth,
+		Channels:   this.stream_channels,
+		FrameSize:  frame_size,
+		Bytes:      bytes,
+		Lost:       lost && !this.dtx,
+		FEC:        fec,
+		DTX:        this.dtx,
+		FinalRange: uint32(this.rangeFinal),
+	})
+}
+
+// SetTracer sets the sink for the trace events of this decoder, nil to
+// disable tracing (the default).
+func (this *OpusDecoder) SetTracer(value Tracer) {
+	this.tracer = value
+}
+
+func (this *OpusDecoder) GetTracer() Tracer {
+	return this.tracer
+}
+
 func (this *OpusDecoder) GetBandwidth() int {
 	return this.bandwidth
 }
@@ -585,12 +673,81 @@ func (this *OpusDecoder) GetGain() int {
 
 func (this *OpusDecoder) SetGain(value int) error {
 	if value < -32768 || value > 32767 {
-		return errors.New("Gain must be within the range of a signed int16")
+		return bad_arg("value", "Gain must be within the range of a signed int16")
 	}
 	this.decode_gain = value
 	return nil
 }
 
+// SetPhaseInversionDisabled makes the decoder ignore phase inversion in
+// intensity-coded CELT bands. Mono decoders default to true, stereo decoders
+// to false.
+func (this *OpusDecoder) SetPhaseInversionDisabled(value bool) {
+	this.disable_inv = value
+	this.Celt_Decoder.SetPhaseInversionDisabled(this.disable_inv || this.mono_downmix)
+}
+
+func (this *OpusDecoder) GetPhaseInversionDisabled() bool {
+	return this.disable_inv
+}
+
+// SetMonoDownmix makes a stereo decoder output the mono downmix of the
+// stream on both channels. Phase inversion is ignored while it is on, so
+// intensity-coded bands add up instead of cancelling out.
+func (this *OpusDecoder) SetMonoDownmix(value bool) {
+	this.mono_downmix = value
+	this.Celt_Decoder.SetPhaseInversionDisabled(this.disable_inv || this.mono_downmix)
+}
+
+func (this *OpusDecoder) GetMonoDownmix() bool {
+	return this.mono_downmix
+}
+
+// SetComplexity trades decoding quality for CPU, from 0 (cheapest) to 10
+// (the default, exact decoding). Each level keeps the savings of those
+// above it:
+//
+//	8-10  everything is decoded
+//	6-7   the CELT pitch post-filter is skipped
+//	5     lost CELT frames are filled with noise instead of repeating the
+//	      last pitch period
+//	3-4   CELT bands above 12 kHz are parsed but not synthesized
+//	2     hybrid packets are decoded as SILK-only wideband
+//	0-1   CELT bands above 8 kHz are not synthesized either
+//
+// Below 8 the output no longer matches the reference decoder, and below 3
+// GetFinalRange no longer matches the encoder's for hybrid packets.
+func (this *OpusDecoder) SetComplexity(value int) error {
+	if value < 0 || value > 10 {
+		return bad_arg("value", "Complexity must be between 0 and 10")
+	}
+	this.complexity = value
+	this.Celt_Decoder.SetComplexity(value)
+	return nil
+}
+
+func (this *OpusDecoder) GetComplexity() int {
+	return this.complexity
+}
+
+// Replaces both channels of frame_size interleaved stereo samples with
+// their average.
+func downmix_stereo(pcm []int16, pcm_ptr int, frame_size int) {
+	for i := pcm_ptr; i < pcm_ptr+2*frame_size; i += 2 {
+		m := int16((int(pcm[i]) + int(pcm[i+1])) >> 1)
+		pcm[i] = m
+		pcm[i+1] = m
+	}
+}
+
+// GetVoiceActivity reports whether the last packet decoded carried speech or
+// other activity: the VAD flags of SILK and hybrid packets, and the decoded
+// level of CELT packets. Concealed frames keep the activity of the packet
+// before them, and DTX reports none.
+func (this *OpusDecoder) GetVoiceActivity() bool {
+	return this.voice_activity
+}
+
 func (this *OpusDecoder) GetLastPacketDuration() int {
 	return this.last_packet_duration
 }
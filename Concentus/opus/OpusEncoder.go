@@ -1,10 +1,10 @@
 package opus
 
 import (
-	"errors"
 	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type OpusEncoder struct {
@@ -45,6 +45,11 @@ type OpusEncoder struct {
 	delay_buffer            [MAX_ENCODER_BUFFER * 2]int16
 	detected_bandwidth      int
 	rangeFinal              int
+	tracer                  Tracer
+	realtime                realtime_controller
+	preprocessor            *preprocess_stage
+	silk_config             SilkConfig
+	silk_status             SilkStatus
 	SilkEncoder             SilkEncoder
 	Celt_Encoder            CeltEncoder
 }
@@ -69,6 +74,7 @@ func (st *OpusEncoder) reset() {
 	st.lsb_depth = 0
 	st.encoder_buffer = 0
 	st.lfe = 0
+	st.silk_config = SilkConfig{}
 	st.analysis.Reset()
 	st.PartialReset()
 }
@@ -102,6 +108,9 @@ func (st *OpusEncoder) ResetState() {
 	st.analysis.Reset()
 	st.PartialReset()
 	st.Celt_Encoder.ResetState()
+	if st.preprocessor != nil {
+		st.preprocessor.reset()
+	}
 	silk_InitEncoder(&st.SilkEncoder, &dummy)
 	st.stream_channels = st.channels
 	st.hybrid_stereo_width_Q14 = 1 << 14
@@ -114,10 +123,10 @@ func (st *OpusEncoder) ResetState() {
 
 func NewOpusEncoder(Fs, channels int, application OpusApplication) (*OpusEncoder, error) {
 	if Fs != 48000 && Fs != 24000 && Fs != 16000 && Fs != 12000 && Fs != 8000 {
-		return nil, errors.New("Sample rate is invalid (must be 8/12/16/24/48 Khz)")
+		return nil, bad_arg("Fs", "Sample rate is invalid (must be 8/12/16/24/48 Khz)")
 	}
 	if channels != 1 && channels != 2 {
-		return nil, errors.New("Number of channels must be 1 or 2")
+		return nil, bad_arg("channels", "Number of channels must be 1 or 2")
 	}
 	st := &OpusEncoder{}
 
@@ -129,9 +138,9 @@ func NewOpusEncoder(Fs, channels int, application OpusApplication) (*OpusEncoder
 	ret := st.opus_init_encoder(Fs, channels, application)
 	if ret != OpusError.OPUS_OK {
 		if ret == OpusError.OPUS_BAD_ARG {
-			return nil, errors.New("OPUS_BAD_ARG when creating encoder")
+			return nil, bad_arg("application", "Error while initializing encoder")
 		}
-		return nil, errors.New("Error while initializing encoder")
+		return nil, OpusException2("Error while initializing encoder", ret)
 	}
 	return st, nil
 }
@@ -212,6 +221,7 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 
 	silk_enc := &st.SilkEncoder
 	celt_enc := &st.Celt_Encoder
+	st.silk_status = SilkStatus{}
 
 	var i int
 	var ret int = 0
@@ -357,7 +367,7 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 		data[data_ptr] = gen_toc(tocmode, frame_rate, bw, st.stream_channels)
 		ret = 1
 		if st.use_vbr == 0 {
-			ret = PadPacket(data, data_ptr, ret, max_data_bytes)
+			ret = opus_packet_pad(data, data_ptr, ret, max_data_bytes)
 			if ret == OpusError.OPUS_OK {
 				ret = max_data_bytes
 			}
@@ -729,6 +739,16 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 	data_ptr += 1
 
 	enc.enc_init(data, data_ptr, (max_data_bytes - 1))
+	enc.tracer = st.tracer
+	if st.tracer != nil {
+		st.tracer.Trace(TraceModeDecision{
+			Mode:      st.mode,
+			Bandwidth: curr_bandwidth,
+			Channels:  st.stream_channels,
+			FrameSize: frame_size,
+			Bitrate:   st.bitrate_bps,
+		})
+	}
 
 	pcm_buf := make([]int16, (total_buffer+frame_size)*st.channels)
 	//System.arraycopy(st.delay_buffer, ((st.encoder_buffer - total_buffer) * st.channels), pcm_buf, 0, total_buffer*st.channels)
@@ -878,8 +898,11 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 		} else {
 			st.silk_mode.maxInternalSampleRate = 16000
 		}
+		if st.mode == MODE_SILK_ONLY {
+			st.apply_silk_rates()
+		}
 
-		st.silk_mode.useCBR = boolToInt(st.use_vbr == 0)
+		st.silk_mode.useCBR = boolToInt(st.use_vbr == 0 || st.silk_config.UseCBR)
 
 		/* Call SILK encoder for the low band */
 		nBytes = IMIN(1275, max_data_bytes-1-redundancy_bytes)
@@ -894,6 +917,9 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 			/* Reduce the initial target to make it easier to reach the CBR rate */
 			st.silk_mode.bitRate = IMAX(1, st.silk_mode.bitRate-2000)
 		}
+		if st.silk_config.MaxBits > 0 {
+			st.silk_mode.maxBits = IMIN(st.silk_mode.maxBits, st.silk_config.MaxBits)
+		}
 
 		if prefill != 0 {
 			zero := &BoxedValueInt{0}
@@ -927,6 +953,8 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 
 			return OpusError.OPUS_INTERNAL_ERROR
 		}
+		st.silk_status.InternalSampleRate = st.silk_mode.internalSampleRate
+		st.silk_status.BandwidthSwitchAllowed = st.silk_mode.allowBandwidthSwitch != 0
 		if nBytes == 0 {
 			st.rangeFinal = 0
 			data[data_ptr-1] = gen_toc(st.mode, st.Fs/frame_size, curr_bandwidth, st.stream_channels)
@@ -1223,7 +1251,7 @@ func (st *OpusEncoder) opus_encode_native(pcm []int16, pcm_ptr, frame_size int,
 	/* Count ToC and redundancy */
 	ret += 1 + redundancy_bytes
 	if st.use_vbr == 0 {
-		if PadPacket(data, data_ptr, ret, max_data_bytes) != OpusError.OPUS_OK {
+		if opus_packet_pad(data, data_ptr, ret, max_data_bytes) != OpusError.OPUS_OK {
 			return OpusError.OPUS_INTERNAL_ERROR
 		}
 		ret = max_data_bytes
@@ -1248,9 +1276,22 @@ func formatSignedBytes(data []byte) string {
 	return builder.String()
 }
 func (st *OpusEncoder) Encode(in_pcm []int16, pcm_offset, frame_size int, out_data []byte, out_data_offset, max_data_bytes int) (int, error) {
+	if st.realtime.budget > 0 {
+		defer st.realtime_frame(st.realtime.now())
+	}
 
 	if out_data_offset+max_data_bytes > len(out_data) {
-		return 0, errors.New("Output buffer is too small")
+		return 0, OpusException2("Output buffer is too small", OpusError.OPUS_BUFFER_TOO_SMALL).arg("max_data_bytes")
+	}
+	if st.preprocessor != nil {
+		if err := st.preprocessor.check(frame_size); err != nil {
+			return 0, err
+		}
+		if pcm_offset+frame_size*st.channels > len(in_pcm) {
+			return 0, bad_arg("in_pcm", "Not enough samples provided in input signal")
+		}
+		in_pcm = st.preprocessor.process(in_pcm, pcm_offset, frame_size)
+		pcm_offset = 0
 	}
 	delay_compensation := st.delay_compensation
 	if st.application == OPUS_APPLICATION_RESTRICTED_LOWDELAY {
@@ -1258,19 +1299,55 @@ func (st *OpusEncoder) Encode(in_pcm []int16, pcm_offset, frame_size int, out_da
 	}
 	internal_frame_size := compute_frame_size(in_pcm, pcm_offset, frame_size, st.variable_duration, st.channels, st.Fs, st.bitrate_bps, delay_compensation, st.analysis.subframe_mem, st.analysis.enabled)
 	if pcm_offset+internal_frame_size > len(in_pcm) {
-		return 0, errors.New("Not enough samples provided in input signal")
+		return 0, bad_arg("in_pcm", "Not enough samples provided in input signal")
 	}
 
-	ret := st.opus_encode_native(in_pcm, pcm_offset, internal_frame_size, out_data, out_data_offset, max_data_bytes, 16, in_pcm, pcm_offset, frame_size, 0, -2, st.channels, 0)
+	return st.encode_frame(in_pcm, pcm_offset, internal_frame_size, frame_size, out_data, out_data_offset, max_data_bytes)
+}
+
+// Encodes the first frame_size samples of pcm, of which analysis_size are
+// available to the analysis.
+func (st *OpusEncoder) encode_frame(pcm []int16, pcm_offset, frame_size, analysis_size int, out_data []byte, out_data_offset, max_data_bytes int) (int, error) {
+	ret := st.opus_encode_native(pcm, pcm_offset, frame_size, out_data, out_data_offset, max_data_bytes, 16, pcm, pcm_offset, analysis_size, 0, -2, st.channels, 0)
 	if ret < 0 {
-		if ret == OpusError.OPUS_BAD_ARG {
-			return 0, errors.New("OPUS_BAD_ARG while encoding")
-		}
-		return 0, errors.New("An error occurred during encoding")
+		return 0, encode_error(ret, max_data_bytes)
+	}
+	if st.tracer != nil {
+		st.trace_frame(out_data, out_data_offset, frame_size, ret)
 	}
 	return ret, nil
 }
 
+// Wraps an error code returned by opus_encode_native for a packet of at most
+// max_data_bytes. A bad argument is the frame size unless there is no room at
+// all for the packet.
+func encode_error(code int, max_data_bytes int) *OpusException {
+	err := OpusException2("An error occurred during encoding", code)
+	switch code {
+	case OpusError.OPUS_BAD_ARG:
+		if max_data_bytes <= 0 {
+			err.arg("max_data_bytes")
+		} else {
+			err.arg("frame_size")
+		}
+	case OpusError.OPUS_BUFFER_TOO_SMALL:
+		err.arg("max_data_bytes")
+	}
+	return err
+}
+
+func (st *OpusEncoder) trace_frame(data []byte, data_ptr int, frame_size int, len int) {
+	st.tracer.Trace(TraceFrame{
+		Encoder:    true,
+		Mode:       GetEncoderMode(data, data_ptr),
+		Bandwidth:  GetBandwidth(data, data_ptr),
+		Channels:   GetNumEncodedChannels(data, data_ptr),
+		FrameSize:  frame_size,
+		Bytes:      len,
+		FinalRange: uint32(st.rangeFinal),
+	})
+}
+
 func (st *OpusEncoder) GetApplication() OpusApplication {
 	return st.application
 }
@@ -1360,10 +1437,55 @@ func (st *OpusEncoder) SetComplexity(value int) {
 	if value < 0 || value > 10 {
 		panic("Complexity must be between 0 and 10")
 	}
+	st.set_complexity(value)
+	st.realtime.ceiling = value
+}
+
+func (st *OpusEncoder) set_complexity(value int) {
 	st.silk_mode.complexity = value
 	st.Celt_Encoder.SetComplexity(value)
 }
 
+// SetRealtimeBudget enables the real-time mode, in which the complexity is
+// adjusted after each frame to keep the time spent in Encode under budget.
+// The complexity set beforehand, or with SetComplexity later on, becomes the
+// highest the encoder may use. A budget of 0 disables the mode and restores
+// that complexity.
+func (st *OpusEncoder) SetRealtimeBudget(budget time.Duration) error {
+	if budget < 0 {
+		return bad_arg("budget", "Invalid real-time budget")
+	}
+	if budget == 0 {
+		if st.realtime.budget > 0 {
+			st.set_complexity(st.realtime.ceiling)
+		}
+		st.realtime.enable(0, 0)
+		return nil
+	}
+	if st.realtime.budget == 0 {
+		st.realtime.enable(budget, st.silk_mode.complexity)
+	} else {
+		st.realtime.budget = budget
+	}
+	return nil
+}
+
+func (st *OpusEncoder) GetRealtimeBudget() time.Duration {
+	return st.realtime.budget
+}
+
+// GetRealtimeState returns the latest decisions of the real-time mode.
+func (st *OpusEncoder) GetRealtimeState() RealtimeState {
+	return st.realtime.state(st.silk_mode.complexity)
+}
+
+func (st *OpusEncoder) realtime_frame(start time.Time) {
+	complexity := st.realtime.update(st.realtime.now().Sub(start), st.silk_mode.complexity)
+	if complexity != st.silk_mode.complexity {
+		st.set_complexity(complexity)
+	}
+}
+
 func (st *OpusEncoder) GetUseInbandFEC() bool {
 	return st.silk_mode.useInBandFEC != 0
 }
@@ -1427,6 +1549,9 @@ func (st *OpusEncoder) GetLookahead() int {
 	if st.application != OPUS_APPLICATION_RESTRICTED_LOWDELAY {
 		returnVal += st.delay_compensation
 	}
+	if st.preprocessor != nil {
+		returnVal += st.preprocessor.pre.Delay()
+	}
 	return returnVal
 }
 
@@ -1504,6 +1629,51 @@ func (st *OpusEncoder) SetEnergyMask(value []int) {
 	st.Celt_Encoder.SetEnergyMask(value)
 }
 
+// SetTracer sets the sink for the trace events of this encoder, nil to
+// disable tracing (the default).
+func (st *OpusEncoder) SetTracer(value Tracer) {
+	st.tracer = value
+}
+
+func (st *OpusEncoder) GetTracer() Tracer {
+	return st.tracer
+}
+
+// SetPhaseInversionDisabled keeps the stereo CELT output safe to downmix to
+// mono, at a small cost in stereo image. See
+// CeltEncoder.SetPhaseInversionDisabled.
+func (st *OpusEncoder) SetPhaseInversionDisabled(value bool) {
+	st.Celt_Encoder.SetPhaseInversionDisabled(value)
+}
+
+func (st *OpusEncoder) GetPhaseInversionDisabled() bool {
+	return st.Celt_Encoder.GetPhaseInversionDisabled()
+}
+
+// SetPreprocessor runs the input through pre before encoding, which must
+// take the sample rate and channel count of the encoder. Frames must then
+// be a multiple of its frame size, and its delay adds to the lookahead.
+// nil, the default, turns preprocessing off.
+func (st *OpusEncoder) SetPreprocessor(pre Preprocessor) error {
+	if pre == nil {
+		st.preprocessor = nil
+		return nil
+	}
+	ps, err := new_preprocess_stage(pre, st.Fs, st.channels)
+	if err != nil {
+		return err
+	}
+	st.preprocessor = ps
+	return nil
+}
+
+func (st *OpusEncoder) GetPreprocessor() Preprocessor {
+	if st.preprocessor == nil {
+		return nil
+	}
+	return st.preprocessor.pre
+}
+
 func (st *OpusEncoder) GetCeltMode() *CeltMode {
 	return st.Celt_Encoder.GetMode()
 }
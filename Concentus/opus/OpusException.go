@@ -1,26 +1,119 @@
 package opus
 
+import (
+	"errors"
+	"strconv"
+)
+
+// Sentinel errors, one per OpusError code. Every error returned by the
+// package matches one of them with errors.Is.
+var (
+	ErrBadArg         = errors.New("opus: invalid argument")
+	ErrBufferTooSmall = errors.New("opus: buffer too small")
+	ErrInternalError  = errors.New("opus: internal error")
+	ErrInvalidPacket  = errors.New("opus: corrupted stream")
+	ErrUnimplemented  = errors.New("opus: request not implemented")
+	ErrInvalidState   = errors.New("opus: invalid state")
+	ErrAllocFail      = errors.New("opus: memory allocation failed")
+)
+
+// OpusException is the error type returned by the package. It carries the
+// OpusError code along with whatever is known about where the error comes
+// from, and unwraps to the sentinel matching the code, so that
+//
+//	errors.Is(err, ErrInvalidPacket)
+//
+// tells a corrupt packet apart from a bad argument, while errors.As gives
+// access to the details.
 type OpusException struct {
-	_message         string
-	_opus_error_code int
+	Code    int         // one of the OpusError codes
+	Message string      // what was being done
+	Arg     string      // name of the offending argument, if any
+	Offset  int         // byte offset from the start of the packet or blob, -1 if unknown
+	Stream  int         // stream of a multistream packet or codec, -1 if none
+	Fault   PacketFault // why a packet is malformed, FaultNone if not known
 }
 
 func OpusException1(message string) *OpusException {
-	return OpusException2(message, 1)
+	return OpusException2(message, OpusError.OPUS_INTERNAL_ERROR)
 }
 
 func OpusException2(message string, opus_error_code int) *OpusException {
-	fullMessage := message + ": " + opus_strerror(opus_error_code)
 	return &OpusException{
-		_message:         fullMessage,
-		_opus_error_code: opus_error_code,
+		Code:    opus_error_code,
+		Message: message,
+		Offset:  -1,
+		Stream:  -1,
 	}
 }
 
 func (e *OpusException) Error() string {
-	return e._message
+	msg := e.Message
+	if msg == "" {
+		msg = "opus"
+	}
+	if e.Arg != "" {
+		msg += " (" + e.Arg + ")"
+	}
+	if e.Stream >= 0 {
+		msg += " in stream " + strconv.Itoa(e.Stream)
+	}
+	if e.Offset >= 0 {
+		msg += " at byte " + strconv.Itoa(e.Offset)
+	}
+	return msg + ": " + opus_strerror(e.Code)
+}
+
+func (e *OpusException) Unwrap() error {
+	switch e.Code {
+	case OpusError.OPUS_BAD_ARG:
+		return ErrBadArg
+	case OpusError.OPUS_BUFFER_TOO_SMALL:
+		return ErrBufferTooSmall
+	case OpusError.OPUS_INVALID_PACKET:
+		return ErrInvalidPacket
+	case OpusError.OPUS_UNIMPLEMENTED:
+		return ErrUnimplemented
+	case OpusError.OPUS_INVALID_STATE:
+		return ErrInvalidState
+	case OpusError.OPUS_ALLOC_FAIL:
+		return ErrAllocFail
+	}
+	return ErrInternalError
 }
 
 func (e *OpusException) getMessage() string {
-	return e._message
+	return e.Error()
+}
+
+// ErrorFromCode converts a negative OpusError code, as returned by the
+// functions of the package that report errors in-band, to an error. It
+// returns nil for codes that are not errors.
+func ErrorFromCode(code int) error {
+	if code >= 0 {
+		return nil
+	}
+	return OpusException2("", code)
+}
+
+// Sets the name of the offending argument.
+func (e *OpusException) arg(name string) *OpusException {
+	e.Arg = name
+	return e
+}
+
+// Sets the byte offset of the error.
+func (e *OpusException) at(offset int) *OpusException {
+	e.Offset = offset
+	return e
+}
+
+// Sets the stream the error comes from.
+func (e *OpusException) in_stream(stream int) *OpusException {
+	e.Stream = stream
+	return e
+}
+
+func bad_arg(name string, message string) *OpusException {
+	return OpusException2(message, OpusError.OPUS_BAD_ARG).arg(name)
 }
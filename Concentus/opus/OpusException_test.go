@@ -0,0 +1,135 @@
+package opus
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorsMatchSentinels(t *testing.T) {
+	_, err := NewOpusEncoder(44100, 1, OPUS_APPLICATION_AUDIO)
+	var oe *OpusException
+	if !errors.Is(err, ErrBadArg) || !errors.As(err, &oe) || oe.Arg != "Fs" {
+		t.Fatalf("NewOpusEncoder: got %v", err)
+	}
+
+	dec, _ := NewOpusDecoder(48000, 1)
+	/* Two CBR frames cannot share an odd number of bytes */
+	packet := []byte{0x49, 1, 2, 3}
+	_, err = dec.Decode(packet, 0, len(packet), make([]int16, 960), 0, 960, false)
+	if !errors.Is(err, ErrInvalidPacket) || errors.Is(err, ErrBadArg) || !errors.As(err, &oe) || oe.Offset != 0 {
+		t.Fatalf("Decode: got %v", err)
+	}
+	_, err = dec.Decode(packet, 0, len(packet), make([]int16, 960), 0, 0, false)
+	if !errors.Is(err, ErrBadArg) {
+		t.Fatalf("Decode with no room: got %v", err)
+	}
+	/* Padding longer than the packet, past a prefix of three bytes */
+	padded := []byte{0, 0, 0, 0x4B, 0x41, 0x10, 1}
+	_, err = dec.Decode(padded, 3, 4, make([]int16, 960), 0, 960, false)
+	if !errors.As(err, &oe) || oe.Offset != 2 || oe.Fault != FaultPadding {
+		t.Fatalf("Decode with long padding: got %v", err)
+	}
+
+	if _, err = ParseOpusPacket(padded, 3, 4); !errors.As(err, &oe) || oe.Offset != 2 || oe.Fault != FaultPadding {
+		t.Fatalf("ParseOpusPacket: got %v", err)
+	}
+
+	enc, _ := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	pcm := make([]int16, 960)
+	out := make([]byte, 1275)
+	if _, err = enc.Encode(pcm, 0, 900, out, 0, len(out)); !errors.As(err, &oe) || oe.Arg != "frame_size" {
+		t.Fatalf("Encode of 900 samples: got %v", err)
+	}
+	if _, err = enc.Encode(pcm, 0, 960, out, 0, 0); !errors.As(err, &oe) || oe.Arg != "max_data_bytes" {
+		t.Fatalf("Encode into no bytes: got %v", err)
+	}
+	if err = ErrorFromCode(OpusError.OPUS_BUFFER_TOO_SMALL); !errors.Is(err, ErrBufferTooSmall) {
+		t.Fatalf("ErrorFromCode: got %v", err)
+	}
+	if ErrorFromCode(12) != nil {
+		t.Fatal("ErrorFromCode reported a length as an error")
+	}
+}
+
+func TestRepacketizerErrors(t *testing.T) {
+	packets := testSilkPackets(t, testSpeechSignal(1, 960*2, 48000), 1, 24000)
+	rp := NewOpusRepacketizer()
+	if err := rp.AddPacket(packets[0], 0, len(packets[0])); err != nil {
+		t.Fatal(err)
+	}
+	other := append([]byte(nil), packets[1]...)
+	other[0] ^= 0x80 /* CELT configuration */
+	if err := rp.AddPacket(other, 0, len(other)); !errors.Is(err, ErrInvalidPacket) {
+		t.Fatalf("AddPacket with another configuration: got %v", err)
+	}
+	if err := rp.AddPacket(packets[1], 0, len(packets[1])); err != nil {
+		t.Fatal(err)
+	}
+	if rp.GetNumFrames() != 2 {
+		t.Fatalf("%d frames queued", rp.GetNumFrames())
+	}
+	out := make([]byte, 1275)
+	if _, err := rp.CreatePacket(out, 0, 4); !errors.Is(err, ErrBufferTooSmall) {
+		t.Fatalf("CreatePacket into a short buffer: got %v", err)
+	}
+	if _, err := rp.CreatePacketRange(1, 3, out, 0, len(out)); !errors.Is(err, ErrBadArg) {
+		t.Fatalf("CreatePacketRange out of range: got %v", err)
+	}
+	n, err := rp.CreatePacket(out, 0, len(out))
+	if err != nil || GetNumFrames(out, 0, n) != 2 {
+		t.Fatalf("CreatePacket: %d bytes, %v", n, err)
+	}
+
+	if err := PadPacket(out, 0, n, n+10); err != nil {
+		t.Fatal(err)
+	}
+	if m, err := UnpadPacket(out, 0, n+10); err != nil || m != n {
+		t.Fatalf("UnpadPacket: %d bytes, %v", m, err)
+	}
+	if err := PadPacket(out, 0, n, n-1); !errors.Is(err, ErrBadArg) {
+		t.Fatalf("PadPacket shrinking: got %v", err)
+	}
+}
+
+func TestMultistreamErrorsCarryStream(t *testing.T) {
+	const channels = 4
+	enc, dec := newTestMSCodec(t, channels, 1, 0)
+	pcm := testMultichannelSignal(channels, 960)
+	buf := make([]byte, 4000)
+	n, err := enc.EncodeMultistream(pcm, 0, 960, buf, 0, len(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/* Make the last stream two CBR frames of an odd total size */
+	toc := BoxedValueByte{0}
+	size := make([]int16, 48)
+	dummy := BoxedValueInt{0}
+	first := BoxedValueInt{0}
+	if opus_packet_parse_impl(buf, 0, n, 1, &toc, nil, 0, size, 0, &dummy, &first) < 0 {
+		t.Fatal("first stream does not parse")
+	}
+	buf[first.Val] = buf[first.Val]&0xFC | 1
+	if (n-first.Val-1)%2 == 0 {
+		n--
+	}
+	_, err = dec.DecodeMultistream(buf, 0, n, make([]int16, 960*channels), 0, 960, false)
+	var oe *OpusException
+	if !errors.Is(err, ErrInvalidPacket) || !errors.As(err, &oe) || oe.Stream != 1 || oe.Offset != first.Val {
+		t.Fatalf("got %v, want an invalid packet in stream 1 at byte %d", err, first.Val)
+	}
+
+	/* Too short for the streams, past a prefix of five bytes */
+	short := []byte{0, 0, 0, 0, 0, 0x48}
+	_, err = dec.DecodeMultistream(short, 5, 1, make([]int16, 960*channels), 0, 960, false)
+	if !errors.As(err, &oe) || oe.Offset != 0 || oe.Stream != -1 {
+		t.Fatalf("short packet: got %v", err)
+	}
+
+	if _, err = enc.GetMultistreamEncoderState(2); !errors.Is(err, ErrBadArg) {
+		t.Fatalf("GetMultistreamEncoderState: got %v", err)
+	}
+	if _, err = enc.EncodeMultistream(pcm, 0, 960, buf, 0, 2); !errors.Is(err, ErrBufferTooSmall) {
+		t.Fatalf("EncodeMultistream: got %v", err)
+	}
+}
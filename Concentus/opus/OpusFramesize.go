@@ -54,7 +54,9 @@ const (
 	OPUS_FRAMESIZE_40_MS
 	// Use 60 ms frames
 	OPUS_FRAMESIZE_60_MS
-	// Do not use - not fully implemented. Optimize the frame size dynamically.
+	// Optimize the frame size dynamically. Encode codes a frame of up to
+	// frame_size samples from the start of its input; OpusVariableEncoder
+	// buffers the input and returns packets of varying duration.
 	OPUS_FRAMESIZE_VARIABLE
 )
 
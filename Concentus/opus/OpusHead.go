@@ -0,0 +1,156 @@
+package opus
+
+import (
+	"encoding/binary"
+)
+
+/* The identification header of RFC 7845. Containers other than Ogg carry the
+   same fields, in the same or a closely related layout. */
+
+// OpusHead describes how to set up a decoder for a stream of Opus packets.
+type OpusHead struct {
+	Version         int
+	Channels        int
+	PreSkip         int // samples at 48 kHz to discard from the start of the decoded output
+	InputSampleRate int // informational only
+	OutputGain      int // Q8 dB, applied by the decoder
+	MappingFamily   int
+	StreamCount     int
+	CoupledCount    int
+	ChannelMapping  []byte
+}
+
+// GetOpusHead returns the identification header of the stream produced by
+// the encoder.
+func (st *OpusEncoder) GetOpusHead() *OpusHead {
+	head := &OpusHead{
+		Version:         1,
+		Channels:        st.channels,
+		PreSkip:         st.GetLookahead() * (48000 / st.Fs),
+		InputSampleRate: st.Fs,
+		StreamCount:     1,
+		CoupledCount:    st.channels - 1,
+	}
+	head.ChannelMapping = []byte{0, 1}[:st.channels]
+	return head
+}
+
+// GetOpusHead returns the identification header of the stream produced by
+// the encoder. Encoders built with CreateSurroundOpusMSEncoder keep their
+// mapping family; others use family 255.
+func (st *OpusMSEncoder) GetOpusHead() *OpusHead {
+	head := &OpusHead{
+		Version:         1,
+		Channels:        st.layout.nb_channels,
+		PreSkip:         st.GetLookahead() * (48000 / st.GetSampleRate()),
+		InputSampleRate: st.GetSampleRate(),
+		MappingFamily:   st.mapping_family,
+		StreamCount:     st.layout.nb_streams,
+		CoupledCount:    st.layout.nb_coupled_streams,
+		ChannelMapping:  make([]byte, st.layout.nb_channels),
+	}
+	for c := range head.ChannelMapping {
+		head.ChannelMapping[c] = byte(st.layout.mapping[c])
+	}
+	return head
+}
+
+// Marshal returns the header in the Ogg layout, starting with "OpusHead".
+func (h *OpusHead) Marshal() []byte {
+	out := make([]byte, 19, 21+len(h.ChannelMapping))
+	copy(out, "OpusHead")
+	out[8] = byte(h.Version)
+	out[9] = byte(h.Channels)
+	binary.LittleEndian.PutUint16(out[10:], uint16(h.PreSkip))
+	binary.LittleEndian.PutUint32(out[12:], uint32(h.InputSampleRate))
+	binary.LittleEndian.PutUint16(out[16:], uint16(int16(h.OutputGain)))
+	out[18] = byte(h.MappingFamily)
+	if h.MappingFamily != 0 {
+		out = append(out, byte(h.StreamCount), byte(h.CoupledCount))
+		out = append(out, h.ChannelMapping[:h.Channels]...)
+	}
+	return out
+}
+
+// ParseOpusHead reads a header in the Ogg layout.
+func ParseOpusHead(data []byte) (*OpusHead, error) {
+	if len(data) < 19 || string(data[:8]) != "OpusHead" {
+		return nil, OpusException2("Not an OpusHead", OpusError.OPUS_INVALID_PACKET).at(0)
+	}
+	/* Only the major version is meaningful to a decoder */
+	if data[8]>>4 != 0 {
+		return nil, OpusException2("Unsupported OpusHead version", OpusError.OPUS_UNIMPLEMENTED).at(8)
+	}
+	h := &OpusHead{
+		Version:         int(data[8]),
+		Channels:        int(data[9]),
+		PreSkip:         int(binary.LittleEndian.Uint16(data[10:])),
+		InputSampleRate: int(binary.LittleEndian.Uint32(data[12:])),
+		OutputGain:      int(int16(binary.LittleEndian.Uint16(data[16:]))),
+		MappingFamily:   int(data[18]),
+	}
+	if h.MappingFamily == 0 {
+		h.StreamCount = 1
+		h.CoupledCount = h.Channels - 1
+		h.ChannelMapping = []byte{0, 1}[:IMIN(h.Channels, 2)]
+	} else {
+		if len(data) < 21+h.Channels {
+			return nil, OpusException2("OpusHead is truncated", OpusError.OPUS_INVALID_PACKET).at(len(data))
+		}
+		h.StreamCount = int(data[19])
+		h.CoupledCount = int(data[20])
+		h.ChannelMapping = append([]byte(nil), data[21:21+h.Channels]...)
+	}
+	if err := h.validate(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *OpusHead) validate() error {
+	if h.Channels < 1 || (h.MappingFamily == 0 && h.Channels > 2) {
+		return OpusException2("Invalid channel count", OpusError.OPUS_INVALID_PACKET).at(9)
+	}
+	if h.StreamCount < 1 || h.CoupledCount > h.StreamCount || h.StreamCount+h.CoupledCount > 255 {
+		return OpusException2("Invalid stream count", OpusError.OPUS_INVALID_PACKET).at(19)
+	}
+	for c, m := range h.ChannelMapping {
+		if m != 255 && int(m) >= h.StreamCount+h.CoupledCount {
+			return OpusException2("Invalid channel mapping", OpusError.OPUS_INVALID_PACKET).at(21 + c)
+		}
+	}
+	return nil
+}
+
+// CreateDecoder returns a decoder for a mono or stereo stream, with the
+// output gain applied. Multichannel streams need CreateMSDecoder.
+func (h *OpusHead) CreateDecoder(Fs int) (*OpusDecoder, error) {
+	if h.StreamCount != 1 || h.Channels > 2 {
+		return nil, bad_arg("h", "Multichannel streams need a multistream decoder")
+	}
+	dec, err := NewOpusDecoder(Fs, h.Channels)
+	if err != nil {
+		return nil, err
+	}
+	if err = dec.SetGain(h.OutputGain); err != nil {
+		return nil, err
+	}
+	return dec, nil
+}
+
+// CreateMSDecoder returns a multistream decoder for any mapping family, with
+// the output gain applied.
+func (h *OpusHead) CreateMSDecoder(Fs int) (*OpusMSDecoder, error) {
+	mapping := make([]int16, h.Channels)
+	for c := range mapping {
+		mapping[c] = int16(h.ChannelMapping[c])
+	}
+	dec, err := OpusMSDecoder_create(Fs, h.Channels, h.StreamCount, h.CoupledCount, mapping)
+	if err != nil {
+		return nil, err
+	}
+	if err = dec.SetGain(h.OutputGain); err != nil {
+		return nil, err
+	}
+	return dec, nil
+}
@@ -1,16 +1,25 @@
 package opus
 
-import "errors"
+import (
+	"sync"
+)
 
 type OpusMSDecoder struct {
-	layout   ChannelLayout
-	decoders []*OpusDecoder
+	layout      ChannelLayout
+	decoders    []*OpusDecoder
+	parallelism int
+	tracer      Tracer
+
+	/* Where the last decode failed, for error reporting */
+	error_stream int
+	error_offset int
 }
 
 func newOpusMSDecoder(nb_streams int, nb_coupled_streams int) *OpusMSDecoder {
 	decoders := make([]*OpusDecoder, nb_streams)
 	for c := 0; c < nb_streams; c++ {
 		decoders[c] = new(OpusDecoder)
+		decoders[c].SilkDecoder = NewSilkDecoder()
 	}
 	return &OpusMSDecoder{
 		layout:   ChannelLayout{},
@@ -54,20 +63,17 @@ func (this *OpusMSDecoder) opus_multistream_decoder_init(Fs int, channels int, s
 
 func OpusMSDecoder_create(Fs int, channels int, streams int, coupled_streams int, mapping []int16) (*OpusMSDecoder, error) {
 	if channels > 255 || channels < 1 || coupled_streams > streams || streams < 1 || coupled_streams < 0 || streams > 255-coupled_streams {
-		return nil, errors.New("Invalid channel / stream configuration")
+		return nil, bad_arg("streams", "Invalid channel / stream configuration")
 	}
 	st := newOpusMSDecoder(streams, coupled_streams)
 	ret := st.opus_multistream_decoder_init(Fs, channels, streams, coupled_streams, mapping)
 	if ret != OpusError.OPUS_OK {
-		if ret == OpusError.OPUS_BAD_ARG {
-			return nil, errors.New("Bad argument while creating MS decoder")
-		}
-		return nil, errors.New("Could not create MS decoder")
+		return nil, OpusException2("Could not create MS decoder", ret)
 	}
 	return st, nil
 }
 
-func opus_multistream_packet_validate(data []byte, data_ptr int, len int, nb_streams int, Fs int) int {
+func opus_multistream_packet_validate(data []byte, data_ptr int, len int, nb_streams int, Fs int, error_stream *BoxedValueInt, error_ptr *BoxedValueInt) int {
 	toc := BoxedValueByte{Val: 0}
 	size := make([]int16, 48)
 	samples := 0
@@ -75,6 +81,8 @@ func opus_multistream_packet_validate(data []byte, data_ptr int, len int, nb_str
 	dummy := BoxedValueInt{Val: 0}
 
 	for s := 0; s < nb_streams; s++ {
+		error_stream.Val = s
+		error_ptr.Val = data_ptr
 		if len <= 0 {
 			return OpusError.OPUS_INVALID_PACKET
 		}
@@ -109,64 +117,49 @@ func (this *OpusMSDecoder) opus_multistream_decode_native(data []byte, data_ptr
 	if len < 0 {
 		return OpusError.OPUS_BAD_ARG
 	}
+	this.error_stream = -1
+	this.error_offset = -1
 	if do_plc == 0 && len < 2*this.layout.nb_streams-1 {
+		this.error_offset = data_ptr
 		return OpusError.OPUS_INVALID_PACKET
 	}
 	if do_plc == 0 {
-		ret := opus_multistream_packet_validate(data, data_ptr, len, this.layout.nb_streams, Fs)
+		error_stream := BoxedValueInt{Val: 0}
+		error_ptr := BoxedValueInt{Val: 0}
+		ret := opus_multistream_packet_validate(data, data_ptr, len, this.layout.nb_streams, Fs, &error_stream, &error_ptr)
 		if ret < 0 {
+			this.set_error(error_ptr.Val, error_stream.Val)
 			return ret
 		} else if ret > frame_size {
 			return OpusError.OPUS_BUFFER_TOO_SMALL
 		}
 	}
 
-	for s := 0; s < this.layout.nb_streams; s++ {
-		dec := this.decoders[decoder_ptr]
-		decoder_ptr++
-
-		if do_plc == 0 && len <= 0 {
-			return OpusError.OPUS_INTERNAL_ERROR
-		}
-
-		packet_offset := BoxedValueInt{Val: 0}
-		ret := dec.opus_decode_native(data, data_ptr, len, buf, 0, frame_size, decode_fec, boolToInt(s != this.layout.nb_streams-1), &packet_offset, soft_clip)
-		data_ptr += packet_offset.Val
-		len -= packet_offset.Val
+	if this.parallelism > 1 && this.layout.nb_streams > 1 {
+		ret := this.opus_multistream_decode_parallel(data, data_ptr, len, pcm, pcm_ptr, frame_size, decode_fec, do_plc, soft_clip)
 		if ret <= 0 {
 			return ret
 		}
 		frame_size = ret
+	} else {
+		for s := 0; s < this.layout.nb_streams; s++ {
+			dec := this.decoders[decoder_ptr]
+			decoder_ptr++
 
-		if s < this.layout.nb_coupled_streams {
-			prev := -1
-			for {
-				_chan := get_left_channel(this.layout, s, prev)
-				if _chan == -1 {
-					break
-				}
-				opus_copy_channel_out_short(pcm, pcm_ptr, this.layout.nb_channels, _chan, buf, 0, 2, frame_size)
-				prev = _chan
+			if do_plc == 0 && len <= 0 {
+				return OpusError.OPUS_INTERNAL_ERROR
 			}
-			prev = -1
-			for {
-				_chan := get_right_channel(this.layout, s, prev)
-				if _chan == -1 {
-					break
-				}
-				opus_copy_channel_out_short(pcm, pcm_ptr, this.layout.nb_channels, _chan, buf, 1, 2, frame_size)
-				prev = _chan
-			}
-		} else {
-			prev := -1
-			for {
-				_chan := get_mono_channel(this.layout, s, prev)
-				if _chan == -1 {
-					break
-				}
-				opus_copy_channel_out_short(pcm, pcm_ptr, this.layout.nb_channels, _chan, buf, 0, 1, frame_size)
-				prev = _chan
+
+			packet_offset := BoxedValueInt{Val: 0}
+			ret := dec.opus_decode_native(data, data_ptr, len, buf, 0, frame_size, decode_fec, boolToInt(s != this.layout.nb_streams-1), &packet_offset, soft_clip)
+			if ret <= 0 {
+				this.set_error(data_ptr, s)
+				return ret
 			}
+			data_ptr += packet_offset.Val
+			len -= packet_offset.Val
+			frame_size = ret
+			this.copy_stream_out(s, pcm, pcm_ptr, buf, frame_size)
 		}
 	}
 
@@ -178,6 +171,100 @@ func (this *OpusMSDecoder) opus_multistream_decode_native(data []byte, data_ptr
 	return frame_size
 }
 
+func (this *OpusMSDecoder) copy_stream_out(s int, pcm []int16, pcm_ptr int, buf []int16, frame_size int) {
+	if s < this.layout.nb_coupled_streams {
+		prev := -1
+		for {
+			_chan := get_left_channel(this.layout, s, prev)
+			if _chan == -1 {
+				break
+			}
+			opus_copy_channel_out_short(pcm, pcm_ptr, this.layout.nb_channels, _chan, buf, 0, 2, frame_size)
+			prev = _chan
+		}
+		prev = -1
+		for {
+			_chan := get_right_channel(this.layout, s, prev)
+			if _chan == -1 {
+				break
+			}
+			opus_copy_channel_out_short(pcm, pcm_ptr, this.layout.nb_channels, _chan, buf, 1, 2, frame_size)
+			prev = _chan
+		}
+	} else {
+		prev := -1
+		for {
+			_chan := get_mono_channel(this.layout, s, prev)
+			if _chan == -1 {
+				break
+			}
+			opus_copy_channel_out_short(pcm, pcm_ptr, this.layout.nb_channels, _chan, buf, 0, 1, frame_size)
+			prev = _chan
+		}
+	}
+}
+
+// Every stream of a packet decodes to the same number of samples, so the
+// per-stream frame sizes and packet offsets are known before decoding starts
+// and the streams can be handed out independently.
+func (this *OpusMSDecoder) opus_multistream_decode_parallel(data []byte, data_ptr int, len int, pcm []int16, pcm_ptr int, frame_size int, decode_fec int, do_plc int, soft_clip int) int {
+	nb_streams := this.layout.nb_streams
+	offsets := make([]int, nb_streams)
+	lens := make([]int, nb_streams)
+	sizes := make([]int, nb_streams)
+	for s := 0; s < nb_streams; s++ {
+		offsets[s] = data_ptr
+		lens[s] = len
+		sizes[s] = frame_size
+		if do_plc != 0 {
+			continue
+		}
+		if len <= 0 {
+			return OpusError.OPUS_INTERNAL_ERROR
+		}
+		toc := BoxedValueByte{Val: 0}
+		size := make([]int16, 48)
+		dummy := BoxedValueInt{Val: 0}
+		packet_offset := BoxedValueInt{Val: 0}
+		count := opus_packet_parse_impl(data, data_ptr, len, boolToInt(s != nb_streams-1), &toc, nil, 0, size, 0, &dummy, &packet_offset)
+		if count < 0 {
+			return count
+		}
+		if s != 0 && decode_fec == 0 {
+			sizes[s] = GetNumSamples(data, data_ptr, packet_offset.Val, this.getSampleRate())
+		}
+		data_ptr += packet_offset.Val
+		len -= packet_offset.Val
+	}
+
+	bufs := make([][]int16, nb_streams)
+	rets := make([]int, nb_streams)
+	sem := make(chan struct{}, this.parallelism)
+	var wg sync.WaitGroup
+	for s := 0; s < nb_streams; s++ {
+		bufs[s] = make([]int16, 2*frame_size)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(s int) {
+			defer wg.Done()
+			packet_offset := BoxedValueInt{Val: 0}
+			rets[s] = this.decoders[s].opus_decode_native(data, offsets[s], lens[s], bufs[s], 0, sizes[s], decode_fec, boolToInt(s != nb_streams-1), &packet_offset, soft_clip)
+			<-sem
+		}(s)
+	}
+	wg.Wait()
+
+	for s := 0; s < nb_streams; s++ {
+		if rets[s] <= 0 {
+			this.set_error(offsets[s], s)
+			return rets[s]
+		}
+		frame_size = rets[s]
+		this.copy_stream_out(s, pcm, pcm_ptr, bufs[s], frame_size)
+	}
+	return frame_size
+}
+
 func opus_copy_channel_out_short(dst []int16, dst_ptr int, dst_stride int, dst_channel int, src []int16, src_ptr int, src_stride int, frame_size int) {
 	if src != nil {
 		for i := 0; i < frame_size; i++ {
@@ -190,8 +277,40 @@ func opus_copy_channel_out_short(dst []int16, dst_ptr int, dst_stride int, dst_c
 	}
 }
 
-func (this *OpusMSDecoder) decodeMultistream(data []byte, data_offset int, len int, out_pcm []int16, out_pcm_offset int, frame_size int, decode_fec int) int {
-	return this.opus_multistream_decode_native(data, data_offset, len, out_pcm, out_pcm_offset, frame_size, decode_fec, 0)
+// Records the stream that failed to decode and where its packet starts,
+// relative to the start of the multistream packet.
+func (this *OpusMSDecoder) set_error(data_ptr int, stream int) {
+	this.error_stream = stream
+	this.error_offset = data_ptr
+}
+
+// DecodeMultistream decodes a multistream packet into frame_size samples per
+// channel of interleaved output and returns the number of samples decoded.
+// Pass a nil packet to conceal a lost one. Errors coming from one stream of
+// the packet are reported with the index of that stream and the offset of its
+// data within the packet.
+func (this *OpusMSDecoder) DecodeMultistream(data []byte, data_offset int, _len int, out_pcm []int16, out_pcm_offset int, frame_size int, decode_fec bool) (int, error) {
+	if frame_size <= 0 {
+		return 0, bad_arg("frame_size", "Frame size must be > 0")
+	}
+	if out_pcm_offset+frame_size*this.layout.nb_channels > len(out_pcm) {
+		return 0, OpusException2("Output buffer is too small", OpusError.OPUS_BUFFER_TOO_SMALL).arg("out_pcm")
+	}
+	if data == nil {
+		_len = 0
+	}
+	ret := this.opus_multistream_decode_native(data, data_offset, _len, out_pcm, out_pcm_offset, frame_size, boolToInt(decode_fec), 0)
+	if ret < 0 {
+		err := decode_error(ret, nil, 0, 0)
+		if this.error_stream >= 0 {
+			err.in_stream(this.error_stream)
+		}
+		if ret == OpusError.OPUS_INVALID_PACKET && this.error_offset >= 0 {
+			err.at(this.error_offset - data_offset)
+		}
+		return 0, err
+	}
+	return ret, nil
 }
 
 func (this *OpusMSDecoder) getBandwidth() int {
@@ -208,17 +327,30 @@ func (this *OpusMSDecoder) getSampleRate() int {
 	return this.decoders[0].GetSampleRate()
 }
 
-func (this *OpusMSDecoder) getGain() int {
+// GetInDTX reports whether every stream is in a DTX pause.
+func (this *OpusMSDecoder) GetInDTX() bool {
+	for _, dec := range this.decoders {
+		if !dec.GetInDTX() {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *OpusMSDecoder) GetGain() int {
 	if this.decoders == nil || len(this.decoders) == 0 {
 		panic("Decoder not initialized")
 	}
 	return this.decoders[0].GetGain()
 }
 
-func (this *OpusMSDecoder) setGain(value int) {
+func (this *OpusMSDecoder) SetGain(value int) error {
 	for s := 0; s < this.layout.nb_streams; s++ {
-		this.decoders[s].SetGain(value)
+		if err := this.decoders[s].SetGain(value); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (this *OpusMSDecoder) getLastPacketDuration() int {
@@ -242,6 +374,66 @@ func (this *OpusMSDecoder) ResetState() {
 	}
 }
 
+func (this *OpusMSDecoder) GetPhaseInversionDisabled() bool {
+	return this.decoders[0].GetPhaseInversionDisabled()
+}
+
+func (this *OpusMSDecoder) SetPhaseInversionDisabled(value bool) {
+	for s := 0; s < this.layout.nb_streams; s++ {
+		this.decoders[s].SetPhaseInversionDisabled(value)
+	}
+}
+
+// SetComplexity sets the decoding complexity of every stream. See
+// OpusDecoder.SetComplexity.
+func (this *OpusMSDecoder) SetComplexity(value int) error {
+	for s := 0; s < this.layout.nb_streams; s++ {
+		if err := this.decoders[s].SetComplexity(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (this *OpusMSDecoder) GetComplexity() int {
+	return this.decoders[0].GetComplexity()
+}
+
+func (this *OpusMSDecoder) GetMonoDownmix() bool {
+	return this.decoders[0].GetMonoDownmix()
+}
+
+// SetMonoDownmix replaces the two channels of each coupled stream with their
+// mono downmix. See OpusDecoder.SetMonoDownmix.
+func (this *OpusMSDecoder) SetMonoDownmix(value bool) {
+	for s := 0; s < this.layout.nb_streams; s++ {
+		this.decoders[s].SetMonoDownmix(value)
+	}
+}
+
+// SetParallelism lets up to value streams be decoded concurrently. Values of 1
+// or less keep the default sequential behaviour; the output is the same either way.
+func (this *OpusMSDecoder) SetParallelism(value int) {
+	this.parallelism = value
+}
+
+func (this *OpusMSDecoder) GetParallelism() int {
+	return IMAX(1, this.parallelism)
+}
+
+// SetTracer sets the sink for the trace events of all streams, which are
+// tagged with their stream index.
+func (this *OpusMSDecoder) SetTracer(value Tracer) {
+	this.tracer = value
+	for s := 0; s < this.layout.nb_streams; s++ {
+		this.decoders[s].SetTracer(stream_tracer(value, s))
+	}
+}
+
+func (this *OpusMSDecoder) GetTracer() Tracer {
+	return this.tracer
+}
+
 func (this *OpusMSDecoder) GetMultistreamDecoderState(streamId int) *OpusDecoder {
 	return this.decoders[streamId]
 }
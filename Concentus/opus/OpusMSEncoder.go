@@ -1,8 +1,6 @@
 package opus
 
-import (
-	"errors"
-)
+import "time"
 
 type OpusMSEncoder struct {
 	layout            ChannelLayout
@@ -15,11 +13,17 @@ type OpusMSEncoder struct {
 	encoders          []*OpusEncoder
 	window_mem        []int
 	preemph_mem       []int
+	parallelism       int
+	tracer            Tracer
+	realtime          realtime_controller
+	preprocessor      *preprocess_stage
+	error_stream      int // stream the last encode failed in
+	mapping_family    int
 }
 
 func NewOpusMSEncoder(nb_streams, nb_coupled_streams int) (*OpusMSEncoder, error) {
 	if nb_streams < 1 || nb_coupled_streams > nb_streams || nb_coupled_streams < 0 {
-		return nil, errors.New("Invalid channel count in MS encoder")
+		return nil, bad_arg("nb_streams", "Invalid channel count in MS encoder")
 	}
 
 	st := &OpusMSEncoder{
@@ -27,6 +31,9 @@ func NewOpusMSEncoder(nb_streams, nb_coupled_streams int) (*OpusMSEncoder, error
 	}
 	for c := 0; c < nb_streams; c++ {
 		st.encoders[c] = &OpusEncoder{}
+		st.encoders[c].SilkEncoder = NewSilkEncoder()
+		st.encoders[c].Celt_Encoder = CeltEncoder{}
+		st.encoders[c].analysis = NewTonalityAnalysisState()
 	}
 
 	nb_channels := nb_coupled_streams*2 + (nb_streams - nb_coupled_streams)
@@ -37,6 +44,9 @@ func NewOpusMSEncoder(nb_streams, nb_coupled_streams int) (*OpusMSEncoder, error
 
 func (st *OpusMSEncoder) ResetState() {
 	st.subframe_mem[0], st.subframe_mem[1], st.subframe_mem[2] = 0, 0, 0
+	if st.preprocessor != nil {
+		st.preprocessor.reset()
+	}
 	if st.surround != 0 {
 		for i := range st.preemph_mem {
 			st.preemph_mem[i] = 0
@@ -260,6 +270,7 @@ func (st *OpusMSEncoder) opus_multistream_encoder_init(Fs, channels, streams, co
 		}
 	}
 	st.surround = surround
+	st.mapping_family = 255
 	return OpusError.OPUS_OK
 }
 
@@ -301,12 +312,16 @@ func (st *OpusMSEncoder) opus_multistream_surround_encoder_init(Fs, channels, ma
 	} else {
 		return OpusError.OPUS_UNIMPLEMENTED
 	}
-	return st.opus_multistream_encoder_init(Fs, channels, streams.Val, coupled_streams.Val, mapping, application, Ternary(channels > 2 && mapping_family == 1, 1, 0))
+	ret := st.opus_multistream_encoder_init(Fs, channels, streams.Val, coupled_streams.Val, mapping, application, Ternary(channels > 2 && mapping_family == 1, 1, 0))
+	if ret == OpusError.OPUS_OK {
+		st.mapping_family = mapping_family
+	}
+	return ret
 }
 
 func CreateOpusMSEncoder(Fs, channels, streams, coupled_streams int, mapping []int16, application OpusApplication) (*OpusMSEncoder, error) {
 	if channels > 255 || channels < 1 || coupled_streams > streams || streams < 1 || coupled_streams < 0 || streams > 255-coupled_streams {
-		return nil, errors.New("Invalid channel / stream configuration")
+		return nil, bad_arg("streams", "Invalid channel / stream configuration")
 	}
 	st, err := NewOpusMSEncoder(streams, coupled_streams)
 	if err != nil {
@@ -314,10 +329,7 @@ func CreateOpusMSEncoder(Fs, channels, streams, coupled_streams int, mapping []i
 	}
 	ret := st.opus_multistream_encoder_init(Fs, channels, streams, coupled_streams, mapping, application, 0)
 	if ret != OpusError.OPUS_OK {
-		if ret == OpusError.OPUS_BAD_ARG {
-			return nil, errors.New("OPUS_BAD_ARG when creating MS encoder")
-		}
-		return nil, errors.New("Error while initializing MS encoder")
+		return nil, OpusException2("Error while initializing MS encoder", ret)
 	}
 	return st, nil
 }
@@ -331,7 +343,7 @@ func GetStreamCount(channels, mapping_family int, nb_streams, nb_coupled_streams
 			nb_streams.Val = 1
 			nb_coupled_streams.Val = 1
 		} else {
-			return errors.New("More than 2 channels requires custom mappings")
+			return bad_arg("channels", "More than 2 channels requires custom mappings")
 		}
 	} else if mapping_family == 1 && channels >= 1 && channels <= 8 {
 		nb_streams.Val = vorbis_mappings[channels-1].nb_streams
@@ -340,14 +352,14 @@ func GetStreamCount(channels, mapping_family int, nb_streams, nb_coupled_streams
 		nb_streams.Val = channels
 		nb_coupled_streams.Val = 0
 	} else {
-		return errors.New("Invalid mapping family")
+		return OpusException2("Invalid mapping family", OpusError.OPUS_UNIMPLEMENTED).arg("mapping_family")
 	}
 	return nil
 }
 
 func CreateSurroundOpusMSEncoder(Fs, channels, mapping_family int, streams, coupled_streams *BoxedValueInt, mapping []int16, application OpusApplication) (*OpusMSEncoder, error) {
 	if channels > 255 || channels < 1 || application == OPUS_APPLICATION_UNIMPLEMENTED {
-		return nil, errors.New("Invalid channel count or application")
+		return nil, bad_arg("channels", "Invalid channel count or application")
 	}
 	nb_streams := BoxedValueInt{0}
 	nb_coupled_streams := BoxedValueInt{0}
@@ -362,10 +374,7 @@ func CreateSurroundOpusMSEncoder(Fs, channels, mapping_family int, streams, coup
 	}
 	ret := st.opus_multistream_surround_encoder_init(Fs, channels, mapping_family, streams, coupled_streams, mapping, application)
 	if ret != OpusError.OPUS_OK {
-		if ret == OpusError.OPUS_BAD_ARG {
-			return nil, errors.New("Bad argument passed to CreateSurround")
-		}
-		return nil, errors.New("Error while initializing encoder")
+		return nil, OpusException2("Error while initializing MS encoder", ret)
 	}
 	return st, nil
 }
@@ -419,7 +428,6 @@ func (st *OpusMSEncoder) opus_multistream_encode_native(pcm []int16, pcm_ptr, an
 	var Fs, tot_size, frame_size, rate_sum, smallest_packet int
 	var vbr int
 	var celt_mode *CeltMode
-	var bandLogE []int
 	var mem, preemph_mem []int
 
 	if st.surround != 0 {
@@ -427,6 +435,7 @@ func (st *OpusMSEncoder) opus_multistream_encode_native(pcm []int16, pcm_ptr, an
 		mem = st.window_mem
 	}
 
+	st.error_stream = -1
 	encoder_ptr := 0
 	Fs = st.encoders[encoder_ptr].GetSampleRate()
 	vbr = Ternary(st.encoders[encoder_ptr].GetUseVBR(), 1, 0)
@@ -490,47 +499,104 @@ func (st *OpusMSEncoder) opus_multistream_encode_native(pcm []int16, pcm_ptr, an
 		}
 	}
 
-	encoder_ptr = 0
+	if st.parallelism > 1 && st.layout.nb_streams > 1 {
+		if caps := st.stream_shares(max_data_bytes, bitrates, rate_sum); caps != nil {
+			return st.opus_multistream_encode_parallel(pcm, pcm_ptr, analysis_frame_size, frame_size, bandSMR, caps, data, data_ptr, max_data_bytes, lsb_depth, float_api)
+		}
+	}
+
 	tot_size = 0
 	rp := NewOpusRepacketizer()
 	tmp_data := make([]byte, MS_FRAME_TMP)
 	for s := 0; s < st.layout.nb_streams; s++ {
-		enc := st.encoders[encoder_ptr]
-		var len, curr_max int
-		var c1, c2 int
-
-		rp.Reset()
-		if s < st.layout.nb_coupled_streams {
-			left := get_left_channel(st.layout, s, -1)
-			right := get_right_channel(st.layout, s, -1)
-			opus_copy_channel_in_short(buf, 0, 2, pcm, pcm_ptr, st.layout.nb_channels, left, frame_size)
-			opus_copy_channel_in_short(buf, 1, 2, pcm, pcm_ptr, st.layout.nb_channels, right, frame_size)
-			encoder_ptr++
-			if st.surround != 0 {
-				bandLogE = make([]int, 42)
-				for i := 0; i < 21; i++ {
-					bandLogE[i] = bandSMR[21*left+i]
-					bandLogE[21+i] = bandSMR[21*right+i]
-				}
-				enc.SetEnergyMask(bandLogE)
-			}
-			c1, c2 = left, right
+		curr_max := st.stream_max_bytes(s, max_data_bytes-tot_size)
+		len := st.encode_stream(s, buf, tmp_data, curr_max, pcm, pcm_ptr, analysis_frame_size, frame_size, bandSMR, lsb_depth, float_api)
+		if len < 0 {
+			st.error_stream = s
+			return len
+		}
+		len = st.write_stream(rp, s, tmp_data, len, data, data_ptr, max_data_bytes-tot_size)
+		data_ptr += len
+		tot_size += len
+	}
+
+	return tot_size
+}
+
+func (st *OpusMSEncoder) stream_max_bytes(s, avail int) int {
+	curr_max := avail
+	curr_max -= IMAX(0, 2*(st.layout.nb_streams-s-1)-1)
+	curr_max = IMIN(curr_max, MS_FRAME_TMP)
+	if s != st.layout.nb_streams-1 {
+		if curr_max > 253 {
+			curr_max -= 2
 		} else {
-			_chan := get_mono_channel(st.layout, s, -1)
-			opus_copy_channel_in_short(buf, 0, 1, pcm, pcm_ptr, st.layout.nb_channels, _chan, frame_size)
-			encoder_ptr++
-			if st.surround != 0 {
-				bandLogE = make([]int, 21)
-				for i := 0; i < 21; i++ {
-					bandLogE[i] = bandSMR[21*_chan+i]
-				}
-				enc.SetEnergyMask(bandLogE)
+			curr_max -= 1
+		}
+	}
+	return curr_max
+}
+
+func (st *OpusMSEncoder) encode_stream(s int, buf []int16, tmp_data []byte, curr_max int, pcm []int16, pcm_ptr, analysis_frame_size, frame_size int, bandSMR []int, lsb_depth, float_api int) int {
+	var bandLogE []int
+	var c1, c2 int
+	enc := st.encoders[s]
+	if s < st.layout.nb_coupled_streams {
+		left := get_left_channel(st.layout, s, -1)
+		right := get_right_channel(st.layout, s, -1)
+		opus_copy_channel_in_short(buf, 0, 2, pcm, pcm_ptr, st.layout.nb_channels, left, frame_size)
+		opus_copy_channel_in_short(buf, 1, 2, pcm, pcm_ptr, st.layout.nb_channels, right, frame_size)
+		if st.surround != 0 {
+			bandLogE = make([]int, 42)
+			for i := 0; i < 21; i++ {
+				bandLogE[i] = bandSMR[21*left+i]
+				bandLogE[21+i] = bandSMR[21*right+i]
 			}
-			c1, c2 = _chan, -1
+			enc.SetEnergyMask(bandLogE)
 		}
+		c1, c2 = left, right
+	} else {
+		_chan := get_mono_channel(st.layout, s, -1)
+		opus_copy_channel_in_short(buf, 0, 1, pcm, pcm_ptr, st.layout.nb_channels, _chan, frame_size)
+		if st.surround != 0 {
+			bandLogE = make([]int, 21)
+			for i := 0; i < 21; i++ {
+				bandLogE[i] = bandSMR[21*_chan+i]
+			}
+			enc.SetEnergyMask(bandLogE)
+		}
+		c1, c2 = _chan, -1
+	}
+
+	if !st.encoders[0].GetUseVBR() && s == st.layout.nb_streams-1 {
+		enc.SetBitrate(curr_max * (8 * st.encoders[0].GetSampleRate() / frame_size))
+	}
+	ret := enc.opus_encode_native(buf, 0, frame_size, tmp_data, 0, curr_max, lsb_depth, pcm, pcm_ptr, analysis_frame_size, c1, c2, st.layout.nb_channels, float_api)
+	if ret > 0 && enc.tracer != nil {
+		enc.trace_frame(tmp_data, 0, frame_size, ret)
+	}
+	return ret
+}
 
-		curr_max = max_data_bytes - tot_size
-		curr_max -= IMAX(0, 2*(st.layout.nb_streams-s-1)-1)
+func (st *OpusMSEncoder) write_stream(rp *OpusRepacketizer, s int, tmp_data []byte, len int, data []byte, data_ptr, maxlen int) int {
+	vbr := st.encoders[0].GetUseVBR()
+	rp.Reset()
+	rp.addPacket(tmp_data, 0, len)
+	return rp.opus_repacketizer_out_range_impl(0, rp.getNumFrames(),
+		data, data_ptr, maxlen, boolToInt(s != st.layout.nb_streams-1), boolToInt(!vbr && s == st.layout.nb_streams-1))
+}
+
+// The size limit handed to each stream by the sequential path depends on how
+// many bytes the earlier streams used. To start every stream at once, each is
+// limited to its share of max_data_bytes in proportion to its bitrate instead,
+// clamped to MS_FRAME_TMP as before. When every share reaches the clamp, the
+// limits and so the output are the same as the sequential path. Returns nil
+// when a share is too small to code a frame, which is left to the sequential
+// path.
+func (st *OpusMSEncoder) stream_shares(max_data_bytes int, bitrates []int, rate_sum int) []int {
+	caps := make([]int, st.layout.nb_streams)
+	for s := range caps {
+		curr_max := int(int64(max_data_bytes) * int64(bitrates[s]) / int64(IMAX(1, rate_sum)))
 		curr_max = IMIN(curr_max, MS_FRAME_TMP)
 		if s != st.layout.nb_streams-1 {
 			if curr_max > 253 {
@@ -539,22 +605,61 @@ func (st *OpusMSEncoder) opus_multistream_encode_native(pcm []int16, pcm_ptr, an
 				curr_max -= 1
 			}
 		}
-		if vbr == 0 && s == st.layout.nb_streams-1 {
-			enc.SetBitrate(curr_max * (8 * Fs / frame_size))
+		if curr_max < 3 {
+			return nil
 		}
-		len = enc.opus_encode_native(buf, 0, frame_size, tmp_data, 0, curr_max, lsb_depth, pcm, pcm_ptr, analysis_frame_size, c1, c2, st.layout.nb_channels, float_api)
-		if len < 0 {
-			return len
-		}
-		rp.addPacket(tmp_data, 0, len)
+		caps[s] = curr_max
+	}
+	return caps
+}
 
-		len = rp.opus_repacketizer_out_range_impl(0, rp.getNumFrames(),
-			data, data_ptr, max_data_bytes-tot_size, boolToInt(s != st.layout.nb_streams-1), boolToInt(vbr == 0 && s == st.layout.nb_streams-1))
+func (st *OpusMSEncoder) opus_multistream_encode_parallel(pcm []int16, pcm_ptr, analysis_frame_size, frame_size int, bandSMR []int, caps []int, data []byte, data_ptr, max_data_bytes, lsb_depth, float_api int) int {
+	type streamResult struct {
+		tmp_data []byte
+		len      int
+		done     chan struct{}
+	}
+	nb_streams := st.layout.nb_streams
+	results := make([]streamResult, nb_streams)
+	for s := range results {
+		results[s].tmp_data = make([]byte, MS_FRAME_TMP)
+		results[s].done = make(chan struct{})
+	}
+	sem := make(chan struct{}, st.parallelism)
+	go func() {
+		for s := 0; s < nb_streams; s++ {
+			r := &results[s]
+			buf := make([]int16, 2*frame_size)
+			sem <- struct{}{}
+			go func(s int) {
+				r.len = st.encode_stream(s, buf, r.tmp_data, caps[s], pcm, pcm_ptr, analysis_frame_size, frame_size, bandSMR, lsb_depth, float_api)
+				<-sem
+				close(r.done)
+			}(s)
+		}
+	}()
 
+	tot_size := 0
+	ret := 0
+	rp := NewOpusRepacketizer()
+	for s := 0; s < nb_streams; s++ {
+		r := &results[s]
+		<-r.done
+		if ret < 0 {
+			continue
+		}
+		if r.len < 0 {
+			ret = r.len
+			st.error_stream = s
+			continue
+		}
+		len := st.write_stream(rp, s, r.tmp_data, r.len, data, data_ptr, max_data_bytes-tot_size)
 		data_ptr += len
 		tot_size += len
 	}
-
+	if ret < 0 {
+		return ret
+	}
 	return tot_size
 }
 
@@ -564,8 +669,35 @@ func opus_copy_channel_in_short(dst []int16, dst_ptr, dst_stride int, src []int1
 	}
 }
 
-func (st *OpusMSEncoder) EncodeMultistream(pcm []int16, pcm_offset, frame_size int, outputBuffer []byte, outputBuffer_offset, max_data_bytes int) int {
-	return st.opus_multistream_encode_native(pcm, pcm_offset, frame_size, outputBuffer, outputBuffer_offset, max_data_bytes, 16, 0)
+// EncodeMultistream encodes frame_size samples per channel of interleaved
+// input and returns the length of the multistream packet. Errors raised by
+// one of the streams carry the index of that stream.
+func (st *OpusMSEncoder) EncodeMultistream(pcm []int16, pcm_offset, frame_size int, outputBuffer []byte, outputBuffer_offset, max_data_bytes int) (int, error) {
+	if st.realtime.budget > 0 {
+		defer st.realtime_frame(st.realtime.now())
+	}
+	if outputBuffer_offset+max_data_bytes > len(outputBuffer) {
+		return 0, OpusException2("Output buffer is too small", OpusError.OPUS_BUFFER_TOO_SMALL).arg("max_data_bytes")
+	}
+	if pcm_offset+frame_size*st.layout.nb_channels > len(pcm) {
+		return 0, bad_arg("pcm", "Not enough samples provided in input signal")
+	}
+	if st.preprocessor != nil {
+		if err := st.preprocessor.check(frame_size); err != nil {
+			return 0, err
+		}
+		pcm = st.preprocessor.process(pcm, pcm_offset, frame_size)
+		pcm_offset = 0
+	}
+	ret := st.opus_multistream_encode_native(pcm, pcm_offset, frame_size, outputBuffer, outputBuffer_offset, max_data_bytes, 16, 0)
+	if ret < 0 {
+		err := encode_error(ret, max_data_bytes)
+		if st.error_stream >= 0 {
+			err.in_stream(st.error_stream)
+		}
+		return 0, err
+	}
+	return ret, nil
 }
 
 func (st *OpusMSEncoder) GetBitrate() int {
@@ -581,7 +713,7 @@ func (st *OpusMSEncoder) GetBitrate() int {
 
 func (st *OpusMSEncoder) SetBitrate(value int) error {
 	if value < 0 && value != OPUS_AUTO && value != OPUS_BITRATE_MAX {
-		return errors.New("Invalid bitrate")
+		return bad_arg("value", "Invalid bitrate")
 	}
 	st.bitrate_bps = value
 	return nil
@@ -645,6 +777,52 @@ func (st *OpusMSEncoder) SetComplexity(value int) {
 	for i := 0; i < st.layout.nb_streams; i++ {
 		st.encoders[i].SetComplexity(value)
 	}
+	st.realtime.ceiling = value
+}
+
+func (st *OpusMSEncoder) set_complexity(value int) {
+	for i := 0; i < st.layout.nb_streams; i++ {
+		st.encoders[i].set_complexity(value)
+	}
+}
+
+// SetRealtimeBudget enables the real-time mode for the whole multistream
+// encoder: the budget applies to each EncodeMultistream call and all streams
+// share the same complexity. See OpusEncoder.SetRealtimeBudget.
+func (st *OpusMSEncoder) SetRealtimeBudget(budget time.Duration) error {
+	if budget < 0 {
+		return bad_arg("budget", "Invalid real-time budget")
+	}
+	if budget == 0 {
+		if st.realtime.budget > 0 {
+			st.set_complexity(st.realtime.ceiling)
+		}
+		st.realtime.enable(0, 0)
+		return nil
+	}
+	if st.realtime.budget == 0 {
+		st.realtime.enable(budget, st.GetComplexity())
+	} else {
+		st.realtime.budget = budget
+	}
+	return nil
+}
+
+func (st *OpusMSEncoder) GetRealtimeBudget() time.Duration {
+	return st.realtime.budget
+}
+
+// GetRealtimeState returns the latest decisions of the real-time mode.
+func (st *OpusMSEncoder) GetRealtimeState() RealtimeState {
+	return st.realtime.state(st.GetComplexity())
+}
+
+func (st *OpusMSEncoder) realtime_frame(start time.Time) {
+	complexity := st.GetComplexity()
+	next := st.realtime.update(st.realtime.now().Sub(start), complexity)
+	if next != complexity {
+		st.set_complexity(next)
+	}
 }
 
 func (st *OpusMSEncoder) GetForceMode() int {
@@ -708,6 +886,9 @@ func (st *OpusMSEncoder) SetSignalType(value OpusSignal) {
 }
 
 func (st *OpusMSEncoder) GetLookahead() int {
+	if st.preprocessor != nil {
+		return st.encoders[0].GetLookahead() + st.preprocessor.pre.Delay()
+	}
 	return st.encoders[0].GetLookahead()
 }
 
@@ -746,6 +927,35 @@ func (st *OpusMSEncoder) SetPredictionDisabled(value bool) {
 	}
 }
 
+// SetSilkConfig sets the expert SILK settings of every stream. A config
+// one stream rejects is applied to none.
+func (st *OpusMSEncoder) SetSilkConfig(config SilkConfig) error {
+	old := st.encoders[0].GetSilkConfig()
+	for i := 0; i < st.layout.nb_streams; i++ {
+		if err := st.encoders[i].SetSilkConfig(config); err != nil {
+			for j := 0; j < i; j++ {
+				st.encoders[j].SetSilkConfig(old)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (st *OpusMSEncoder) GetSilkConfig() SilkConfig {
+	return st.encoders[0].GetSilkConfig()
+}
+
+// GetSilkStatus reports the SILK layer of each stream in the last packet
+// encoded.
+func (st *OpusMSEncoder) GetSilkStatus() []SilkStatus {
+	status := make([]SilkStatus, st.layout.nb_streams)
+	for i := range status {
+		status[i] = st.encoders[i].GetSilkStatus()
+	}
+	return status
+}
+
 func (st *OpusMSEncoder) GetExpertFrameDuration() OpusFramesize {
 	return st.variable_duration
 }
@@ -754,9 +964,68 @@ func (st *OpusMSEncoder) SetExpertFrameDuration(value OpusFramesize) {
 	st.variable_duration = value
 }
 
+// SetTracer sets the sink for the trace events of all streams, which are
+// tagged with their stream index.
+func (st *OpusMSEncoder) SetTracer(value Tracer) {
+	st.tracer = value
+	for s := 0; s < st.layout.nb_streams; s++ {
+		st.encoders[s].SetTracer(stream_tracer(value, s))
+	}
+}
+
+func (st *OpusMSEncoder) GetTracer() Tracer {
+	return st.tracer
+}
+
+func (st *OpusMSEncoder) GetPhaseInversionDisabled() bool {
+	return st.encoders[0].GetPhaseInversionDisabled()
+}
+
+func (st *OpusMSEncoder) SetPhaseInversionDisabled(value bool) {
+	for i := 0; i < st.layout.nb_streams; i++ {
+		st.encoders[i].SetPhaseInversionDisabled(value)
+	}
+}
+
+// SetPreprocessor runs the input channels through pre before they are
+// mapped to the streams, as OpusEncoder.SetPreprocessor does.
+func (st *OpusMSEncoder) SetPreprocessor(pre Preprocessor) error {
+	if pre == nil {
+		st.preprocessor = nil
+		return nil
+	}
+	ps, err := new_preprocess_stage(pre, st.encoders[0].Fs, st.layout.nb_channels)
+	if err != nil {
+		return err
+	}
+	st.preprocessor = ps
+	return nil
+}
+
+func (st *OpusMSEncoder) GetPreprocessor() Preprocessor {
+	if st.preprocessor == nil {
+		return nil
+	}
+	return st.preprocessor.pre
+}
+
+// SetParallelism lets up to value streams be encoded concurrently. Values of 1
+// or less keep the default sequential behaviour. Concurrent streams are each
+// limited to a share of max_data_bytes in proportion to their bitrate, rather
+// than to what the streams before them left. The output is the same as the
+// sequential one when every share is at least MS_FRAME_TMP bytes; with smaller
+// buffers a stream that would have used more than its share is coded smaller.
+func (st *OpusMSEncoder) SetParallelism(value int) {
+	st.parallelism = value
+}
+
+func (st *OpusMSEncoder) GetParallelism() int {
+	return IMAX(1, st.parallelism)
+}
+
 func (st *OpusMSEncoder) GetMultistreamEncoderState(streamId int) (*OpusEncoder, error) {
-	if streamId >= st.layout.nb_streams {
-		return nil, errors.New("Requested stream doesn't exist")
+	if streamId < 0 || streamId >= st.layout.nb_streams {
+		return nil, bad_arg("streamId", "Requested stream doesn't exist").in_stream(streamId)
 	}
 	return st.encoders[streamId], nil
 }
@@ -0,0 +1,197 @@
+package opus
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func testMultichannelSignal(channels, samples int) []int16 {
+	pcm := make([]int16, samples*channels)
+	seed := uint32(1)
+	for i := 0; i < samples; i++ {
+		for c := 0; c < channels; c++ {
+			seed = seed*1664525 + 1013904223
+			noise := float64(int32(seed)>>20) / 2048.0
+			tone := math.Sin(2 * math.Pi * float64(i) * float64(110*(c+1)) / 48000)
+			pcm[i*channels+c] = int16(8000*tone + 1000*noise)
+		}
+	}
+	return pcm
+}
+
+// Bandwidth and per-channel bitrate each forced mode is tested at.
+var testMSModes = []struct {
+	name      string
+	mode      int
+	bandwidth int
+	bitrate   int
+}{
+	{"SILK", MODE_SILK_ONLY, OPUS_BANDWIDTH_WIDEBAND, 32000},
+	{"hybrid", MODE_HYBRID, OPUS_BANDWIDTH_FULLBAND, 24000},
+	{"CELT", MODE_CELT_ONLY, OPUS_BANDWIDTH_FULLBAND, 64000},
+}
+
+func newTestMSCodec(tb testing.TB, channels, parallelism, mode int) (*OpusMSEncoder, *OpusMSDecoder) {
+	streams := (channels + 1) / 2
+	coupled := channels / 2
+	mapping := make([]int16, channels)
+	for c := range mapping {
+		mapping[c] = int16(c)
+	}
+	enc, err := CreateOpusMSEncoder(48000, channels, streams, coupled, mapping, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	m := testMSModes[mode]
+	enc.SetForceMode(m.mode)
+	enc.SetMaxBandwidth(m.bandwidth)
+	enc.SetComplexity(0)
+	enc.SetBitrate(m.bitrate * channels)
+	enc.SetParallelism(parallelism)
+	dec, err := OpusMSDecoder_create(48000, channels, streams, coupled, mapping)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	dec.SetParallelism(parallelism)
+	return enc, dec
+}
+
+func TestMultistreamParallelMatchesSequential(t *testing.T) {
+	const channels = 7
+	const frame = 960
+	const frames = 25
+	pcm := testMultichannelSignal(channels, frame*frames)
+	/* Every stream gets MS_FRAME_TMP bytes either way */
+	const maxBytes = MS_FRAME_TMP * 8
+	for mode := range testMSModes {
+		name := testMSModes[mode].name
+		seqEnc, seqDec := newTestMSCodec(t, channels, 1, mode)
+		parEnc, parDec := newTestMSCodec(t, channels, 3, mode)
+		seqPkt := make([]byte, maxBytes)
+		parPkt := make([]byte, maxBytes)
+		seqOut := make([]int16, frame*channels)
+		parOut := make([]int16, frame*channels)
+		for f := 0; f < frames; f++ {
+			seqLen, seqErr := seqEnc.EncodeMultistream(pcm, f*frame*channels, frame, seqPkt, 0, maxBytes)
+			parLen, parErr := parEnc.EncodeMultistream(pcm, f*frame*channels, frame, parPkt, 0, maxBytes)
+			if seqErr != nil || parErr != nil {
+				t.Fatalf("%s max %d frame %d: %v, %v", name, maxBytes, f, seqErr, parErr)
+			}
+			if !bytes.Equal(seqPkt[:seqLen], parPkt[:parLen]) {
+				t.Fatalf("%s max %d frame %d: packets differ (%d vs %d bytes)", name, maxBytes, f, seqLen, parLen)
+			}
+
+			plc := f%7 == 6
+			if plc {
+				seqLen = 0
+			}
+			seqRet, seqErr := seqDec.DecodeMultistream(seqPkt, 0, seqLen, seqOut, 0, frame, false)
+			parRet, parErr := parDec.DecodeMultistream(seqPkt, 0, seqLen, parOut, 0, frame, false)
+			if seqErr != nil || parErr != nil {
+				t.Fatalf("%s max %d frame %d: %v, %v", name, maxBytes, f, seqErr, parErr)
+			}
+			if seqRet != frame || seqRet != parRet {
+				t.Fatalf("%s max %d frame %d: decode returned %d and %d", name, maxBytes, f, seqRet, parRet)
+			}
+			for i := range seqOut {
+				if seqOut[i] != parOut[i] {
+					t.Fatalf("%s max %d frame %d: decoded sample %d differs", name, maxBytes, f, i)
+				}
+			}
+		}
+	}
+}
+
+// With less than MS_FRAME_TMP bytes per stream, each concurrent stream is held
+// to its share of the budget, so the packets may differ from the sequential
+// ones but still fit and decode.
+func TestMultistreamParallelBudget(t *testing.T) {
+	const channels = 7
+	const frame = 960
+	const frames = 25
+	pcm := testMultichannelSignal(channels, frame*frames)
+	for _, tc := range []struct {
+		maxBytes int
+		vbr      bool
+		mode     int
+	}{{1500, true, 0}, {MS_FRAME_TMP * 8, false, 0}, {1500, true, 1}, {MS_FRAME_TMP * 8, false, 1},
+		{1500, true, 2}, {600, true, 2}, {MS_FRAME_TMP * 8, false, 2}} {
+		name := testMSModes[tc.mode].name
+		seqEnc, _ := newTestMSCodec(t, channels, 1, tc.mode)
+		parEnc, dec := newTestMSCodec(t, channels, 3, tc.mode)
+		seqEnc.SetUseVBR(tc.vbr)
+		parEnc.SetUseVBR(tc.vbr)
+		seqPkt := make([]byte, tc.maxBytes)
+		pkt := make([]byte, tc.maxBytes)
+		out := make([]int16, frame*channels)
+		for f := 0; f < frames; f++ {
+			seqLen, err := seqEnc.EncodeMultistream(pcm, f*frame*channels, frame, seqPkt, 0, tc.maxBytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			n, err := parEnc.EncodeMultistream(pcm, f*frame*channels, frame, pkt, 0, tc.maxBytes)
+			if err != nil {
+				t.Fatalf("%s max %d frame %d: %v", name, tc.maxBytes, f, err)
+			}
+			/* CBR packets are padded to the same size either way */
+			if !tc.vbr && n != seqLen {
+				t.Fatalf("%s CBR frame %d: %d bytes, want %d", name, f, n, seqLen)
+			}
+			if ret, err := dec.DecodeMultistream(pkt, 0, n, out, 0, frame, false); err != nil || ret != frame {
+				t.Fatalf("%s max %d frame %d: decoded %d samples, %v", name, tc.maxBytes, f, ret, err)
+			}
+		}
+	}
+}
+
+func BenchmarkMultistreamEncode(b *testing.B) {
+	const channels = 16
+	const frame = 960
+	pcm := testMultichannelSignal(channels, frame*10)
+	for _, parallelism := range []int{1, 2, 4, 8} {
+		/* A budget that fits an Ethernet MTU, and one that never limits */
+		for _, budget := range []int{1500, MS_FRAME_TMP * channels} {
+			b.Run(fmt.Sprintf("parallelism=%d/budget=%d", parallelism, budget), func(b *testing.B) {
+				enc, _ := newTestMSCodec(b, channels, parallelism, 0)
+				pkt := make([]byte, budget)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := enc.EncodeMultistream(pcm, (i%10)*frame*channels, frame, pkt, 0, len(pkt)); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkMultistreamDecode(b *testing.B) {
+	const channels = 16
+	const frame = 960
+	pcm := testMultichannelSignal(channels, frame*10)
+	enc, _ := newTestMSCodec(b, channels, 1, 0)
+	packets := make([][]byte, 10)
+	for f := range packets {
+		pkt := make([]byte, MS_FRAME_TMP*channels)
+		n, err := enc.EncodeMultistream(pcm, f*frame*channels, frame, pkt, 0, len(pkt))
+		if err != nil {
+			b.Fatal(err)
+		}
+		packets[f] = pkt[:n]
+	}
+	out := make([]int16, frame*channels)
+	for _, parallelism := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			_, dec := newTestMSCodec(b, channels, parallelism, 0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pkt := packets[i%len(packets)]
+				if _, err := dec.DecodeMultistream(pkt, 0, len(pkt), out, 0, frame, false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
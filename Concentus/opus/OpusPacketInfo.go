@@ -1,7 +1,5 @@
 package opus
 
-import "errors"
-
 type OpusPacketInfo struct {
 	TOCByte       byte
 	Frames        [][]byte
@@ -17,9 +15,12 @@ func NewOpusPacketInfo(toc byte, frames [][]byte, payloadOffset int) *OpusPacket
 }
 
 func ParseOpusPacket(packet []byte, packet_offset, _len int) (*OpusPacketInfo, error) {
+	if _len < 1 || packet_offset+_len > len(packet) {
+		return nil, bad_arg("_len", "Packet length is out of range")
+	}
 	numFrames := GetNumFrames(packet, packet_offset, _len)
 	if numFrames < 0 {
-		return nil, errors.New("opus_packet_parse_impl failed")
+		return nil, OpusException2("An error occurred while parsing the packet", numFrames).at_fault(packet, packet_offset, _len)
 	}
 
 	var out_toc = BoxedValueByte{0}
@@ -30,7 +31,7 @@ func ParseOpusPacket(packet []byte, packet_offset, _len int) (*OpusPacketInfo, e
 	var packet_offset_out = BoxedValueInt{0}
 	errCode := opus_packet_parse_impl(packet, packet_offset, _len, 0, &out_toc, frames, 0, sizes, 0, &payload_offset, &packet_offset_out)
 	if errCode < 0 {
-		return nil, errors.New("opus_packet_parse_impl failed")
+		return nil, OpusException2("An error occurred while parsing the packet", errCode).at_fault(packet, packet_offset, _len)
 	}
 
 	copiedFrames := make([][]byte, len(frames))
@@ -113,6 +114,82 @@ func GetEncoderMode(packet []byte, packet_offset int) int {
 	return MODE_SILK_ONLY
 }
 
+// PacketHasLBRR reports whether the first frame of a SILK or hybrid packet
+// carries in-band FEC (LBRR) data for the packet before it.
+func PacketHasLBRR(packet []byte, packet_offset, len int) bool {
+	if len < 1 || GetEncoderMode(packet, packet_offset) == MODE_CELT_ONLY {
+		return false
+	}
+	nb_frames := IMAX(1, GetNumSamplesPerFrame(packet, packet_offset, 48000)/960)
+	toc := BoxedValueByte{0}
+	size := make([]int16, 48)
+	payload_offset := BoxedValueInt{0}
+	packet_offset_out := BoxedValueInt{0}
+	if opus_packet_parse_impl(packet, packet_offset, len, 0, &toc, nil, 0, size, 0, &payload_offset, &packet_offset_out) <= 0 || size[0] == 0 {
+		return false
+	}
+	/* The VAD and LBRR flags are the first bits of the range coder, coded
+	   with a probability of one half, so they can be read directly */
+	first := packet[packet_offset+payload_offset.Val]
+	lbrr := (first >> (7 - nb_frames)) & 0x1
+	if GetNumEncodedChannels(packet, packet_offset) == 2 {
+		lbrr |= (first >> (6 - 2*nb_frames)) & 0x1
+	}
+	return lbrr != 0
+}
+
+// PacketIsDTX reports whether the packet carries no audio, as the packets
+// an encoder sends during DTX: a lone TOC byte, or frames of at most one
+// byte, which the decoder conceals as it would a loss.
+func PacketIsDTX(packet []byte, packet_offset, len int) bool {
+	if len < 1 {
+		return false
+	}
+	toc := BoxedValueByte{0}
+	size := make([]int16, 48)
+	payload_offset := BoxedValueInt{0}
+	packet_offset_out := BoxedValueInt{0}
+	count := opus_packet_parse_impl(packet, packet_offset, len, 0, &toc, nil, 0, size, 0, &payload_offset, &packet_offset_out)
+	if count <= 0 {
+		return false
+	}
+	for i := 0; i < count; i++ {
+		if size[i] > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// PacketHasVoiceActivity reports whether the SILK encoder flagged any frame
+// of the first frame of the packet as active speech. CELT packets carry no
+// such flag: only their silence frames, which the encoder sends for digital
+// silence, report false. OpusDecoder.GetVoiceActivity judges them by their
+// decoded level instead.
+func PacketHasVoiceActivity(packet []byte, packet_offset, len int) bool {
+	if len < 1 {
+		return false
+	}
+	nb_frames := IMAX(1, GetNumSamplesPerFrame(packet, packet_offset, 48000)/960)
+	toc := BoxedValueByte{0}
+	size := make([]int16, 48)
+	payload_offset := BoxedValueInt{0}
+	packet_offset_out := BoxedValueInt{0}
+	if opus_packet_parse_impl(packet, packet_offset, len, 0, &toc, nil, 0, size, 0, &payload_offset, &packet_offset_out) <= 0 || size[0] <= 1 {
+		/* Empty frames are DTX */
+		return false
+	}
+	if GetEncoderMode(packet, packet_offset) == MODE_CELT_ONLY {
+		/* The silence flag is the first symbol of a CELT frame */
+		dec := EntropyCoder{}
+		dec.dec_init(packet, packet_offset+payload_offset.Val, int(size[0]))
+		return dec.dec_bit_logp(15) == 0
+	}
+	/* One VAD flag per 20 ms SILK frame, ahead of the LBRR flag */
+	first := packet[packet_offset+payload_offset.Val]
+	return first>>(8-nb_frames) != 0
+}
+
 func GetBandwidth(packet []byte, packet_offset int) int {
 	var bandwidth int
 	if (packet[packet_offset] & 0x80) != 0 {
@@ -172,6 +249,8 @@ func opus_packet_parse_impl(data []byte, data_ptr, len_val, self_delimited int,
 	Fs := 48000 // 标准采样率
 	framesize := GetNumSamplesPerFrame(data, data_ptr, Fs)
 
+	data0 := data_ptr
+	pad := 0
 	cbr := 0
 	toc := data[data_ptr]
 	data_ptr++
@@ -211,10 +290,9 @@ func opus_packet_parse_impl(data []byte, data_ptr, len_val, self_delimited int,
 		data_ptr++
 		len_val--
 		count = ch & 0x3F
-		if count <= 0 || framesize*count > MAX_FRAME_SIZE {
+		if count <= 0 || framesize*count > 5760 {
 			return OpusError.OPUS_INVALID_PACKET
 		}
-		pad := 0
 		if (ch & 0x40) != 0 {
 			for {
 				if len_val <= 0 {
@@ -237,8 +315,9 @@ func opus_packet_parse_impl(data []byte, data_ptr, len_val, self_delimited int,
 		if len_val < 0 {
 			return OpusError.OPUS_INVALID_PACKET
 		}
+		/* Bit 7 is the VBR flag */
 		cbr = 0
-		if (ch & 0x80) != 0 {
+		if (ch & 0x80) == 0 {
 			cbr = 1
 		}
 		if cbr == 0 { // VBR
@@ -295,22 +374,22 @@ func opus_packet_parse_impl(data []byte, data_ptr, len_val, self_delimited int,
 		sizes[sizes_ptr+count-1] = int16(last_size)
 	}
 
-	payload_offset.Val = data_ptr - packet_offset.Val
+	payload_offset.Val = data_ptr - data0
 
 	// 复制帧数据
 	for i := 0; i < count; i++ {
+		size := int(sizes[sizes_ptr+i])
+		if data_ptr+size > len(data) {
+			return OpusError.OPUS_INVALID_PACKET
+		}
 		if frames != nil && frames_ptr+i < len(frames) {
-			size := int(sizes[sizes_ptr+i])
-			if data_ptr+size > len(data) {
-				return OpusError.OPUS_INVALID_PACKET
-			}
 			frames[frames_ptr+i] = make([]byte, size)
 			copy(frames[frames_ptr+i], data[data_ptr:data_ptr+size])
-			data_ptr += size
 		}
+		data_ptr += size
 	}
 
-	packet_offset.Val = data_ptr
+	packet_offset.Val = pad + data_ptr - data0
 	out_toc.Val = int8(toc)
 	return count
 }
@@ -13,7 +13,10 @@ func (this *OpusRepacketizer) Reset() {
 }
 
 func NewOpusRepacketizer() *OpusRepacketizer {
-	rp := &OpusRepacketizer{}
+	rp := &OpusRepacketizer{
+		frames: make([][]byte, 48),
+		len:    make([]int16, 48),
+	}
 	rp.Reset()
 	return rp
 }
@@ -32,7 +35,7 @@ func (this *OpusRepacketizer) opus_repacketizer_cat_impl(data []byte, data_ptr i
 		return OpusError.OPUS_INVALID_PACKET
 	}
 
-	curr_nb_frames := getNumFrames(data, data_ptr, len_val)
+	curr_nb_frames := GetNumFrames(data, data_ptr, len_val)
 	if curr_nb_frames < 1 {
 		return OpusError.OPUS_INVALID_PACKET
 	}
@@ -100,7 +103,7 @@ func (this *OpusRepacketizer) opus_repacketizer_out_range_impl(begin int, end in
 			}
 			data[ptr] = (this.toc & 0xFC) | 0x02
 			ptr++
-			ptr += encode_size(int(this.len[0]), data[ptr:], ptr)
+			ptr += encode_size(int(this.len[0]), data, ptr)
 		}
 	}
 	if count > 2 || (pad != 0 && tot_size < maxlen) {
@@ -166,13 +169,13 @@ func (this *OpusRepacketizer) opus_repacketizer_out_range_impl(begin int, end in
 
 		if vbr != 0 {
 			for i := 0; i < count-1; i++ {
-				ptr += encode_size(int(this.len[i]), data[ptr:], ptr)
+				ptr += encode_size(int(this.len[i]), data, ptr)
 			}
 		}
 	}
 
 	if self_delimited != 0 {
-		sdlen := encode_size(int(this.len[count-1]), data[ptr:], ptr)
+		sdlen := encode_size(int(this.len[count-1]), data, ptr)
 		ptr += sdlen
 	}
 
@@ -190,15 +193,98 @@ func (this *OpusRepacketizer) opus_repacketizer_out_range_impl(begin int, end in
 	return tot_size
 }
 
-func (this *OpusRepacketizer) createPacket(begin int, end int, data []byte, data_offset int, maxlen int) int {
-	return this.opus_repacketizer_out_range_impl(begin, end, data, data_offset, maxlen, 0, 0)
+// AddPacket queues the frames of a packet. All packets added between resets
+// must share the same TOC configuration and hold no more than 120 ms in
+// total.
+func (this *OpusRepacketizer) AddPacket(data []byte, data_offset int, len_val int) error {
+	ret := this.addPacket(data, data_offset, len_val)
+	if ret < 0 {
+		return OpusException2("Packet cannot be added", ret).at(0)
+	}
+	return nil
+}
+
+// GetNumFrames returns the number of frames queued since the last reset.
+func (this *OpusRepacketizer) GetNumFrames() int {
+	return this.nb_frames
+}
+
+// CreatePacketRange writes the queued frames begin to end-1 as a single
+// packet and returns its length.
+func (this *OpusRepacketizer) CreatePacketRange(begin int, end int, data []byte, data_offset int, maxlen int) (int, error) {
+	ret := this.opus_repacketizer_out_range_impl(begin, end, data, data_offset, maxlen, 0, 0)
+	if ret < 0 {
+		err := OpusException2("Packet cannot be created", ret)
+		if ret == OpusError.OPUS_BAD_ARG {
+			err.arg("begin")
+		} else if ret == OpusError.OPUS_BUFFER_TOO_SMALL {
+			err.arg("maxlen")
+		}
+		return 0, err
+	}
+	return ret, nil
+}
+
+// CreatePacket writes all queued frames as a single packet and returns its
+// length.
+func (this *OpusRepacketizer) CreatePacket(data []byte, data_offset int, maxlen int) (int, error) {
+	return this.CreatePacketRange(0, this.nb_frames, data, data_offset, maxlen)
+}
+
+// PadPacket pads a packet of len_val bytes in place to new_len bytes.
+func PadPacket(data []byte, data_offset int, len_val int, new_len int) error {
+	if data_offset+new_len > len(data) {
+		return OpusException2("Output buffer is too small", OpusError.OPUS_BUFFER_TOO_SMALL).arg("new_len")
+	}
+	if ret := opus_packet_pad(data, data_offset, len_val, new_len); ret < 0 {
+		return pad_error(ret, "Packet cannot be padded", -1)
+	}
+	return nil
+}
+
+// UnpadPacket removes all padding from a packet in place and returns its new
+// length.
+func UnpadPacket(data []byte, data_offset int, len_val int) (int, error) {
+	ret := opus_packet_unpad(data, data_offset, len_val)
+	if ret < 0 {
+		return 0, pad_error(ret, "Packet cannot be unpadded", -1)
+	}
+	return ret, nil
+}
+
+// PadMultistreamPacket pads the last stream of a multistream packet in place
+// so that the packet grows to new_len bytes.
+func PadMultistreamPacket(data []byte, data_offset int, len_val int, new_len int, nb_streams int) error {
+	if data_offset+new_len > len(data) {
+		return OpusException2("Output buffer is too small", OpusError.OPUS_BUFFER_TOO_SMALL).arg("new_len")
+	}
+	error_stream := BoxedValueInt{-1}
+	if ret := opus_multistream_packet_pad(data, data_offset, len_val, new_len, nb_streams, &error_stream); ret < 0 {
+		return pad_error(ret, "Packet cannot be padded", error_stream.Val)
+	}
+	return nil
+}
+
+// UnpadMultistreamPacket removes all padding from each stream of a
+// multistream packet in place and returns its new length.
+func UnpadMultistreamPacket(data []byte, data_offset int, len_val int, nb_streams int) (int, error) {
+	error_stream := BoxedValueInt{-1}
+	ret := opus_multistream_packet_unpad(data, data_offset, len_val, nb_streams, &error_stream)
+	if ret < 0 {
+		return 0, pad_error(ret, "Packet cannot be unpadded", error_stream.Val)
+	}
+	return ret, nil
 }
 
-func (this *OpusRepacketizer) createPacketOut(data []byte, data_offset int, maxlen int) int {
-	return this.opus_repacketizer_out_range_impl(0, this.nb_frames, data, data_offset, maxlen, 0, 0)
+func pad_error(code int, message string, stream int) *OpusException {
+	err := OpusException2(message, code).in_stream(stream)
+	if code == OpusError.OPUS_BAD_ARG {
+		err.arg("len_val")
+	}
+	return err
 }
 
-func PadPacket(data []byte, data_offset int, len_val int, new_len int) int {
+func opus_packet_pad(data []byte, data_offset int, len_val int, new_len int) int {
 	if len_val < 1 {
 		return OpusError.OPUS_BAD_ARG
 	}
@@ -218,7 +304,7 @@ func PadPacket(data []byte, data_offset int, len_val int, new_len int) int {
 	return ret
 }
 
-func UnpadPacket(data []byte, data_offset int, len_val int) int {
+func opus_packet_unpad(data []byte, data_offset int, len_val int) int {
 	if len_val < 1 {
 		return OpusError.OPUS_BAD_ARG
 	}
@@ -232,7 +318,7 @@ func UnpadPacket(data []byte, data_offset int, len_val int) int {
 	return ret
 }
 
-func PadMultistreamPacket(data []byte, data_offset int, len_val int, new_len int, nb_streams int) int {
+func opus_multistream_packet_pad(data []byte, data_offset int, len_val int, new_len int, nb_streams int, error_stream *BoxedValueInt) int {
 	if len_val < 1 {
 		return OpusError.OPUS_BAD_ARG
 	}
@@ -244,11 +330,12 @@ func PadMultistreamPacket(data []byte, data_offset int, len_val int, new_len int
 
 	amount := new_len - len_val
 	dummy_toc := BoxedValueByte{0}
-	size := []int16{}
+	size := make([]int16, 48)
 	packet_offset := BoxedValueInt{0}
 	dummy_offset := BoxedValueInt{0}
 
 	for s := 0; s < nb_streams-1; s++ {
+		error_stream.Val = s
 		if len_val <= 0 {
 			return OpusError.OPUS_INVALID_PACKET
 		}
@@ -259,10 +346,11 @@ func PadMultistreamPacket(data []byte, data_offset int, len_val int, new_len int
 		data_offset += int(packet_offset.Val)
 		len_val -= int(packet_offset.Val)
 	}
-	return PadPacket(data, data_offset, len_val, len_val+amount)
+	error_stream.Val = nb_streams - 1
+	return opus_packet_pad(data, data_offset, len_val, len_val+amount)
 }
 
-func UnpadMultistreamPacket(data []byte, data_offset int, len_val int, nb_streams int) int {
+func opus_multistream_packet_unpad(data []byte, data_offset int, len_val int, nb_streams int, error_stream *BoxedValueInt) int {
 	if len_val < 1 {
 		return OpusError.OPUS_BAD_ARG
 	}
@@ -270,7 +358,7 @@ func UnpadMultistreamPacket(data []byte, data_offset int, len_val int, nb_stream
 	dst := data_offset
 	dst_len := 0
 	dummy_toc := BoxedValueByte{0}
-	size := []int16{}
+	size := make([]int16, 48)
 	packet_offset := BoxedValueInt{0}
 	dummy_offset := BoxedValueInt{0}
 
@@ -279,6 +367,7 @@ func UnpadMultistreamPacket(data []byte, data_offset int, len_val int, nb_stream
 		if s != nb_streams-1 {
 			self_delimited = 1
 		}
+		error_stream.Val = s
 		if len_val <= 0 {
 			return OpusError.OPUS_INVALID_PACKET
 		}
@@ -325,25 +414,3 @@ func getNumSamplesPerFrame(packet []byte, packet_offset int, Fs int) int {
 	}
 	return audiosize
 }
-
-func getNumFrames(data []byte, data_ptr int, len_val int) int {
-	if len_val < 1 {
-		return OpusError.OPUS_INVALID_PACKET
-	}
-	toc := data[data_ptr]
-	if (toc & 0x80) != 0 {
-		count := int(toc & 0x3F)
-		if count == 0 || count > 48 {
-			return OpusError.OPUS_INVALID_PACKET
-		}
-		return count
-	} else if (toc & 0x60) == 0x60 {
-		count := 1
-		if (toc & 0x08) != 0 {
-			count++
-		}
-		return count
-	} else {
-		return 1
-	}
-}
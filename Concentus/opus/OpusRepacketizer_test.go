@@ -0,0 +1,58 @@
+package opus
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Single-frame packets of SILK-only 20 ms frames with payloads of different
+// sizes, so that joining them needs a VBR code 3 packet with coded sizes.
+func testRepacketizerFrames() [][]byte {
+	frames := make([][]byte, 3)
+	for i := range frames {
+		frames[i] = make([]byte, 1+40*i+300*(i&1))
+		frames[i][0] = 0x08
+		for j := 1; j < len(frames[i]); j++ {
+			frames[i][j] = byte(i*31 + j)
+		}
+	}
+	return frames
+}
+
+func TestRepacketizerRoundTrip(t *testing.T) {
+	frames := testRepacketizerFrames()
+	rp := NewOpusRepacketizer()
+	for _, f := range frames {
+		if err := rp.AddPacket(f, 0, len(f)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := make([]byte, 1500)
+	n, err := rp.CreatePacket(out, 0, len(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := ParseOpusPacket(out, 0, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Frames) != len(frames) {
+		t.Fatalf("%d frames, want %d", len(info.Frames), len(frames))
+	}
+	for i, f := range frames {
+		if !bytes.Equal(info.Frames[i], f[1:]) {
+			t.Fatalf("frame %d: %d bytes differ from the %d added", i, len(info.Frames[i]), len(f)-1)
+		}
+	}
+
+	/* Padding the joined packet and taking it off again gives it back */
+	padded := make([]byte, n+600)
+	copy(padded, out[:n])
+	if err := PadPacket(padded, 0, n, len(padded)); err != nil {
+		t.Fatal(err)
+	}
+	if m, err := UnpadPacket(padded, 0, len(padded)); err != nil || m != n || !bytes.Equal(padded[:m], out[:n]) {
+		t.Fatalf("UnpadPacket gives %d bytes, want the %d of the joined packet", m, n)
+	}
+}
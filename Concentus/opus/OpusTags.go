@@ -0,0 +1,136 @@
+package opus
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
+
+/* The comment header of RFC 7845, Vorbis comments with an "OpusTags" magic */
+
+// OpusTags holds the vendor string and the user comments of a stream, each
+// in the form NAME=value.
+type OpusTags struct {
+	Vendor   string
+	Comments []string
+}
+
+// NewOpusTags returns tags with this library as the vendor.
+func NewOpusTags() *OpusTags {
+	return &OpusTags{Vendor: GetVersionString()}
+}
+
+// Marshal returns the tags in the Ogg layout, starting with "OpusTags".
+func (t *OpusTags) Marshal() []byte {
+	out := []byte("OpusTags")
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(t.Vendor)))
+	out = append(out, t.Vendor...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(t.Comments)))
+	for _, c := range t.Comments {
+		out = binary.LittleEndian.AppendUint32(out, uint32(len(c)))
+		out = append(out, c...)
+	}
+	return out
+}
+
+// ParseOpusTags reads tags in the Ogg layout.
+func ParseOpusTags(data []byte) (*OpusTags, error) {
+	if len(data) < 16 || string(data[:8]) != "OpusTags" {
+		return nil, OpusException2("Not an OpusTags", OpusError.OPUS_INVALID_PACKET).at(0)
+	}
+	pos := 8
+	str := func() (string, bool) {
+		if len(data)-pos < 4 {
+			return "", false
+		}
+		n := int(binary.LittleEndian.Uint32(data[pos:]))
+		if n < 0 || n > len(data)-pos-4 {
+			return "", false
+		}
+		pos += 4 + n
+		return string(data[pos-n : pos]), true
+	}
+	t := &OpusTags{}
+	var ok bool
+	if t.Vendor, ok = str(); !ok || len(data)-pos < 4 {
+		return nil, OpusException2("OpusTags is truncated", OpusError.OPUS_INVALID_PACKET).at(pos)
+	}
+	count := int(binary.LittleEndian.Uint32(data[pos:]))
+	pos += 4
+	for i := 0; i < count; i++ {
+		c, ok := str()
+		if !ok {
+			return nil, OpusException2("OpusTags is truncated", OpusError.OPUS_INVALID_PACKET).at(pos)
+		}
+		t.Comments = append(t.Comments, c)
+	}
+	return t, nil
+}
+
+// Get returns the first value of a comment, matching the name without
+// regard to case.
+func (t *OpusTags) Get(name string) (string, bool) {
+	for _, c := range t.Comments {
+		if n, v, ok := strings.Cut(c, "="); ok && strings.EqualFold(n, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// Set replaces all the values of a comment with one value.
+func (t *OpusTags) Set(name string, value string) {
+	t.Remove(name)
+	t.Comments = append(t.Comments, name+"="+value)
+}
+
+// Remove removes all the values of a comment.
+func (t *OpusTags) Remove(name string) {
+	kept := t.Comments[:0]
+	for _, c := range t.Comments {
+		if n, _, ok := strings.Cut(c, "="); !ok || !strings.EqualFold(n, name) {
+			kept = append(kept, c)
+		}
+	}
+	t.Comments = kept
+}
+
+/* The R128 gains are Q8 dB, like the output gain, and are applied on top of
+   it to reach the -23 LUFS reference of EBU R128 */
+
+// GetR128TrackGain returns the R128_TRACK_GAIN comment in Q8 dB.
+func (t *OpusTags) GetR128TrackGain() (int, bool) {
+	return t.getGain("R128_TRACK_GAIN")
+}
+
+// SetR128TrackGain sets the R128_TRACK_GAIN comment, in Q8 dB.
+func (t *OpusTags) SetR128TrackGain(value int) {
+	t.Set("R128_TRACK_GAIN", strconv.Itoa(clamp_gain(value)))
+}
+
+// GetR128AlbumGain returns the R128_ALBUM_GAIN comment in Q8 dB.
+func (t *OpusTags) GetR128AlbumGain() (int, bool) {
+	return t.getGain("R128_ALBUM_GAIN")
+}
+
+// SetR128AlbumGain sets the R128_ALBUM_GAIN comment, in Q8 dB.
+func (t *OpusTags) SetR128AlbumGain(value int) {
+	t.Set("R128_ALBUM_GAIN", strconv.Itoa(clamp_gain(value)))
+}
+
+func (t *OpusTags) getGain(name string) (int, bool) {
+	v, ok := t.Get(name)
+	if !ok {
+		return 0, false
+	}
+	/* A leading '+' is tolerated, as some taggers write one */
+	gain, err := strconv.Atoi(strings.TrimPrefix(v, "+"))
+	if err != nil || gain < -32768 || gain > 32767 {
+		return 0, false
+	}
+	return gain, true
+}
+
+func clamp_gain(value int) int {
+	return IMAX(-32768, IMIN(32767, value))
+}
@@ -0,0 +1,197 @@
+package opus
+
+// OpusTwoPassProfile is the complexity profile gathered by the first pass of a
+// two-pass encode. It measures nothing but the size in bytes of every frame
+// when the whole input is encoded in unconstrained VBR at the target average
+// rate; no other feature of the signal is kept. The sizes reflect the
+// decisions of the tonality analysis, the CELT VBR target and the SILK SNR
+// control. The second pass uses them as relative weights, and compares their
+// total with Bitrate to learn how much of a requested rate the encoder spends.
+type OpusTwoPassProfile struct {
+	FrameSize  int
+	Bitrate    int // the rate the first pass was asked for
+	FrameBytes []int
+}
+
+func (p *OpusTwoPassProfile) TotalBytes() int {
+	total := 0
+	for _, b := range p.FrameBytes {
+		total += b
+	}
+	return total
+}
+
+func twopass_frame_count(pcm []int16, pcm_offset, frame_size, channels int) int {
+	samples := (len(pcm) - pcm_offset) / channels
+	return (samples + frame_size - 1) / frame_size
+}
+
+// Returns the input frame at index i, zero padding the last one if needed.
+func twopass_frame(pcm []int16, pcm_offset, frame_size, channels, i int, pad []int16) ([]int16, int) {
+	start := pcm_offset + i*frame_size*channels
+	if start+frame_size*channels <= len(pcm) {
+		return pcm, start
+	}
+	for j := range pad {
+		pad[j] = 0
+	}
+	copy(pad, pcm[start:])
+	return pad, 0
+}
+
+func (st *OpusEncoder) twopass_check(pcm []int16, pcm_offset, frame_size int) error {
+	if 400*frame_size != st.Fs && 200*frame_size != st.Fs && 100*frame_size != st.Fs &&
+		50*frame_size != st.Fs && 25*frame_size != st.Fs && 50*frame_size != 3*st.Fs {
+		return bad_arg("frame_size", "Invalid frame size")
+	}
+	if pcm_offset < 0 || pcm_offset >= len(pcm) {
+		return bad_arg("pcm", "No input samples provided")
+	}
+	return nil
+}
+
+// AnalyzeTwoPass runs the first pass over the whole input and returns its
+// complexity profile. The encoder is reset afterwards.
+func (st *OpusEncoder) AnalyzeTwoPass(pcm []int16, pcm_offset, frame_size, bitrate int) (*OpusTwoPassProfile, error) {
+	if err := st.twopass_check(pcm, pcm_offset, frame_size); err != nil {
+		return nil, err
+	}
+	frames := twopass_frame_count(pcm, pcm_offset, frame_size, st.channels)
+	saved_bitrate, saved_vbr, saved_constraint := st.user_bitrate_bps, st.use_vbr, st.vbr_constraint
+	defer func() {
+		st.user_bitrate_bps = saved_bitrate
+		st.SetUseVBR(saved_vbr != 0)
+		st.vbr_constraint = saved_constraint
+		st.ResetState()
+	}()
+
+	st.ResetState()
+	st.SetBitrate(bitrate)
+	st.SetUseVBR(true)
+	st.SetUseConstrainedVBR(false)
+
+	profile := &OpusTwoPassProfile{FrameSize: frame_size, Bitrate: bitrate, FrameBytes: make([]int, frames)}
+	pad := make([]int16, frame_size*st.channels)
+	packet := make([]byte, 1275*3+7)
+	for i := 0; i < frames; i++ {
+		in, in_ptr := twopass_frame(pcm, pcm_offset, frame_size, st.channels, i, pad)
+		ret := st.opus_encode_native(in, in_ptr, frame_size, packet, 0, len(packet), 16, in, in_ptr, frame_size, 0, -2, st.channels, 0)
+		if ret < 0 {
+			return nil, encode_error(ret, len(packet))
+		}
+		profile.FrameBytes[i] = ret
+	}
+	return profile, nil
+}
+
+// EncodeTwoPass encodes the whole input so that the packets add up to
+// target_bytes. The first pass builds a complexity profile, the second
+// hands every frame a share of the remaining budget proportional to its
+// first pass size, correcting for what the previous frames actually used.
+// No frame gets more than twice its share of the whole budget, so bytes
+// left over by frames that could not use them are not dumped on the last
+// frames. Only the Opus packets are counted, not any container overhead.
+func (st *OpusEncoder) EncodeTwoPass(pcm []int16, pcm_offset, frame_size, target_bytes int) ([][]byte, error) {
+	if err := st.twopass_check(pcm, pcm_offset, frame_size); err != nil {
+		return nil, err
+	}
+	frames := twopass_frame_count(pcm, pcm_offset, frame_size, st.channels)
+	if target_bytes < 2*frames {
+		return nil, bad_arg("target_bytes", "Target size is too small")
+	}
+	frame_rate := st.Fs / frame_size
+	profile, err := st.AnalyzeTwoPass(pcm, pcm_offset, frame_size, target_bytes*8*frame_rate/frames)
+	if err != nil {
+		return nil, err
+	}
+	return st.EncodeWithProfile(pcm, pcm_offset, profile, target_bytes)
+}
+
+// EncodeTwoPassBitrate is EncodeTwoPass with the budget given as an average
+// bitrate over the duration of the input.
+func (st *OpusEncoder) EncodeTwoPassBitrate(pcm []int16, pcm_offset, frame_size, bitrate int) ([][]byte, error) {
+	if err := st.twopass_check(pcm, pcm_offset, frame_size); err != nil {
+		return nil, err
+	}
+	frames := twopass_frame_count(pcm, pcm_offset, frame_size, st.channels)
+	target_bytes := int(int64(bitrate) * int64(frames*frame_size) / int64(8*st.Fs))
+	return st.EncodeTwoPass(pcm, pcm_offset, frame_size, target_bytes)
+}
+
+// EncodeWithProfile runs the second pass using a profile from AnalyzeTwoPass.
+func (st *OpusEncoder) EncodeWithProfile(pcm []int16, pcm_offset int, profile *OpusTwoPassProfile, target_bytes int) ([][]byte, error) {
+	frame_size := profile.FrameSize
+	if err := st.twopass_check(pcm, pcm_offset, frame_size); err != nil {
+		return nil, err
+	}
+	frames := twopass_frame_count(pcm, pcm_offset, frame_size, st.channels)
+	if frames != len(profile.FrameBytes) {
+		return nil, bad_arg("profile", "Profile does not match the input")
+	}
+	frame_rate := st.Fs / frame_size
+	/* Enough for SILK to code a frame at its lowest rate */
+	min_bytes := IMAX(2, 6000/(8*frame_rate))
+	if target_bytes < min_bytes*frames {
+		return nil, bad_arg("target_bytes", "Target size is too small")
+	}
+
+	saved_bitrate, saved_vbr, saved_constraint := st.user_bitrate_bps, st.use_vbr, st.vbr_constraint
+	defer func() {
+		st.user_bitrate_bps = saved_bitrate
+		st.SetUseVBR(saved_vbr != 0)
+		st.vbr_constraint = saved_constraint
+	}()
+	st.ResetState()
+	st.SetUseVBR(true)
+	st.SetUseConstrainedVBR(true)
+
+	remaining_weight := int64(0)
+	for _, b := range profile.FrameBytes {
+		remaining_weight += int64(IMAX(b, 1))
+	}
+	total_weight := remaining_weight
+	remaining_bytes := target_bytes
+	/* How much of what it is given the encoder spends, starting from what
+	   the first pass spent of its rate */
+	allocated, used := int64(0), int64(0)
+	if profile.Bitrate > 0 {
+		allocated = int64(profile.Bitrate) * int64(frames) / int64(8*frame_rate)
+		used = int64(profile.TotalBytes())
+	}
+	packets := make([][]byte, frames)
+	pad := make([]int16, frame_size*st.channels)
+	packet := make([]byte, 1275*3+7)
+	for i := 0; i < frames; i++ {
+		weight := int64(IMAX(profile.FrameBytes[i], 1))
+		alloc := int(int64(remaining_bytes) * weight / remaining_weight)
+		remaining_weight -= weight
+
+		/* No frame takes more than twice its share of the whole budget, so
+		   what earlier frames left over cannot pile up on the last ones */
+		frame_cap := IMAX(min_bytes, int(2*int64(target_bytes)*weight/total_weight))
+
+		/* Never take bytes the frames after this one need to stay decodable */
+		max_bytes := remaining_bytes - (frames-i-1)*min_bytes
+		max_bytes = IMIN(max_bytes, IMIN(frame_cap, len(packet)))
+		alloc = IMAX(min_bytes, IMIN(alloc, max_bytes))
+
+		/* Scale the rate asked for by how much of its allocations the
+		   encoder has been spending, within a factor of two */
+		rate := int64(alloc)
+		if used > 0 && allocated > 0 {
+			rate = int64(silk_LIMIT(int(rate*allocated/used), int(rate/2), int(2*rate)))
+		}
+		st.SetBitrate(IMAX(500, int(rate)*8*frame_rate))
+
+		in, in_ptr := twopass_frame(pcm, pcm_offset, frame_size, st.channels, i, pad)
+		ret := st.opus_encode_native(in, in_ptr, frame_size, packet, 0, max_bytes, 16, in, in_ptr, frame_size, 0, -2, st.channels, 0)
+		if ret < 0 {
+			return nil, encode_error(ret, max_bytes)
+		}
+		packets[i] = append([]byte(nil), packet[:ret]...)
+		remaining_bytes -= ret
+		allocated += int64(alloc)
+		used += int64(ret)
+	}
+	return packets, nil
+}
@@ -0,0 +1,134 @@
+package opus
+
+import (
+	"encoding/binary"
+	"math"
+	"os"
+	"testing"
+)
+
+// The first seconds of the music clip the test program encodes.
+func testMusicClip(tb testing.TB, seconds int) []int16 {
+	data, err := os.ReadFile("../test/48Khz Stereo.raw")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	pcm := make([]int16, IMIN(len(data)/2, seconds*48000*2))
+	for i := range pcm {
+		pcm[i] = int16(binary.LittleEndian.Uint16(data[2*i:]))
+	}
+	return pcm
+}
+
+// A voiced/unvoiced alternation below 4 kHz, so that a wideband SILK encode
+// keeps all of it.
+func testSpeechSignal(channels, samples, rate int) []int16 {
+	out := make([]int16, samples*channels)
+	phase, lp := 0.0, 0.0
+	seed := uint32(1)
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(rate)
+		f0 := 140 + 40*math.Sin(2*math.Pi*0.7*t)
+		phase += 2 * math.Pi * f0 / float64(rate)
+		voiced := 0.0
+		for h := 1; float64(h)*f0 < 3800; h++ {
+			voiced += math.Sin(float64(h)*phase) / float64(h)
+		}
+		seed = seed*1664525 + 1013904223
+		lp = 0.7*lp + 0.3*float64(int32(seed)>>16)/32768
+		s := 6000*math.Max(0, math.Sin(2*math.Pi*3*t))*voiced + 3000*math.Max(0, -math.Sin(2*math.Pi*3*t))*lp
+		for c := 0; c < channels; c++ {
+			out[i*channels+c] = int16(s * (1 - 0.2*float64(c)))
+		}
+	}
+	return out
+}
+
+func TestTwoPassTargetSize(t *testing.T) {
+	signals := []struct {
+		name     string
+		pcm      []int16
+		channels int
+		app      OpusApplication
+		mode     int
+		bitrates []int
+	}{
+		{"mono", testSpeechSignal(1, 6*48000, 48000), 1, OPUS_APPLICATION_VOIP, MODE_SILK_ONLY, []int{12000, 20000, 32000}},
+		{"stereo", testSpeechSignal(2, 6*48000, 48000), 2, OPUS_APPLICATION_AUDIO, MODE_SILK_ONLY, []int{12000, 20000, 32000}},
+		{"music", testMusicClip(t, 6), 2, OPUS_APPLICATION_AUDIO, MODE_AUTO, []int{12000, 24000, 48000, 96000}},
+		{"speech", testSpeechSignal(1, 6*48000, 48000), 1, OPUS_APPLICATION_VOIP, MODE_AUTO, []int{12000, 24000, 48000, 96000}},
+	}
+	for _, s := range signals {
+		for _, bitrate := range s.bitrates {
+			enc, err := NewOpusEncoder(48000, s.channels, s.app)
+			if err != nil {
+				t.Fatal(err)
+			}
+			enc.SetForceMode(s.mode)
+			if s.mode == MODE_SILK_ONLY {
+				enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+			}
+			seconds := len(s.pcm) / s.channels / 48000
+			target := bitrate * seconds / 8
+			packets, err := enc.EncodeTwoPass(s.pcm, 0, 960, target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			total := 0
+			for _, p := range packets {
+				total += len(p)
+			}
+			/* Frames are capped at twice their share, so what the encoder
+			   cannot spend on easy content is left unused */
+			if total < target*95/100 || total > target*101/100 {
+				t.Fatalf("%s at %d b/s: %d bytes, want %d, at most 1%% over or 5%% under", s.name, bitrate, total, target)
+			}
+
+			/* The packets decode to the whole input */
+			dec, err := NewOpusDecoder(48000, s.channels)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := make([]int16, 960*s.channels)
+			for i, p := range packets {
+				if n, err := dec.Decode(p, 0, len(p), out, 0, 960, false); err != nil || n != 960 {
+					t.Fatalf("%s at %d b/s, packet %d: decoded %d samples, %v", s.name, bitrate, i, n, err)
+				}
+			}
+		}
+	}
+}
+
+func TestTwoPassTailFrames(t *testing.T) {
+	/* Five seconds of silence, then one of music */
+	music := testMusicClip(t, 1)
+	pcm := make([]int16, 5*48000*2+len(music))
+	copy(pcm[5*48000*2:], music)
+	enc, err := NewOpusEncoder(48000, 2, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	/* A flat profile hands the silent frames bytes they cannot use, which
+	   must not all go to the music at the end */
+	frames := len(pcm) / 2 / 960
+	profile := &OpusTwoPassProfile{FrameSize: 960, Bitrate: 64000, FrameBytes: make([]int, frames)}
+	for i := range profile.FrameBytes {
+		profile.FrameBytes[i] = 160
+	}
+	target := 160 * frames
+	packets, err := enc.EncodeWithProfile(pcm, 0, profile, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0
+	for i, p := range packets {
+		if len(p) > 2*160 {
+			t.Fatalf("packet %d: %d bytes, more than twice its share of 160", i, len(p))
+		}
+		total += len(p)
+	}
+	if total > target {
+		t.Fatalf("%d bytes, want at most %d", total, target)
+	}
+}
@@ -0,0 +1,261 @@
+package opus
+
+/* Structural validation of packets, following the framing rules of
+   RFC 6716 section 3 and the self-delimiting framing of its appendix B,
+   without touching the entropy coded payload */
+
+// PacketFault tells why a packet failed validation.
+type PacketFault int
+
+const (
+	FaultNone           PacketFault = iota
+	FaultEmpty                      // no TOC byte
+	FaultTruncated                  // a length, the frame count or a frame runs past the end
+	FaultFrameCount                 // a code 3 packet with no frames
+	FaultDuration                   // more than 120 ms of audio
+	FaultPadding                    // padding longer than the rest of the packet
+	FaultCBRLength                  // CBR frames that cannot share the payload equally
+	FaultFrameLength                // a frame longer than 1275 bytes
+	FaultStreamDuration             // streams of a multistream packet of different durations
+)
+
+func (f PacketFault) String() string {
+	switch f {
+	case FaultNone:
+		return "no fault"
+	case FaultEmpty:
+		return "empty packet"
+	case FaultTruncated:
+		return "truncated packet"
+	case FaultFrameCount:
+		return "packet without frames"
+	case FaultDuration:
+		return "packet longer than 120 ms"
+	case FaultPadding:
+		return "padding past the end of the packet"
+	case FaultCBRLength:
+		return "CBR frames of unequal lengths"
+	case FaultFrameLength:
+		return "frame longer than 1275 bytes"
+	case FaultStreamDuration:
+		return "streams of different durations"
+	}
+	return "unknown fault"
+}
+
+func packet_fault(fault PacketFault, offset int) *OpusException {
+	e := OpusException2(fault.String(), OpusError.OPUS_INVALID_PACKET).at(offset)
+	e.Fault = fault
+	return e
+}
+
+// Walks the framing of one packet, or one self-delimited packet at the
+// start of data, and returns the number of bytes it takes and its duration
+// at 48 kHz. Fault offsets are from data_ptr.
+func validate_packet(data []byte, data_ptr int, len int, self_delimited bool) (int, int, *OpusException) {
+	if len < 1 {
+		return 0, 0, packet_fault(FaultEmpty, 0)
+	}
+	toc := data[data_ptr]
+	framesize := GetNumSamplesPerFrame(data, data_ptr, 48000)
+	pos := 1
+	end := len
+	header := 0 /* the byte that sets the frame layout */
+	pad := 0
+	count := 0
+	cbr := false
+	var sizes [48]int
+
+	read_size := func() (int, *OpusException) {
+		if pos >= end {
+			return 0, packet_fault(FaultTruncated, pos)
+		}
+		b := int(data[data_ptr+pos])
+		if b < 252 {
+			pos++
+			return b, nil
+		}
+		if pos+1 >= end {
+			return 0, packet_fault(FaultTruncated, pos)
+		}
+		pos += 2
+		return b + 4*int(data[data_ptr+pos-1]), nil
+	}
+
+	switch toc & 0x3 {
+	case 0:
+		count = 1
+	case 1:
+		count = 2
+		cbr = true
+	case 2:
+		count = 2
+		size, err := read_size()
+		if err != nil {
+			return 0, 0, err
+		}
+		sizes[0] = size
+	default:
+		if pos >= end {
+			return 0, 0, packet_fault(FaultTruncated, pos)
+		}
+		header = pos
+		ch := int(data[data_ptr+pos])
+		pos++
+		count = ch & 0x3F
+		if count == 0 {
+			return 0, 0, packet_fault(FaultFrameCount, header)
+		}
+		if framesize*count > 5760 {
+			return 0, 0, packet_fault(FaultDuration, header)
+		}
+		if ch&0x40 != 0 {
+			start := pos
+			for {
+				if pos >= end {
+					return 0, 0, packet_fault(FaultTruncated, pos)
+				}
+				p := int(data[data_ptr+pos])
+				pos++
+				if p != 255 {
+					pad += p
+					break
+				}
+				pad += 254
+			}
+			if pad > end-pos {
+				return 0, 0, packet_fault(FaultPadding, start)
+			}
+			end -= pad
+		}
+		cbr = ch&0x80 == 0
+		if !cbr {
+			for i := 0; i < count-1; i++ {
+				size, err := read_size()
+				if err != nil {
+					return 0, 0, err
+				}
+				sizes[i] = size
+			}
+		}
+	}
+
+	if self_delimited {
+		at := pos
+		size, err := read_size()
+		if err != nil {
+			return 0, 0, err
+		}
+		if size > 1275 {
+			return 0, 0, packet_fault(FaultFrameLength, at)
+		}
+		sizes[count-1] = size
+		if cbr {
+			for i := 0; i < count-1; i++ {
+				sizes[i] = size
+			}
+		}
+	} else if cbr {
+		if (end-pos)%count != 0 {
+			return 0, 0, packet_fault(FaultCBRLength, header)
+		}
+		for i := 0; i < count; i++ {
+			sizes[i] = (end - pos) / count
+		}
+	} else {
+		last := end - pos
+		for i := 0; i < count-1; i++ {
+			last -= sizes[i]
+		}
+		if last < 0 {
+			/* Found below, at the first frame that does not fit */
+			last = 0
+		}
+		sizes[count-1] = last
+	}
+
+	for i := 0; i < count; i++ {
+		if sizes[i] > 1275 {
+			return 0, 0, packet_fault(FaultFrameLength, pos)
+		}
+		if sizes[i] > end-pos {
+			return 0, 0, packet_fault(FaultTruncated, pos)
+		}
+		pos += sizes[i]
+	}
+	if !self_delimited {
+		return len, count * framesize, nil
+	}
+	return pos + pad, count * framesize, nil
+}
+
+// Points e at the fault the framing checks find in a packet, leaving the
+// offset unknown when there is none.
+func (e *OpusException) at_fault(data []byte, data_ptr int, _len int) *OpusException {
+	if data == nil || data_ptr < 0 || _len < 0 || data_ptr+_len > len(data) {
+		return e
+	}
+	if _, _, fault := validate_packet(data, data_ptr, _len, false); fault != nil {
+		e.Offset = fault.Offset
+		e.Fault = fault.Fault
+	}
+	return e
+}
+
+// ValidatePacket checks the framing of a packet without decoding it: the
+// frame count, the 120 ms limit, the padding and the frame lengths. It
+// returns the duration of the packet in samples at 48 kHz. A malformed
+// packet gives an *OpusException matching ErrInvalidPacket, whose Fault
+// tells what is wrong and whose Offset points at the offending byte.
+func ValidatePacket(packet []byte, packet_offset int, _len int) (int, error) {
+	if packet_offset < 0 || _len < 0 || packet_offset+_len > len(packet) {
+		return 0, bad_arg("_len", "Packet length is out of range")
+	}
+	_, samples, err := validate_packet(packet, packet_offset, _len, false)
+	if err != nil {
+		return 0, err
+	}
+	return samples, nil
+}
+
+// ValidateSelfDelimitedPacket checks a packet in the self-delimiting
+// framing of RFC 6716 appendix B, which may be followed by more data. It
+// returns the number of bytes the packet takes and its duration at 48 kHz.
+func ValidateSelfDelimitedPacket(packet []byte, packet_offset int, _len int) (int, int, error) {
+	if packet_offset < 0 || _len < 0 || packet_offset+_len > len(packet) {
+		return 0, 0, bad_arg("_len", "Packet length is out of range")
+	}
+	n, samples, err := validate_packet(packet, packet_offset, _len, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	return n, samples, nil
+}
+
+// ValidateMultistreamPacket checks a multistream packet of the given number
+// of streams: every stream but the last is self-delimited, and all must have
+// the same duration, which it returns in samples at 48 kHz. Faults carry the
+// stream they were found in, and an offset from the start of the packet.
+func ValidateMultistreamPacket(packet []byte, packet_offset int, _len int, streams int) (int, error) {
+	if packet_offset < 0 || _len < 0 || packet_offset+_len > len(packet) {
+		return 0, bad_arg("_len", "Packet length is out of range")
+	}
+	if streams < 1 || streams > 255 {
+		return 0, bad_arg("streams", "Invalid number of streams")
+	}
+	pos := 0
+	duration := 0
+	for s := 0; s < streams; s++ {
+		last := s == streams-1
+		n, samples, err := validate_packet(packet, packet_offset+pos, _len-pos, !last)
+		if err != nil {
+			return 0, err.at(err.Offset + pos).in_stream(s)
+		}
+		if s > 0 && samples != duration {
+			return 0, packet_fault(FaultStreamDuration, pos).in_stream(s)
+		}
+		duration = samples
+		pos += n
+	}
+	return duration, nil
+}
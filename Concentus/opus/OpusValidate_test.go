@@ -0,0 +1,116 @@
+package opus
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestValidatePacket(t *testing.T) {
+	packets := testSilkPackets(t, testSpeechSignal(1, 960*3, 48000), 1, 24000)
+	padded := []byte{0x4B, 0xC2, 0x02, 0x01, 0xAA, 0xBB, 0xCC, 0x00, 0x00}
+	long := make([]byte, 1277)
+	long[0] = 0x48
+
+	for _, tc := range []struct {
+		name    string
+		packet  []byte
+		samples int
+		fault   PacketFault
+		offset  int
+	}{
+		{"encoded", packets[2], 960, FaultNone, 0},
+		{"VBR with padding", padded, 1920, FaultNone, 0},
+		{"DTX", []byte{0x48}, 960, FaultNone, 0},
+		{"empty", []byte{}, 0, FaultEmpty, 0},
+		{"odd CBR pair", []byte{0x49, 1, 2, 3}, 0, FaultCBRLength, 0},
+		{"no frame count", []byte{0x4B}, 0, FaultTruncated, 1},
+		{"no frames", []byte{0x4B, 0x00}, 0, FaultFrameCount, 1},
+		{"140 ms", []byte{0x4B, 0x07}, 0, FaultDuration, 1},
+		{"padding too long", []byte{0x4B, 0x41, 0x10, 1}, 0, FaultPadding, 2},
+		{"padding length cut", []byte{0x4B, 0x41, 0xFF}, 0, FaultTruncated, 3},
+		{"uneven CBR", []byte{0x4B, 0x03, 1, 2, 3, 4}, 0, FaultCBRLength, 1},
+		{"VBR size too long", []byte{0x4A, 10, 1, 2}, 0, FaultTruncated, 2},
+		{"VBR size cut", []byte{0x4A, 0xFC}, 0, FaultTruncated, 1},
+		{"frame too long", long, 0, FaultFrameLength, 1},
+	} {
+		samples, err := ValidatePacket(tc.packet, 0, len(tc.packet))
+		if tc.fault == FaultNone {
+			if err != nil || samples != tc.samples {
+				t.Errorf("%s: %d samples, %v", tc.name, samples, err)
+			}
+			continue
+		}
+		var oe *OpusException
+		if !errors.Is(err, ErrInvalidPacket) || !errors.As(err, &oe) || oe.Fault != tc.fault || oe.Offset != tc.offset {
+			t.Errorf("%s: got %v, want %v at %d", tc.name, err, tc.fault, tc.offset)
+		}
+	}
+
+	if _, err := ValidatePacket(packets[0], 1, len(packets[0])); !errors.Is(err, ErrBadArg) {
+		t.Errorf("out of range: got %v", err)
+	}
+}
+
+func TestValidatePacketMatchesParser(t *testing.T) {
+	/* Any packet the parser of the decoder accepts is valid, and no other */
+	rng := rand.New(rand.NewSource(1))
+	toc := BoxedValueByte{0}
+	size := make([]int16, 48)
+	payload_offset := BoxedValueInt{0}
+	packet_offset := BoxedValueInt{0}
+	for i := 0; i < 20000; i++ {
+		packet := make([]byte, rng.Intn(40))
+		rng.Read(packet)
+		if len(packet) > 0 && rng.Intn(2) == 0 {
+			packet[0] |= 0x3 /* code 3 is where the trouble is */
+		}
+		if len(packet) > 1 && rng.Intn(2) == 0 {
+			packet[1] &= 0xC7 /* at most 7 frames */
+		}
+		_, err := ValidatePacket(packet, 0, len(packet))
+		ret := OpusError.OPUS_INVALID_PACKET
+		if len(packet) > 0 {
+			ret = opus_packet_parse_impl(packet, 0, len(packet), 0, &toc, nil, 0, size, 0, &payload_offset, &packet_offset)
+		}
+		if (err == nil) != (ret >= 0) {
+			t.Fatalf("% x: validator says %v, parser %d", packet, err, ret)
+		}
+	}
+}
+
+func TestValidateMultistreamPacket(t *testing.T) {
+	packets := testSilkPackets(t, testSpeechSignal(1, 960*3, 48000), 1, 24000)
+	a, b := packets[1], packets[2]
+	/* Stream a, made self-delimited by coding the length of its frame */
+	delimited := append([]byte{a[0], byte(len(a) - 1)}, a[1:]...)
+	n, samples, err := ValidateSelfDelimitedPacket(append(delimited, b...), 0, len(delimited)+len(b))
+	if err != nil || n != len(delimited) || samples != 960 {
+		t.Fatalf("self-delimited: %d bytes, %d samples, %v", n, samples, err)
+	}
+
+	packet := append(append([]byte(nil), delimited...), b...)
+	if samples, err := ValidateMultistreamPacket(packet, 0, len(packet), 2); err != nil || samples != 960 {
+		t.Fatalf("multistream: %d samples, %v", samples, err)
+	}
+
+	var oe *OpusException
+	for _, tc := range []struct {
+		name    string
+		packet  []byte
+		streams int
+		fault   PacketFault
+		stream  int
+		offset  int
+	}{
+		{"missing stream", append(append([]byte(nil), delimited...), delimited...), 3, FaultEmpty, 2, 2 * len(delimited)},
+		{"40 ms stream", append(append([]byte(nil), delimited...), b[0]|0x1, 0xAA, 0xBB), 2, FaultStreamDuration, 1, len(delimited)},
+		{"cut stream", append(append([]byte(nil), delimited...), 0x4A, 0x10, 0xAA), 2, FaultTruncated, 1, len(delimited) + 2},
+		{"overlong first stream", delimited[:len(delimited)-1], 2, FaultTruncated, 0, 2},
+	} {
+		_, err := ValidateMultistreamPacket(tc.packet, 0, len(tc.packet), tc.streams)
+		if !errors.As(err, &oe) || oe.Fault != tc.fault || oe.Stream != tc.stream || oe.Offset != tc.offset {
+			t.Errorf("%s: got %v, want %v in stream %d at %d", tc.name, err, tc.fault, tc.stream, tc.offset)
+		}
+	}
+}
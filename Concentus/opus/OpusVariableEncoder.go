@@ -0,0 +1,271 @@
+package opus
+
+/* Dynamic frame sizing. Input is buffered so that each decision sees 60 ms
+   ahead. The transient Viterbi search of optimize_framesize picks a frame of
+   2.5 to 20 ms that ends before the next onset; 20 ms frames are stretched to
+   40 or 60 ms when the energy stays flat over that span, more readily for
+   tonal input, and runs of short frames are joined into packets of up to
+   20 ms with the repacketizer. */
+
+const (
+	variable_flat_metric = 1.5 // energy spread below which a long frame is used
+	variable_group_ms    = 20  // longest packet made of short frames
+)
+
+// OpusVariablePacket is a packet produced by an OpusVariableEncoder.
+type OpusVariablePacket struct {
+	Data      []byte
+	Timestamp int64 // first input sample coded in the packet, per channel
+	Duration  int   // samples per channel
+	Padding   int   // silence added by Flush at the end of the last packet
+}
+
+// OpusVariableEncoder runs an OpusEncoder in the OPUS_FRAMESIZE_VARIABLE
+// mode: it buffers the input and picks the duration of each packet, from 2.5
+// to 60 ms, from transient and tonality analysis. This suits offline and
+// music encoding, at the cost of 60 ms of extra latency. Packets keep the
+// encoder's lookahead, so decoded audio lags the timestamps by GetLookahead
+// samples as with fixed frames.
+type OpusVariableEncoder struct {
+	enc          *OpusEncoder
+	pcm          []int16 // buffered input, interleaved
+	pos          int64   // timestamp of pcm[0]
+	max_duration int
+	subframe_mem [3]float32
+	group        *OpusRepacketizer
+	group_frames [][]byte
+	group_pos    int64
+	group_size   int // frame size of the packets in the group
+	out          []byte
+}
+
+// NewOpusVariableEncoder takes over enc, switching it to
+// OPUS_FRAMESIZE_VARIABLE with analysis enabled. enc must not be used
+// directly while the variable encoder is in use.
+func NewOpusVariableEncoder(enc *OpusEncoder) *OpusVariableEncoder {
+	enc.SetExpertFrameDuration(OPUS_FRAMESIZE_VARIABLE)
+	enc.SetEnableAnalysis(true)
+	return &OpusVariableEncoder{
+		enc:          enc,
+		max_duration: 3 * enc.Fs / 50,
+		group:        NewOpusRepacketizer(),
+		out:          make([]byte, 1275),
+	}
+}
+
+func (st *OpusVariableEncoder) GetEncoder() *OpusEncoder {
+	return st.enc
+}
+
+// SetMaxDuration caps the duration of the packets, which must be one of
+// OPUS_FRAMESIZE_2_5_MS to OPUS_FRAMESIZE_60_MS. Frames never get shorter
+// than the forced mode allows: 10 ms for SILK and hybrid.
+func (st *OpusVariableEncoder) SetMaxDuration(value OpusFramesize) error {
+	size := frame_size_select(3*st.enc.Fs/50, value, st.enc.Fs)
+	if value == OPUS_FRAMESIZE_ARG || size < 0 {
+		return bad_arg("value", "Invalid maximum packet duration")
+	}
+	st.max_duration = size
+	return nil
+}
+
+func (st *OpusVariableEncoder) GetMaxDuration() int {
+	return st.max_duration
+}
+
+// Write buffers frame_size samples per channel of interleaved input and
+// returns the packets that could be completed, in order.
+func (st *OpusVariableEncoder) Write(pcm []int16, pcm_offset, frame_size int) ([]OpusVariablePacket, error) {
+	if frame_size < 0 || pcm_offset+frame_size*st.enc.channels > len(pcm) {
+		return nil, bad_arg("pcm", "Not enough samples provided in input signal")
+	}
+	if st.enc.preprocessor != nil {
+		st.pcm = append(st.pcm, st.enc.preprocessor.process(pcm, pcm_offset, frame_size)...)
+	} else {
+		st.pcm = append(st.pcm, pcm[pcm_offset:pcm_offset+frame_size*st.enc.channels]...)
+	}
+	var packets []OpusVariablePacket
+	for len(st.pcm)/st.enc.channels >= st.window() {
+		var err error
+		if packets, err = st.encode(packets, st.select_frame(st.window()), 0); err != nil {
+			return packets, err
+		}
+	}
+	return packets, nil
+}
+
+// Flush encodes the buffered input, padding the last frame with silence,
+// and returns the remaining packets.
+func (st *OpusVariableEncoder) Flush() ([]OpusVariablePacket, error) {
+	var packets []OpusVariablePacket
+	for len(st.pcm) > 0 {
+		avail := len(st.pcm) / st.enc.channels
+		frame := st.select_frame(avail)
+		padding := 0
+		if frame > avail {
+			padding = frame - avail
+			st.pcm = append(st.pcm, make([]int16, padding*st.enc.channels)...)
+		}
+		var err error
+		if packets, err = st.encode(packets, frame, padding); err != nil {
+			return packets, err
+		}
+	}
+	return st.flush_group(packets)
+}
+
+// Input each decision looks at, per channel.
+func (st *OpusVariableEncoder) window() int {
+	return 3*st.enc.Fs/50 + st.enc.Fs/400
+}
+
+// Shortest frame the coding mode allows.
+func (st *OpusVariableEncoder) min_frame() int {
+	if st.enc.user_forced_mode == MODE_SILK_ONLY || st.enc.user_forced_mode == MODE_HYBRID {
+		return st.enc.Fs / 100
+	}
+	return st.enc.Fs / 400
+}
+
+// Tonality of the latest analysis frame, 0 when there is none.
+func (st *OpusVariableEncoder) tonality() float32 {
+	tonal := &st.enc.analysis
+	if !tonal.enabled || tonal.count == 0 {
+		return 0
+	}
+	info := tonal.info[(tonal.write_pos+DETECT_SIZE-1)%DETECT_SIZE]
+	if info.valid == 0 {
+		return 0
+	}
+	return info.tonality
+}
+
+// Picks the size of the next frame from the avail samples buffered. The
+// result may exceed avail only when flushing.
+func (st *OpusVariableEncoder) select_frame(avail int) int {
+	Fs := st.enc.Fs
+	C := st.enc.channels
+	subframe := Fs / 400
+	min_frame := st.min_frame()
+	max_frame := IMAX(st.max_duration, min_frame)
+	if avail < 2*subframe {
+		return min_frame
+	}
+
+	buffering := st.enc.delay_compensation
+	if st.enc.application == OPUS_APPLICATION_RESTRICTED_LOWDELAY {
+		buffering = 0
+	}
+	tonality := st.tonality()
+	bitrate := st.enc.user_bitrate_to_bitrate(Fs/50, 1276)
+	LM := optimize_framesize(st.pcm, 0, avail, C, Fs, bitrate, tonality, st.subframe_mem[:], buffering)
+	frame := IMAX(subframe<<LM, min_frame)
+
+	/* A 20 ms frame can grow while the energy stays flat */
+	if frame == Fs/50 {
+		limit := variable_flat_metric * (1 + tonality)
+		for _, long := range []int{3 * Fs / 50, Fs / 25} {
+			if long <= max_frame && long <= avail && energy_spread(st.pcm, long, C, subframe) < limit {
+				frame = long
+				break
+			}
+		}
+	}
+	for frame > max_frame || (frame > avail && frame > min_frame) {
+		if frame > Fs/50 {
+			frame = Fs / 50
+		} else {
+			frame = IMAX(frame>>1, min_frame)
+		}
+	}
+	return frame
+}
+
+// Ratio of the arithmetic to the harmonic mean of the energy of the 2.5 ms
+// subframes in the first len samples of x, 1 for a steady signal.
+func energy_spread(x []int16, len int, C int, subframe int) float32 {
+	N := len / subframe
+	sub := make([]int, subframe)
+	var sumE, sumE_1 float32
+	mem := 0
+	for i := 0; i < N; i++ {
+		downmix_int(x, 0, sub, 0, subframe, i*subframe, 0, -2, C)
+		if i == 0 {
+			mem = sub[0]
+		}
+		/* Quiet subframes count as flat rather than as onsets */
+		tmp := float32(subframe)
+		for j := 0; j < subframe; j++ {
+			diff := float32(sub[j] - mem)
+			tmp += diff * diff
+			mem = sub[j]
+		}
+		sumE += tmp
+		sumE_1 += 1 / tmp
+	}
+	return sumE * sumE_1 / float32(N*N)
+}
+
+// Codes the next frame, of which padding samples are silence, and adds the
+// packets it completes.
+func (st *OpusVariableEncoder) encode(packets []OpusVariablePacket, frame, padding int) ([]OpusVariablePacket, error) {
+	C := st.enc.channels
+	ret, err := st.enc.encode_frame(st.pcm, 0, frame, len(st.pcm)/C, st.out, 0, len(st.out))
+	if err != nil {
+		return packets, err
+	}
+	data := append([]byte(nil), st.out[:ret]...)
+	pos := st.pos
+	st.pcm = st.pcm[:copy(st.pcm, st.pcm[frame*C:])]
+	st.pos += int64(frame - padding)
+
+	Fs := st.enc.Fs
+	group_max := IMIN(variable_group_ms*Fs/1000, st.max_duration)
+	if frame*2 > group_max || padding > 0 {
+		if packets, err = st.flush_group(packets); err != nil {
+			return packets, err
+		}
+		return append(packets, OpusVariablePacket{Data: data, Timestamp: pos, Duration: frame, Padding: padding}), nil
+	}
+
+	/* Join short frames of the same size and configuration */
+	if st.group_size != frame || (len(st.group_frames)+1)*frame > group_max || st.group.AddPacket(data, 0, len(data)) != nil {
+		if packets, err = st.flush_group(packets); err != nil {
+			return packets, err
+		}
+		if err = st.group.AddPacket(data, 0, len(data)); err != nil {
+			return packets, err
+		}
+		st.group_pos = pos
+		st.group_size = frame
+	}
+	st.group_frames = append(st.group_frames, data)
+	return packets, nil
+}
+
+// Emits the pending group of short frames as one packet.
+func (st *OpusVariableEncoder) flush_group(packets []OpusVariablePacket) ([]OpusVariablePacket, error) {
+	if len(st.group_frames) == 0 {
+		return packets, nil
+	}
+	var data []byte
+	if len(st.group_frames) == 1 {
+		data = st.group_frames[0]
+	} else {
+		total := 0
+		for _, frame := range st.group_frames {
+			total += len(frame)
+		}
+		buf := make([]byte, total+2*len(st.group_frames)+2)
+		n, err := st.group.CreatePacket(buf, 0, len(buf))
+		if err != nil {
+			return packets, err
+		}
+		data = buf[:n]
+	}
+	packets = append(packets, OpusVariablePacket{Data: data, Timestamp: st.group_pos, Duration: len(st.group_frames) * st.group_size})
+	st.group.Reset()
+	st.group_frames = st.group_frames[:0]
+	st.group_size = 0
+	return packets, nil
+}
@@ -0,0 +1,175 @@
+package opus
+
+import (
+	"concentus/quality"
+	"math"
+	"testing"
+)
+
+// Decaying harmonic notes, a new one every 250 ms.
+func testMusicSignal(samples, rate int) []int16 {
+	notes := []float64{220, 277.2, 329.6, 440, 392, 293.7}
+	out := make([]int16, samples)
+	for i := range out {
+		t := float64(i) / float64(rate)
+		n := int(t / 0.25)
+		f := notes[n%len(notes)]
+		v := 0.0
+		for h := 1; h <= 8 && float64(h)*f < 7000; h++ {
+			v += math.Sin(2*math.Pi*f*float64(h)*t) / float64(h*h)
+		}
+		out[i] = int16(8000 * v * math.Exp(-3*(t-float64(n)*0.25)))
+	}
+	return out
+}
+
+func testVariablePackets(tb testing.TB, pcm []int16, bitrate, chunk int) []OpusVariablePacket {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(bitrate)
+	venc := NewOpusVariableEncoder(enc)
+	var packets []OpusVariablePacket
+	for off := 0; off < len(pcm); off += chunk {
+		p, err := venc.Write(pcm, off, IMIN(chunk, len(pcm)-off))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		packets = append(packets, p...)
+	}
+	p, err := venc.Flush()
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return append(packets, p...)
+}
+
+func TestVariableEncoderTimestamps(t *testing.T) {
+	pcm := append(testSpeechSignal(1, 48000, 48000), testMusicSignal(48000+123, 48000)...)
+	/* Clicks every 30 ms in the last half second call for short frames */
+	for i := len(pcm) - 24000; i < len(pcm); i++ {
+		if i%1440 < 48 {
+			pcm[i] = 20000
+		}
+	}
+	packets := testVariablePackets(t, pcm, 64000, 700)
+
+	next := int64(0)
+	durations := map[int]int{}
+	multi := 0
+	for i, p := range packets {
+		if p.Timestamp != next {
+			t.Fatalf("packet %d at %d, want %d", i, p.Timestamp, next)
+		}
+		if n := GetNumSamples(p.Data, 0, len(p.Data), 48000); n != p.Duration {
+			t.Fatalf("packet %d lasts %d, reported %d", i, n, p.Duration)
+		}
+		if p.Padding != 0 && i != len(packets)-1 {
+			t.Fatalf("packet %d padded", i)
+		}
+		if GetNumFrames(p.Data, 0, len(p.Data)) > 1 {
+			multi++
+		}
+		durations[p.Duration]++
+		next += int64(p.Duration - p.Padding)
+	}
+	if next != int64(len(pcm)) {
+		t.Fatalf("packets cover %d samples, want %d", next, len(pcm))
+	}
+	t.Logf("durations %v, %d multi-frame packets", durations, multi)
+	if len(durations) < 2 || multi == 0 {
+		t.Fatalf("durations %v with %d multi-frame packets", durations, multi)
+	}
+
+	dec, _ := NewOpusDecoder(48000, 1)
+	out := make([]int16, 5760)
+	for i, p := range packets {
+		if n, err := dec.Decode(p.Data, 0, len(p.Data), out, 0, len(out), false); err != nil || n != p.Duration {
+			t.Fatalf("packet %d: decoded %d samples, %v", i, n, err)
+		}
+	}
+}
+
+func TestVariableEncoderMaxDuration(t *testing.T) {
+	enc, _ := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	enc.SetForceMode(MODE_SILK_ONLY)
+	venc := NewOpusVariableEncoder(enc)
+	if err := venc.SetMaxDuration(OPUS_FRAMESIZE_ARG); err == nil {
+		t.Fatal("OPUS_FRAMESIZE_ARG accepted")
+	}
+	if err := venc.SetMaxDuration(OPUS_FRAMESIZE_20_MS); err != nil || venc.GetMaxDuration() != 960 {
+		t.Fatalf("max duration %d, %v", venc.GetMaxDuration(), err)
+	}
+	pcm := testMusicSignal(48000, 48000)
+	packets, _ := venc.Write(pcm, 0, len(pcm))
+	tail, _ := venc.Flush()
+	for _, p := range append(packets, tail...) {
+		if p.Duration > 960 {
+			t.Fatalf("%d samples in a packet", p.Duration)
+		}
+	}
+}
+
+// Quality against fixed 20 ms frames at the same bitrate, measured with
+// quality.Perceptual. Long frames save the TOC and part of the side
+// information of stationary passages, so the variable mode should spend
+// fewer bytes for the same score.
+func TestVariableEncoderQuality(t *testing.T) {
+	signals := []struct {
+		name string
+		pcm  []int16
+	}{
+		{"speech", testSpeechSignal(1, 2*48000, 48000)},
+		{"music", testMusicSignal(2*48000, 48000)},
+	}
+	for _, s := range signals {
+		for _, bitrate := range []int{16000, 24000} {
+			fixed := testSilkPackets(t, s.pcm, 1, bitrate)
+			fixedBytes := 0
+			for _, p := range fixed {
+				fixedBytes += len(p)
+			}
+			fixedScore, err := quality.Perceptual(s.pcm, testDecodePackets(t, fixed, 1, 48000), 1, 48000)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			variable := testVariablePackets(t, s.pcm, bitrate, 960)
+			dec, _ := NewOpusDecoder(48000, 1)
+			var deg []int16
+			out := make([]int16, 5760)
+			variableBytes := 0
+			long := 0
+			for _, p := range variable {
+				if p.Duration > 960 {
+					long++
+				}
+				n, err := dec.Decode(p.Data, 0, len(p.Data), out, 0, len(out), false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				deg = append(deg, out[:n]...)
+				variableBytes += len(p.Data)
+			}
+			variableScore, err := quality.Perceptual(s.pcm, deg[:len(s.pcm)], 1, 48000)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			t.Logf("%s at %d bit/s: fixed %d bytes MOS %.3f, variable %d bytes MOS %.3f",
+				s.name, bitrate, fixedBytes, fixedScore.MOS, variableBytes, variableScore.MOS)
+			if long == 0 {
+				t.Errorf("%s at %d bit/s: no long packets", s.name, bitrate)
+			}
+			if variableBytes >= fixedBytes {
+				t.Errorf("%s at %d bit/s: variable frames took %d bytes, fixed %d", s.name, bitrate, variableBytes, fixedBytes)
+			}
+			if variableScore.MOS < fixedScore.MOS-0.05 {
+				t.Errorf("%s at %d bit/s: MOS %.3f, fixed %.3f", s.name, bitrate, variableScore.MOS, fixedScore.MOS)
+			}
+		}
+	}
+}
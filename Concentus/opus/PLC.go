@@ -70,13 +70,13 @@ func silk_PLC_update(psDec *SilkChannelDecoder, psDecCtrl *SilkDecoderControl) {
 			tmp := silk_LSHIFT(V_PITCH_GAIN_START_MIN_Q14, 10)
 			scale_Q10 := silk_DIV32(tmp, silk_max_32(LTP_Gain_Q14, 1))
 			for i := 0; i < LTP_ORDER; i++ {
-				psPLC.LTPCoef_Q14[i] = int16(silk_RSHIFT(silk_SMULWW(int(psPLC.LTPCoef_Q14[i]), scale_Q10), 10))
+				psPLC.LTPCoef_Q14[i] = int16(silk_RSHIFT(silk_SMULBB(int(psPLC.LTPCoef_Q14[i]), scale_Q10), 10))
 			}
 		} else if LTP_Gain_Q14 > V_PITCH_GAIN_START_MAX_Q14 {
 			tmp := silk_LSHIFT(V_PITCH_GAIN_START_MAX_Q14, 14)
 			scale_Q14 := silk_DIV32(tmp, silk_max_32(LTP_Gain_Q14, 1))
 			for i := 0; i < LTP_ORDER; i++ {
-				psPLC.LTPCoef_Q14[i] = int16(silk_RSHIFT(silk_SMULWW(int(psPLC.LTPCoef_Q14[i]), scale_Q14), 14))
+				psPLC.LTPCoef_Q14[i] = int16(silk_RSHIFT(silk_SMULBB(int(psPLC.LTPCoef_Q14[i]), scale_Q14), 14))
 			}
 		}
 	} else {
@@ -159,7 +159,7 @@ func silk_PLC_conceal(psDec *SilkChannelDecoder, psDecCtrl *SilkDecoderControl,
 			}
 			rand_scale_Q14 -= int16(sum)
 			rand_scale_Q14 = silk_max_16(3277, rand_scale_Q14)
-			rand_scale_Q14 = int16(silk_RSHIFT(silk_SMULWW(int(rand_scale_Q14), int(psPLC.prevLTP_scale_Q14)), 14))
+			rand_scale_Q14 = int16(silk_RSHIFT(silk_SMULBB(int(rand_scale_Q14), int(psPLC.prevLTP_scale_Q14)), 14))
 		} else {
 			invGain_Q30 := silk_LPC_inverse_pred_gain(psPLC.prevLPC_Q12[:], psDec.LPC_order)
 			down_scale_Q30 := silk_min_32(silk_RSHIFT(1<<30, LOG2_INV_LPC_GAIN_HIGH_THRES), invGain_Q30)
@@ -176,7 +176,7 @@ func silk_PLC_conceal(psDec *SilkChannelDecoder, psDecCtrl *SilkDecoderControl,
 	idx := psDec.ltp_mem_length - lag - psDec.LPC_order - LTP_ORDER/2
 	silk_LPC_analysis_filter(sLTP[:], idx, psDec.outBuf, idx, psPLC.prevLPC_Q12[:], 0, psDec.ltp_mem_length-idx, psDec.LPC_order)
 	inv_gain_Q30 := silk_INVERSE32_varQ(psPLC.prevGain_Q16[1], 46)
-	inv_gain_Q30 = silk_min_32(inv_gain_Q30, 0x7FFFFFFF)
+	inv_gain_Q30 = silk_min_32(inv_gain_Q30, 0x7FFFFFFF>>1)
 	for i := idx + psDec.LPC_order; i < psDec.ltp_mem_length; i++ {
 		sLTP_Q14[i] = silk_SMULWB(inv_gain_Q30, int(sLTP[i]))
 	}
@@ -199,9 +199,9 @@ func silk_PLC_conceal(psDec *SilkChannelDecoder, psDecCtrl *SilkDecoderControl,
 		}
 
 		for j := 0; j < LTP_ORDER; j++ {
-			B_Q14[j] = int16(silk_RSHIFT(silk_SMULWW(int(B_Q14[j]), harm_Gain_Q15), 15))
+			B_Q14[j] = int16(silk_RSHIFT(silk_SMULBB(harm_Gain_Q15, int(B_Q14[j])), 15))
 		}
-		rand_scale_Q14 = int16(silk_RSHIFT(silk_SMULWW(int(rand_scale_Q14), rand_Gain_Q15), 15))
+		rand_scale_Q14 = int16(silk_RSHIFT(silk_SMULBB(int(rand_scale_Q14), rand_Gain_Q15), 15))
 		psPLC.pitchL_Q8 = silk_SMLAWB(psPLC.pitchL_Q8, psPLC.pitchL_Q8, PITCH_DRIFT_FAC_Q16)
 		psPLC.pitchL_Q8 = silk_min_32(psPLC.pitchL_Q8, silk_LSHIFT(int(silk_SMULBB(MAX_PITCH_LAG_MS, int(psDec.fs_kHz))), 8))
 		lag = int(silk_RSHIFT_ROUND(psPLC.pitchL_Q8, 8))
@@ -262,6 +262,9 @@ func silk_PLC_glue_frames(psDec *SilkChannelDecoder, frame []int16, frame_ptr, l
 			}
 
 			if energy.Val > psPLC.conc_energy {
+				LZ := silk_CLZ32(psPLC.conc_energy) - 1
+				psPLC.conc_energy = silk_LSHIFT(psPLC.conc_energy, LZ)
+				energy.Val = silk_RSHIFT(energy.Val, silk_max_32(24-LZ, 0))
 				frac_Q24 := silk_DIV32(psPLC.conc_energy, silk_max_32(energy.Val, 1))
 				gain_Q16 := silk_LSHIFT(silk_SQRT_APPROX(frac_Q24), 4)
 				slope_Q16 := silk_DIV32_16((1<<16)-gain_Q16, int(length))
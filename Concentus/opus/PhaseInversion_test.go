@@ -0,0 +1,194 @@
+package opus
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Codes anti-phase stereo bands as intensity stereo with quant_all_bands and
+// decodes them back, returning the packet and the decoded channels.
+func testIntensityBands(encodeInv, decodeInv int) ([]byte, []int, []int) {
+	m := mode48000_960_120
+	LM := 3
+	M := 1 << LM
+	N := M * int(m.eBands[m.nbEBands])
+	X := make([]int, N)
+	Y := make([]int, N)
+	bandE := InitTwoDimensionalArrayInt(2, m.nbEBands)
+	for i := 0; i < m.nbEBands; i++ {
+		lo, hi := M*int(m.eBands[i]), M*int(m.eBands[i+1])
+		norm := 0.0
+		for j := lo; j < hi; j++ {
+			norm += math.Pow(math.Sin(float64(j)*1.7), 2)
+		}
+		for j := lo; j < hi; j++ {
+			X[j] = int(16384 * math.Sin(float64(j)*1.7) / math.Sqrt(norm))
+			Y[j] = -X[j]
+		}
+		bandE[0][i] = 1 << 14
+		bandE[1][i] = 1 << 14
+	}
+	pulses := make([]int, m.nbEBands)
+	for i := range pulses {
+		pulses[i] = M * int(m.eBands[i+1]-m.eBands[i]) << BITRES
+	}
+	tf_res := make([]int, m.nbEBands)
+	buf := make([]byte, 400)
+	total := len(buf) * (8 << BITRES)
+
+	enc := NewEntropyCoder()
+	enc.enc_init(buf, 0, len(buf))
+	seed := &BoxedValueInt{0}
+	quant_all_bands(1, m, 0, m.nbEBands, X, Y, make([]int16, 2*m.nbEBands), bandE, pulses, 0, Spread.SPREAD_NORMAL,
+		0, 0, tf_res, total, 0, enc, LM, m.nbEBands, seed, encodeInv, m.nbEBands)
+	enc.enc_done()
+
+	dec := NewEntropyCoder()
+	dec.dec_init(buf, 0, len(buf))
+	X = make([]int, N)
+	Y = make([]int, N)
+	seed.Val = 0
+	quant_all_bands(0, m, 0, m.nbEBands, X, Y, make([]int16, 2*m.nbEBands), nil, pulses, 0, Spread.SPREAD_NORMAL,
+		0, 0, tf_res, total, 0, dec, LM, m.nbEBands, seed, decodeInv, m.nbEBands)
+	return buf, X, Y
+}
+
+// Correlation of the two channels over the coded spectrum.
+func testStereoCorrelation(X, Y []int) float64 {
+	var xy, xx, yy float64
+	for j := range X {
+		xy += float64(X[j]) * float64(Y[j])
+		xx += float64(X[j]) * float64(X[j])
+		yy += float64(Y[j]) * float64(Y[j])
+	}
+	return xy / math.Sqrt(xx*yy+1)
+}
+
+func TestPhaseInversionBands(t *testing.T) {
+	packet, X, Y := testIntensityBands(0, 0)
+	/* The default bitstream, as coded before the flag existed */
+	if h := fmt.Sprintf("%x", sha256.Sum256(packet)); h != "7a3410f252259ff3ac784a3d7e766f5d0f2539eebeda51d6974389015a069d83" {
+		t.Fatalf("default bitstream changed: %s", h)
+	}
+	if c := testStereoCorrelation(X, Y); c > -0.9 {
+		t.Fatalf("anti-phase input decoded with correlation %.3f", c)
+	}
+
+	/* A decoder with inversion disabled reads the same flags but keeps the
+	   channels in phase */
+	_, X, Y = testIntensityBands(0, 1)
+	if c := testStereoCorrelation(X, Y); c < 0.9 {
+		t.Fatalf("inversion applied by decoder: correlation %.3f", c)
+	}
+
+	/* An encoder with inversion disabled still codes the flag, as zero */
+	disabled, X, Y := testIntensityBands(1, 0)
+	if len(disabled) != len(packet) {
+		t.Fatal("packet size changed")
+	}
+	if c := testStereoCorrelation(X, Y); c < 0.9 {
+		t.Fatalf("inversion coded by encoder: correlation %.3f", c)
+	}
+}
+
+func TestPhaseInversionDefaults(t *testing.T) {
+	mono, _ := NewOpusDecoder(48000, 1)
+	stereo, _ := NewOpusDecoder(48000, 2)
+	if !mono.GetPhaseInversionDisabled() || stereo.GetPhaseInversionDisabled() {
+		t.Fatal("a mono decoder has nothing to downmix and should default to disabled inversion")
+	}
+	if stereo.GetMonoDownmix() {
+		t.Fatal("mono downmix on by default")
+	}
+	enc, _ := NewOpusEncoder(48000, 2, OPUS_APPLICATION_AUDIO)
+	if enc.GetPhaseInversionDisabled() {
+		t.Fatal("encoder disables inversion by default")
+	}
+	enc.SetPhaseInversionDisabled(true)
+	if !enc.GetPhaseInversionDisabled() || enc.Celt_Encoder.disable_inv != 1 {
+		t.Fatal("encoder setting not applied to CELT")
+	}
+
+	/* The downmix keeps inversion off in CELT until both are cleared */
+	stereo.SetMonoDownmix(true)
+	if stereo.GetPhaseInversionDisabled() || stereo.Celt_Decoder.disable_inv != 1 {
+		t.Fatal("downmix did not disable inversion in CELT")
+	}
+	stereo.SetPhaseInversionDisabled(true)
+	stereo.SetMonoDownmix(false)
+	if stereo.Celt_Decoder.disable_inv != 1 {
+		t.Fatal("inversion re-enabled while still disabled")
+	}
+	stereo.SetPhaseInversionDisabled(false)
+	if stereo.Celt_Decoder.disable_inv != 0 {
+		t.Fatal("inversion still disabled")
+	}
+
+	msdec, err := OpusMSDecoder_create(48000, 3, 2, 1, []int16{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msdec.SetMonoDownmix(true)
+	msdec.SetPhaseInversionDisabled(true)
+	for i, dec := range msdec.decoders {
+		if !dec.GetMonoDownmix() || !dec.GetPhaseInversionDisabled() {
+			t.Fatalf("stream %d not configured", i)
+		}
+	}
+	msenc, _ := CreateOpusMSEncoder(48000, 3, 2, 1, []int16{0, 1, 2}, OPUS_APPLICATION_AUDIO)
+	msenc.SetPhaseInversionDisabled(true)
+	for i := 0; i < 2; i++ {
+		stream, _ := msenc.GetMultistreamEncoderState(i)
+		if !stream.GetPhaseInversionDisabled() {
+			t.Fatalf("encoder stream %d not configured", i)
+		}
+	}
+}
+
+func TestMonoDownmix(t *testing.T) {
+	pcm := testSpeechSignal(2, 48000, 48000)
+	/* Pull the channels apart so that the downmix has something to do */
+	for i := 0; i < len(pcm); i += 2 {
+		pcm[i+1] = pcm[i+1] / 4
+	}
+	packets := testSilkPackets(t, pcm, 2, 32000)
+
+	dec, _ := NewOpusDecoder(48000, 2)
+	dec.SetMonoDownmix(true)
+	ref, _ := NewOpusDecoder(48000, 2)
+	out := make([]int16, 2*960)
+	stereo := make([]int16, 2*960)
+	split := 0
+	for i, p := range packets {
+		if i == len(packets)/2 {
+			dec.ResetState()
+			ref.ResetState()
+			if !dec.GetMonoDownmix() {
+				t.Fatal("downmix lost on reset")
+			}
+		}
+		n, err := dec.Decode(p, 0, len(p), out, 0, 960, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = ref.Decode(p, 0, len(p), stereo, 0, 960, false); err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j < n; j++ {
+			if out[2*j] != out[2*j+1] {
+				t.Fatalf("packet %d sample %d: %d and %d", i, j, out[2*j], out[2*j+1])
+			}
+			if mid := (int(stereo[2*j]) + int(stereo[2*j+1])) / 2; abs(mid-int(out[2*j])) > 1 {
+				t.Fatalf("packet %d sample %d: %d, average %d", i, j, out[2*j], mid)
+			}
+			if stereo[2*j] != stereo[2*j+1] {
+				split++
+			}
+		}
+	}
+	if split == 0 {
+		t.Fatal("reference decoder output is already mono")
+	}
+}
@@ -202,6 +202,7 @@ func pitch_search(x_lp []int, x_lp_ptr int, y []int, len int, max_pitch int, pit
 var second_check = []int{0, 0, 3, 2, 3, 2, 5, 2, 3, 2, 3, 2, 5, 2, 3, 2}
 
 func remove_doubling(x []int, maxperiod int, minperiod int, N int, T0_ *BoxedValueInt, prev_period int, prev_gain int) int {
+	minperiod0 := minperiod
 	maxperiod /= 2
 	minperiod /= 2
 	T0_.Val /= 2
@@ -222,8 +223,10 @@ func remove_doubling(x []int, maxperiod int, minperiod int, N int, T0_ *BoxedVal
 	boxed_xy2 := BoxedValueInt{0}
 
 	dual_inner_prod(x, x_ptr, x, x_ptr, x, x_ptr-T0, N, &boxed_xx, &boxed_xy)
-	yy_lookup[0] = boxed_xx.Val
-	yy := boxed_xx.Val
+	xx = boxed_xx.Val
+	xy = boxed_xy.Val
+	yy_lookup[0] = xx
+	yy := xx
 	for i := 1; i <= maxperiod; i++ {
 		xi := x_ptr - i
 		yy = yy + MULT16_16(x[xi], x[xi]) - MULT16_16(x[xi+N], x[xi+N])
@@ -310,8 +313,8 @@ func remove_doubling(x []int, maxperiod int, minperiod int, N int, T0_ *BoxedVal
 	}
 
 	T0_.Val = 2*T + offset
-	if T0_.Val < minperiod*2 {
-		T0_.Val = minperiod * 2
+	if T0_.Val < minperiod0 {
+		T0_.Val = minperiod0
 	}
 	return pg
 }
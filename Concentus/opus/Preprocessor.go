@@ -0,0 +1,62 @@
+package opus
+
+// Preprocessor is a stage the input of an encoder goes through before it is
+// encoded, such as the noise suppressor of package denoise. It works on
+// whole frames of interleaved samples and may delay its output.
+type Preprocessor interface {
+	// SampleRate and Channels return the input format it takes.
+	SampleRate() int
+	Channels() int
+	// FrameSize returns how many samples per channel Process takes.
+	FrameSize() int
+	// Delay returns how many samples per channel the output lags the input.
+	Delay() int
+	// Process transforms one frame of interleaved samples in place.
+	Process(pcm []int16)
+	// Reset clears the history, as at the start of a new stream.
+	Reset()
+}
+
+// Buffers the input of an encoder into the frames of its Preprocessor.
+type preprocess_stage struct {
+	pre Preprocessor
+	in  []int16 // input waiting for a complete frame, interleaved
+}
+
+func new_preprocess_stage(pre Preprocessor, Fs int, channels int) (*preprocess_stage, error) {
+	if pre.SampleRate() != Fs || pre.Channels() != channels {
+		return nil, bad_arg("pre", "Preprocessor format does not match the encoder")
+	}
+	if pre.FrameSize() <= 0 {
+		return nil, bad_arg("pre", "Invalid preprocessor frame size")
+	}
+	return &preprocess_stage{pre: pre}, nil
+}
+
+func (ps *preprocess_stage) reset() {
+	ps.pre.Reset()
+	ps.in = ps.in[:0]
+}
+
+// Encoders that take a frame at a time need frames of a multiple of the
+// preprocessor's, so that every call gets as many samples as it gave.
+func (ps *preprocess_stage) check(frame_size int) error {
+	if frame_size <= 0 || frame_size%ps.pre.FrameSize() != 0 {
+		return bad_arg("frame_size", "Frame size is not a multiple of the preprocessor's")
+	}
+	return nil
+}
+
+// Takes frame_size samples per channel and returns the processed output of
+// the frames they complete.
+func (ps *preprocess_stage) process(pcm []int16, pcm_ptr int, frame_size int) []int16 {
+	step := ps.pre.FrameSize() * ps.pre.Channels()
+	ps.in = append(ps.in, pcm[pcm_ptr:pcm_ptr+frame_size*ps.pre.Channels()]...)
+	out := make([]int16, len(ps.in)/step*step)
+	copy(out, ps.in)
+	for base := 0; base < len(out); base += step {
+		ps.pre.Process(out[base : base+step])
+	}
+	ps.in = ps.in[:copy(ps.in, ps.in[len(out):])]
+	return out
+}
@@ -0,0 +1,92 @@
+package opus
+
+import "testing"
+
+// Halves its input and delays it by one frame of 10 ms.
+type testHalver struct {
+	channels int
+	prev     []int16
+}
+
+func (h *testHalver) SampleRate() int { return 48000 }
+func (h *testHalver) Channels() int   { return h.channels }
+func (h *testHalver) FrameSize() int  { return 480 }
+func (h *testHalver) Delay() int      { return 480 }
+func (h *testHalver) Reset()          { clear(h.prev) }
+
+func (h *testHalver) Process(pcm []int16) {
+	if h.prev == nil {
+		h.prev = make([]int16, len(pcm))
+	}
+	for i, v := range pcm {
+		pcm[i], h.prev[i] = h.prev[i], v/2
+	}
+}
+
+func TestPreprocessorEncoder(t *testing.T) {
+	enc, _ := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	lookahead := enc.GetLookahead()
+	if enc.GetPreprocessor() != nil {
+		t.Fatal("preprocessing on by default")
+	}
+	if err := enc.SetPreprocessor(&testHalver{channels: 2}); err == nil {
+		t.Fatal("stereo preprocessor accepted by a mono encoder")
+	}
+	pre := &testHalver{channels: 1}
+	if err := enc.SetPreprocessor(pre); err != nil {
+		t.Fatal(err)
+	}
+	if enc.GetPreprocessor() != pre || enc.GetLookahead() != lookahead+480 {
+		t.Fatalf("lookahead %d, want %d", enc.GetLookahead(), lookahead+480)
+	}
+	buf := make([]byte, 1275)
+	if _, err := enc.Encode(make([]int16, 240), 0, 240, buf, 0, len(buf)); err == nil {
+		t.Fatal("5 ms frame accepted")
+	}
+	enc.SetPreprocessor(nil)
+	if enc.GetLookahead() != lookahead {
+		t.Fatal("lookahead not restored")
+	}
+	narrow, _ := NewOpusEncoder(16000, 1, OPUS_APPLICATION_VOIP)
+	if err := narrow.SetPreprocessor(&testHalver{channels: 1}); err == nil {
+		t.Fatal("16 kHz encoder accepted a 48 kHz preprocessor")
+	}
+
+	/* Encoding through the preprocessor is encoding its output */
+	pcm := testSpeechSignal(1, 48000, 48000)
+	want := make([]int16, len(pcm))
+	for i := 480; i < len(pcm); i++ {
+		want[i] = pcm[i-480] / 2
+	}
+	plain := testSilkPackets(t, want, 1, 24000)
+	processed, _ := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	processed.SetForceMode(MODE_SILK_ONLY)
+	processed.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	processed.SetBitrate(24000)
+	processed.SetPreprocessor(&testHalver{channels: 1})
+	for f, packet := range plain {
+		n, err := processed.Encode(pcm, f*960, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf[:n]) != string(packet) {
+			t.Fatalf("frame %d differs", f)
+		}
+	}
+
+	ms, _ := CreateOpusMSEncoder(48000, 3, 2, 1, []int16{0, 1, 2}, OPUS_APPLICATION_VOIP)
+	msLookahead := ms.GetLookahead()
+	if err := ms.SetPreprocessor(&testHalver{channels: 2}); err == nil {
+		t.Fatal("stereo preprocessor accepted for 3 channels")
+	}
+	msPre := &testHalver{channels: 3}
+	if err := ms.SetPreprocessor(msPre); err != nil {
+		t.Fatal(err)
+	}
+	if ms.GetPreprocessor() != msPre || ms.GetLookahead() != msLookahead+480 {
+		t.Fatal("multistream encoder not configured")
+	}
+	if _, err := ms.EncodeMultistream(make([]int16, 3*240), 0, 240, buf, 0, len(buf)); err == nil {
+		t.Fatal("5 ms multistream frame accepted")
+	}
+}
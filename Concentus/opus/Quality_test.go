@@ -0,0 +1,120 @@
+package opus
+
+import (
+	"concentus/quality"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// White noise of the given RMS.
+func testNoise(samples int, rms float64, seed int64) []float64 {
+	rng := rand.New(rand.NewSource(seed))
+	out := make([]float64, samples)
+	for i := range out {
+		out[i] = rms * rng.NormFloat64()
+	}
+	return out
+}
+
+// Energy in dB of x over [from, to).
+func testEnergy(x []int16, from, to int) float64 {
+	e := 1e-9
+	for _, v := range x[from:to] {
+		e += float64(v) * float64(v)
+	}
+	return 10 * math.Log10(e/float64(to-from))
+}
+
+// SNR in dB of x against ref over [from, to).
+func testSNR(ref, x []int16, from, to int) float64 {
+	s, n := 1e-9, 1e-9
+	for i := from; i < to; i++ {
+		d := float64(x[i]) - float64(ref[i])
+		s += float64(ref[i]) * float64(ref[i])
+		n += d * d
+	}
+	return 10 * math.Log10(s/n)
+}
+
+func testSilkPackets(tb testing.TB, pcm []int16, channels, bitrate int) [][]byte {
+	enc, err := NewOpusEncoder(48000, channels, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(bitrate)
+	var packets [][]byte
+	buf := make([]byte, 1275)
+	for off := 0; off+960*channels <= len(pcm); off += 960 * channels {
+		n, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:n]...))
+	}
+	return packets
+}
+
+func testDecodePackets(tb testing.TB, packets [][]byte, channels, rate int) []int16 {
+	dec, err := NewOpusDecoder(rate, channels)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var out []int16
+	buf := make([]int16, rate/50*channels)
+	for _, packet := range packets {
+		n, err := dec.Decode(packet, 0, len(packet), buf, 0, rate/50, false)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		out = append(out, buf[:n*channels]...)
+	}
+	return out
+}
+
+// Decoding at a lower rate must stay within the opus_compare tolerance of the
+// 48 kHz decode of the same stream, as checked by the reference test vectors.
+func TestOpusCompareDecodeRates(t *testing.T) {
+	for _, channels := range []int{1, 2} {
+		packets := testSilkPackets(t, testSpeechSignal(channels, 2*48000, 48000), channels, 24000)
+		ref := testDecodePackets(t, packets, channels, 48000)
+		for _, rate := range []int{8000, 12000, 16000, 24000} {
+			q, err := quality.OpusCompare(ref, testDecodePackets(t, packets, channels, rate), channels, rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q < 0 {
+				t.Errorf("%d channels at %d Hz: quality %.1f is below the pass threshold", channels, rate, q)
+			}
+		}
+	}
+}
+
+// Quality floors for wideband SILK. A change to the codec that drops below
+// them is a regression.
+func TestPerceptualQualitySilk(t *testing.T) {
+	floors := []struct {
+		bitrate int
+		mos     float64
+	}{
+		{8000, 2.4},
+		{16000, 3.0},
+		{32000, 3.6},
+	}
+	for _, channels := range []int{1, 2} {
+		ref := testSpeechSignal(channels, 2*48000, 48000)
+		for _, f := range floors {
+			deg := testDecodePackets(t, testSilkPackets(t, ref, channels, f.bitrate), channels, 48000)
+			score, err := quality.Perceptual(ref, deg, channels, 48000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Logf("%d channels at %d bit/s: %+v", channels, f.bitrate, score)
+			if score.MOS < f.mos {
+				t.Errorf("%d channels at %d bit/s: MOS %.2f is below %.1f", channels, f.bitrate, score.MOS, f.mos)
+			}
+		}
+	}
+}
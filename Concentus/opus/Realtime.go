@@ -0,0 +1,153 @@
+package opus
+
+import "time"
+
+/* Real-time complexity control. The time spent in each encode call is
+   compared with a per-frame budget: a frame over budget lowers the complexity
+   straight away, while raising it takes a run of frames well under budget. A
+   raise that is followed by an overrun soon after doubles the length of the
+   run needed for the next one, so that an encoder sitting on the edge of its
+   budget does not keep flipping between two settings. */
+
+const (
+	realtime_raise_ratio = 0.6 // fraction of the budget frames must stay under to raise
+	realtime_raise_after = 25  // frames under budget before the first raise
+	realtime_raise_max   = 800 // longest wait between raises
+	realtime_avg_shift   = 3   // smoothing of the average, 1/8 per frame
+)
+
+// RealtimeDecision is the action taken by the real-time controller after a
+// frame.
+type RealtimeDecision int
+
+const (
+	RealtimeHold  RealtimeDecision = iota // complexity left unchanged
+	RealtimeLower                         // frame over budget, complexity lowered
+	RealtimeRaise                         // enough headroom, complexity raised
+)
+
+func (d RealtimeDecision) String() string {
+	switch d {
+	case RealtimeHold:
+		return "hold"
+	case RealtimeLower:
+		return "lower"
+	case RealtimeRaise:
+		return "raise"
+	}
+	return "unknown"
+}
+
+// RealtimeState reports the decisions of the real-time controller of an
+// encoder.
+type RealtimeState struct {
+	Budget     time.Duration    // time allowed per frame, 0 when the mode is off
+	Complexity int              // complexity used for the next frame
+	Ceiling    int              // highest complexity the controller may pick
+	Last       time.Duration    // time spent encoding the last frame
+	Average    time.Duration    // smoothed time per frame
+	Decision   RealtimeDecision // action taken after the last frame
+	Frames     int              // frames encoded since the mode was enabled
+	Overruns   int              // frames over budget since the mode was enabled
+	RaiseAfter int              // frames under budget needed for the next raise
+}
+
+type realtime_controller struct {
+	budget      time.Duration
+	ceiling     int
+	clock       func() time.Time // time.Now unless replaced by tests
+	last        time.Duration
+	average     time.Duration
+	decision    RealtimeDecision
+	frames      int
+	overruns    int
+	under       int // consecutive frames under the raise threshold
+	raise_after int
+	since_raise int // frames since the last raise, -1 if none is pending
+}
+
+func (rc *realtime_controller) enable(budget time.Duration, ceiling int) {
+	clock := rc.clock
+	*rc = realtime_controller{
+		budget:      budget,
+		ceiling:     ceiling,
+		clock:       clock,
+		raise_after: realtime_raise_after,
+		since_raise: -1,
+	}
+}
+
+func (rc *realtime_controller) now() time.Time {
+	if rc.clock != nil {
+		return rc.clock()
+	}
+	return time.Now()
+}
+
+// Accounts for a frame that took elapsed to encode at the given complexity
+// and returns the complexity for the next one.
+func (rc *realtime_controller) update(elapsed time.Duration, complexity int) int {
+	rc.last = elapsed
+	if rc.frames == 0 {
+		rc.average = elapsed
+	} else {
+		rc.average += (elapsed - rc.average) >> realtime_avg_shift
+	}
+	rc.frames++
+	if rc.since_raise >= 0 {
+		rc.since_raise++
+		/* Settled at the raised complexity */
+		if rc.since_raise > rc.raise_after {
+			rc.raise_after = realtime_raise_after
+			rc.since_raise = -1
+		}
+	}
+	rc.decision = RealtimeHold
+
+	if elapsed > rc.budget {
+		rc.overruns++
+		rc.under = 0
+		/* The last raise was premature */
+		if rc.since_raise >= 0 {
+			rc.raise_after = IMIN(2*rc.raise_after, realtime_raise_max)
+		}
+		rc.since_raise = -1
+		if complexity == 0 {
+			return 0
+		}
+		rc.decision = RealtimeLower
+		/* Well over budget, drop faster */
+		if elapsed > rc.budget+rc.budget/2 {
+			return IMAX(0, complexity-2)
+		}
+		return complexity - 1
+	}
+
+	threshold := time.Duration(float64(rc.budget) * realtime_raise_ratio)
+	if elapsed >= threshold || rc.average >= threshold {
+		rc.under = 0
+		return complexity
+	}
+	rc.under++
+	if rc.under < rc.raise_after || complexity >= rc.ceiling {
+		return complexity
+	}
+	rc.under = 0
+	rc.since_raise = 0
+	rc.decision = RealtimeRaise
+	return complexity + 1
+}
+
+func (rc *realtime_controller) state(complexity int) RealtimeState {
+	return RealtimeState{
+		Budget:     rc.budget,
+		Complexity: complexity,
+		Ceiling:    rc.ceiling,
+		Last:       rc.last,
+		Average:    rc.average,
+		Decision:   rc.decision,
+		Frames:     rc.frames,
+		Overruns:   rc.overruns,
+		RaiseAfter: rc.raise_after,
+	}
+}
@@ -0,0 +1,216 @@
+package opus
+
+import (
+	"testing"
+	"time"
+)
+
+// A clock on which each encode call takes cost(complexity), read when the
+// call ends.
+type testRealtimeClock struct {
+	t     time.Time
+	calls int
+	cost  func(complexity int) time.Duration
+	get   func() int
+}
+
+func (c *testRealtimeClock) now() time.Time {
+	c.calls++
+	if c.calls%2 == 0 {
+		c.t = c.t.Add(c.cost(c.get()))
+	}
+	return c.t
+}
+
+func linearCost(per time.Duration) func(int) time.Duration {
+	return func(complexity int) time.Duration {
+		return time.Duration(complexity+1) * per
+	}
+}
+
+func newRealtimeEncoder(t *testing.T, clock *testRealtimeClock) *OpusEncoder {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetComplexity(10)
+	enc.realtime.clock = clock.now
+	clock.get = enc.GetComplexity
+	if err := enc.SetRealtimeBudget(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	return enc
+}
+
+func encodeRealtime(t *testing.T, enc *OpusEncoder, pcm []int16, frames int, each func(i int, s RealtimeState)) {
+	out := make([]byte, 1275)
+	for i := 0; i < frames; i++ {
+		off := (i * 960) % (len(pcm) - 960)
+		if _, err := enc.Encode(pcm, off, 960, out, 0, len(out)); err != nil {
+			t.Fatal(err)
+		}
+		if each != nil {
+			each(i, enc.GetRealtimeState())
+		}
+	}
+}
+
+func TestRealtimeLowersAndRaises(t *testing.T) {
+	pcm := testSpeechSignal(1, 960*50, 48000)
+	clock := &testRealtimeClock{cost: linearCost(2 * time.Millisecond)}
+	enc := newRealtimeEncoder(t, clock)
+
+	/* Under load only complexities up to 4 fit in 10 ms */
+	lowered := 0
+	encodeRealtime(t, enc, pcm, 20, func(i int, s RealtimeState) {
+		if s.Decision == RealtimeLower {
+			lowered++
+		}
+	})
+	s := enc.GetRealtimeState()
+	if s.Complexity != 4 || s.Ceiling != 10 || s.Decision != RealtimeHold {
+		t.Fatalf("under load: %+v", s)
+	}
+	if lowered < 3 || s.Overruns != lowered {
+		t.Fatalf("lowered %d times with %d overruns", lowered, s.Overruns)
+	}
+	if s.Last != 10*time.Millisecond {
+		t.Fatalf("last frame took %v", s.Last)
+	}
+
+	/* Load gone, every complexity fits in half the budget */
+	clock.cost = linearCost(400 * time.Microsecond)
+	raised := 0
+	encodeRealtime(t, enc, pcm, 300, func(i int, s RealtimeState) {
+		if s.Decision == RealtimeRaise {
+			raised++
+		}
+	})
+	s = enc.GetRealtimeState()
+	if s.Complexity != 10 || raised != 6 {
+		t.Fatalf("after load: raised %d times, %+v", raised, s)
+	}
+	if enc.GetComplexity() != 10 || enc.silk_mode.complexity != enc.Celt_Encoder.complexity {
+		t.Fatal("complexity not applied to both layers")
+	}
+}
+
+func TestRealtimeHysteresis(t *testing.T) {
+	pcm := testSpeechSignal(1, 960*50, 48000)
+
+	/* 5 fits with no room to raise: the controller must not hunt */
+	clock := &testRealtimeClock{cost: linearCost(1500 * time.Microsecond)}
+	enc := newRealtimeEncoder(t, clock)
+	encodeRealtime(t, enc, pcm, 200, func(i int, s RealtimeState) {
+		if i > 10 && s.Decision != RealtimeHold {
+			t.Fatalf("frame %d: %v at %+v", i, s.Decision, s)
+		}
+	})
+	if c := enc.GetComplexity(); c != 5 {
+		t.Fatalf("settled at %d", c)
+	}
+
+	/* 3 has headroom but 4 overruns: each retry waits twice as long */
+	clock = &testRealtimeClock{cost: func(c int) time.Duration {
+		if c >= 4 {
+			return 11 * time.Millisecond
+		}
+		return 4 * time.Millisecond
+	}}
+	enc = newRealtimeEncoder(t, clock)
+	var raises []int
+	encodeRealtime(t, enc, pcm, 1000, func(i int, s RealtimeState) {
+		if s.Decision == RealtimeRaise {
+			raises = append(raises, i)
+		}
+	})
+	if len(raises) < 3 {
+		t.Fatalf("raises at %v", raises)
+	}
+	for i := 2; i < len(raises); i++ {
+		if gap, prev := raises[i]-raises[i-1], raises[i-1]-raises[i-2]; gap < 2*prev-1 {
+			t.Fatalf("raises at %v do not back off", raises)
+		}
+	}
+	if s := enc.GetRealtimeState(); s.RaiseAfter <= realtime_raise_after || s.RaiseAfter > realtime_raise_max {
+		t.Fatalf("raise wait %d", s.RaiseAfter)
+	}
+}
+
+func TestRealtimeSettings(t *testing.T) {
+	pcm := testSpeechSignal(1, 960*50, 48000)
+	clock := &testRealtimeClock{cost: linearCost(2 * time.Millisecond)}
+	enc := newRealtimeEncoder(t, clock)
+	if err := enc.SetRealtimeBudget(-time.Millisecond); err == nil {
+		t.Fatal("negative budget accepted")
+	}
+	encodeRealtime(t, enc, pcm, 10, nil)
+
+	/* SetComplexity moves the ceiling */
+	enc.SetComplexity(2)
+	clock.cost = linearCost(100 * time.Microsecond)
+	encodeRealtime(t, enc, pcm, 200, nil)
+	if s := enc.GetRealtimeState(); s.Complexity != 2 || s.Ceiling != 2 {
+		t.Fatalf("ceiling not kept: %+v", s)
+	}
+
+	/* Changing the budget keeps the history */
+	frames := enc.GetRealtimeState().Frames
+	enc.SetRealtimeBudget(5 * time.Millisecond)
+	if s := enc.GetRealtimeState(); s.Frames != frames || s.Budget != 5*time.Millisecond {
+		t.Fatalf("after new budget: %+v", s)
+	}
+
+	/* Disabling restores the ceiling */
+	enc.SetComplexity(8)
+	clock.cost = linearCost(2 * time.Millisecond)
+	encodeRealtime(t, enc, pcm, 10, nil)
+	if enc.GetComplexity() == 8 {
+		t.Fatal("complexity not lowered")
+	}
+	enc.SetRealtimeBudget(0)
+	if s := enc.GetRealtimeState(); enc.GetComplexity() != 8 || s.Budget != 0 || s.Frames != 0 {
+		t.Fatalf("after disabling: complexity %d, %+v", enc.GetComplexity(), s)
+	}
+	calls := clock.calls
+	encodeRealtime(t, enc, pcm, 10, nil)
+	if clock.calls != calls || enc.GetComplexity() != 8 {
+		t.Fatal("disabled controller still running")
+	}
+}
+
+func TestRealtimeMultistream(t *testing.T) {
+	pcm := testSpeechSignal(3, 960*50, 48000)
+	enc, err := CreateOpusMSEncoder(48000, 3, 2, 1, []int16{0, 1, 2}, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetComplexity(10)
+	clock := &testRealtimeClock{cost: linearCost(2 * time.Millisecond), get: enc.GetComplexity}
+	enc.realtime.clock = clock.now
+	enc.SetRealtimeBudget(10 * time.Millisecond)
+
+	out := make([]byte, 4000)
+	for i := 0; i < 20; i++ {
+		if _, err := enc.EncodeMultistream(pcm, i*960*3, 960, out, 0, len(out)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := enc.GetRealtimeState(); s.Complexity != 4 || s.Ceiling != 10 {
+		t.Fatalf("under load: %+v", s)
+	}
+	for i := 0; i < 2; i++ {
+		stream, _ := enc.GetMultistreamEncoderState(i)
+		if stream.GetComplexity() != 4 || stream.GetRealtimeBudget() != 0 {
+			t.Fatalf("stream %d: complexity %d", i, stream.GetComplexity())
+		}
+	}
+	enc.SetRealtimeBudget(0)
+	if enc.GetComplexity() != 10 {
+		t.Fatalf("complexity %d after disabling", enc.GetComplexity())
+	}
+}
@@ -0,0 +1,93 @@
+package opus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// A copy of silk/testdata/shell_blocks.txt in the parent module: the blocks
+// drawn by its test, with the packet its ShellEncoder codes them into.
+const testShellBlocksFile = "testdata/shell_blocks.txt"
+
+func TestShellCoderBlocksFixture(t *testing.T) {
+	testFixtureMatchesParent(t, testShellBlocksFile, "../../silk/testdata/shell_blocks.txt")
+}
+
+func testShellReadBlocks(t *testing.T) ([][]int, []byte) {
+	data, err := os.ReadFile(testShellBlocksFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks [][]int
+	var packet []byte
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		switch {
+		case len(f) == 0 || f[0] == "#":
+		case f[0] == "packet":
+			if packet, err = hex.DecodeString(f[1]); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			block := make([]int, len(f))
+			for i := range f {
+				if block[i], err = strconv.Atoi(f[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			blocks = append(blocks, block)
+		}
+	}
+	if len(blocks) == 0 {
+		t.Fatalf("%s: no blocks", testShellBlocksFile)
+	}
+	return blocks, packet
+}
+
+func testShellEncode(t *testing.T, blocks [][]int) []byte {
+	buf := make([]byte, 1<<16)
+	enc := NewEntropyCoder()
+	enc.enc_init(buf, 0, len(buf))
+	for _, block := range blocks {
+		silk_shell_encoder(enc, block, 0)
+	}
+	enc.enc_done()
+	if enc.get_error() != 0 {
+		t.Fatalf("encoder error %d", enc.get_error())
+	}
+	return buf[:(enc.tell()+7)/8]
+}
+
+// Codes the blocks into the same bytes as the parent module, and decodes
+// them back.
+func TestShellCoderBlocks(t *testing.T) {
+	blocks, want := testShellReadBlocks(t)
+	packet := testShellEncode(t, blocks)
+	if !bytes.Equal(packet, want) {
+		t.Fatalf("coded %x, want %x", packet, want)
+	}
+
+	dec := NewEntropyCoder()
+	dec.dec_init(packet, 0, len(packet))
+	out := make([]int16, SilkConstants.SHELL_CODEC_FRAME_LENGTH)
+	for b, block := range blocks {
+		/* The block sum is coded separately, ahead of the split */
+		sum := 0
+		for _, p := range block {
+			sum += p
+		}
+		silk_shell_decoder(out, 0, dec, sum)
+		for i := range block {
+			if int(out[i]) != block[i] {
+				t.Fatalf("block %d: decoded %v, want %v", b, out, block)
+			}
+		}
+	}
+	if dec.get_error() != 0 {
+		t.Fatalf("decoder error %d", dec.get_error())
+	}
+}
@@ -0,0 +1,105 @@
+package opus
+
+// SilkConfig holds expert settings of the SILK layer, on top of what the
+// encoder derives from its bitrate, bandwidth and frame size. Zero values
+// leave a setting to the encoder.
+type SilkConfig struct {
+	/* Internal sample rates, 8000, 12000 or 16000. They apply to SILK-only
+	   frames; hybrid frames always code SILK at 16 kHz. A minimum above the
+	   rate the bitrate calls for raises the maximum with it. */
+	MinInternalSampleRate     int
+	MaxInternalSampleRate     int
+	DesiredInternalSampleRate int
+
+	ReducedDependency bool // no prediction across packets, the same setting as SetPredictionDisabled
+	UseCBR            bool // constant bitrate SILK frames even when the encoder runs VBR
+	MaxBits           int  // cap on the SILK bits of a packet, below what the packet size allows
+
+	/* LBRR, the in-band FEC, is only sent with SetUseInbandFEC on and a
+	   packet loss percentage above 0 */
+	LBRRGainIncrease int // quantization steps by which LBRR frames are coarser than the main frames, 1 to 63
+	LBRRMinBitrate   int // SILK bitrate above which LBRR is sent, instead of a threshold by bandwidth
+}
+
+// SilkStatus describes the SILK layer of the last packet encoded.
+type SilkStatus struct {
+	InternalSampleRate     int  // 8000, 12000 or 16000, 0 when the packet has no SILK layer
+	BandwidthSwitchAllowed bool // the speech activity is low enough for SILK to change its internal rate
+}
+
+// Resolves the internal sample rates of the configuration against the ones
+// the encoder derived.
+func silk_config_rates(c *SilkConfig, min_rate, max_rate, desired_rate int) (int, int, int) {
+	if c.MaxInternalSampleRate != 0 {
+		max_rate = c.MaxInternalSampleRate
+		min_rate = IMIN(min_rate, max_rate)
+	}
+	if c.MinInternalSampleRate != 0 {
+		min_rate = c.MinInternalSampleRate
+		max_rate = IMAX(max_rate, min_rate)
+	}
+	if c.DesiredInternalSampleRate != 0 {
+		desired_rate = c.DesiredInternalSampleRate
+	}
+	desired_rate = IMIN(IMAX(desired_rate, min_rate), max_rate)
+	return min_rate, max_rate, desired_rate
+}
+
+func (st *OpusEncoder) apply_silk_rates() {
+	m := &st.silk_mode
+	m.minInternalSampleRate, m.maxInternalSampleRate, m.desiredInternalSampleRate =
+		silk_config_rates(&st.silk_config, m.minInternalSampleRate, m.maxInternalSampleRate, m.desiredInternalSampleRate)
+}
+
+// SetSilkConfig sets the expert SILK settings. The combination is checked
+// the way the SILK encoder checks its control input, so that rates out of
+// order, such as a minimum above the maximum, are rejected here rather than
+// at the next Encode call.
+func (st *OpusEncoder) SetSilkConfig(config SilkConfig) error {
+	if config.MaxBits < 0 {
+		return bad_arg("config", "MaxBits must be >= 0")
+	}
+	/* Check against the widest rates the encoder could derive */
+	check := st.silk_mode
+	check.minInternalSampleRate, check.maxInternalSampleRate, check.desiredInternalSampleRate = 8000, 16000, 16000
+	if config.MinInternalSampleRate != 0 {
+		check.minInternalSampleRate = config.MinInternalSampleRate
+	}
+	if config.MaxInternalSampleRate != 0 {
+		check.maxInternalSampleRate = config.MaxInternalSampleRate
+	}
+	if config.DesiredInternalSampleRate != 0 {
+		check.desiredInternalSampleRate = config.DesiredInternalSampleRate
+	} else {
+		check.desiredInternalSampleRate = IMIN(IMAX(16000, check.minInternalSampleRate), check.maxInternalSampleRate)
+	}
+	check.lbrrGainIncreases = config.LBRRGainIncrease
+	check.lbrrMinRate_bps = config.LBRRMinBitrate
+	switch check.check_control_input() {
+	case SilkError.SILK_NO_ERROR:
+	case SilkError.SILK_ENC_FS_NOT_SUPPORTED:
+		return bad_arg("config", "Internal sample rates must be 8000, 12000 or 16000, with min <= desired <= max")
+	case SilkError.SILK_ENC_INVALID_INBAND_FEC_SETTING:
+		return bad_arg("config", "LBRRGainIncrease must be within 0 to 63 and LBRRMinBitrate >= 0")
+	default:
+		return bad_arg("config", "Invalid SILK configuration")
+	}
+
+	st.silk_config = config
+	st.silk_mode.reducedDependency = boolToInt(config.ReducedDependency)
+	st.silk_mode.lbrrGainIncreases = config.LBRRGainIncrease
+	st.silk_mode.lbrrMinRate_bps = config.LBRRMinBitrate
+	return nil
+}
+
+func (st *OpusEncoder) GetSilkConfig() SilkConfig {
+	config := st.silk_config
+	config.ReducedDependency = st.silk_mode.reducedDependency != 0
+	return config
+}
+
+// GetSilkStatus reports the SILK layer of the last packet encoded, such as
+// the internal sample rate it ended up coded at.
+func (st *OpusEncoder) GetSilkStatus() SilkStatus {
+	return st.silk_status
+}
@@ -0,0 +1,204 @@
+package opus
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Encodes pcm in 20 ms SILK-only frames, calling check after each packet.
+func testSilkConfigPackets(t *testing.T, pcm []int16, bitrate int, config SilkConfig, setup func(enc *OpusEncoder), check func(enc *OpusEncoder, packet []byte)) [][]byte {
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(bitrate)
+	if setup != nil {
+		setup(enc)
+	}
+	if err := enc.SetSilkConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	var packets [][]byte
+	buf := make([]byte, 1275)
+	for off := 0; off+960 <= len(pcm); off += 960 {
+		n, err := enc.Encode(pcm, off, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packet := append([]byte(nil), buf[:n]...)
+		if check != nil {
+			check(enc, packet)
+		}
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+func testTotalBytes(packets [][]byte) int {
+	total := 0
+	for _, p := range packets {
+		total += len(p)
+	}
+	return total
+}
+
+func TestSilkConfigInternalRate(t *testing.T) {
+	pcm := testSpeechSignal(1, 48000, 48000)
+	rates := func(bitrate int, config SilkConfig) map[int]int {
+		seen := map[int]int{}
+		testSilkConfigPackets(t, pcm, bitrate, config, nil, func(enc *OpusEncoder, packet []byte) {
+			rate := enc.GetSilkStatus().InternalSampleRate
+			seen[rate]++
+			bw := map[int]int{8000: OPUS_BANDWIDTH_NARROWBAND, 12000: OPUS_BANDWIDTH_MEDIUMBAND, 16000: OPUS_BANDWIDTH_WIDEBAND}[rate]
+			if GetBandwidth(packet, 0) != bw {
+				t.Fatalf("%d Hz internally, bandwidth %d signalled", rate, GetBandwidth(packet, 0))
+			}
+		})
+		return seen
+	}
+
+	/* At 8 kbit/s the encoder drops to narrowband by itself */
+	if seen := rates(8000, SilkConfig{}); seen[16000] != 0 {
+		t.Fatalf("default at 8 kbit/s: %v", seen)
+	}
+	if seen := rates(8000, SilkConfig{MinInternalSampleRate: 16000}); seen[16000] != 50 {
+		t.Fatalf("forced wideband at 8 kbit/s: %v", seen)
+	}
+	if seen := rates(32000, SilkConfig{MaxInternalSampleRate: 8000}); seen[8000] != 50 {
+		t.Fatalf("narrowband cap at 32 kbit/s: %v", seen)
+	}
+	if seen := rates(32000, SilkConfig{MinInternalSampleRate: 12000, MaxInternalSampleRate: 12000}); seen[12000] != 50 {
+		t.Fatalf("mediumband at 32 kbit/s: %v", seen)
+	}
+}
+
+func TestSilkConfigValidation(t *testing.T) {
+	enc, _ := NewOpusEncoder(48000, 1, OPUS_APPLICATION_VOIP)
+	good := SilkConfig{MinInternalSampleRate: 12000, LBRRGainIncrease: 4, ReducedDependency: true}
+	if err := enc.SetSilkConfig(good); err != nil {
+		t.Fatal(err)
+	}
+	for _, bad := range []SilkConfig{
+		{MinInternalSampleRate: 16000, MaxInternalSampleRate: 8000},
+		{DesiredInternalSampleRate: 16000, MaxInternalSampleRate: 12000},
+		{MinInternalSampleRate: 44100},
+		{LBRRGainIncrease: 64},
+		{LBRRMinBitrate: -1},
+		{MaxBits: -8},
+	} {
+		var oe *OpusException
+		if err := enc.SetSilkConfig(bad); !errors.Is(err, ErrBadArg) || !errors.As(err, &oe) || oe.Arg != "config" {
+			t.Errorf("%+v: got %v", bad, err)
+		}
+	}
+	if got := enc.GetSilkConfig(); got != good || !enc.GetPredictionDisabled() {
+		t.Fatalf("config after rejections: %+v", got)
+	}
+
+	ms, err := CreateOpusMSEncoder(48000, 3, 2, 1, []int16{0, 1, 2}, OPUS_APPLICATION_VOIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ms.SetSilkConfig(SilkConfig{MinInternalSampleRate: 16000, MaxInternalSampleRate: 8000}); err == nil {
+		t.Fatal("multistream encoder accepted min > max")
+	}
+	if err := ms.SetSilkConfig(good); err != nil {
+		t.Fatal(err)
+	}
+	for s := 0; s < 2; s++ {
+		stream, _ := ms.GetMultistreamEncoderState(s)
+		if stream.GetSilkConfig() != good {
+			t.Fatalf("stream %d: %+v", s, stream.GetSilkConfig())
+		}
+	}
+	if len(ms.GetSilkStatus()) != 2 {
+		t.Fatal("one status per stream expected")
+	}
+}
+
+func TestSilkConfigLBRR(t *testing.T) {
+	pcm := testSpeechSignal(1, 2*48000, 48000)
+	fec := func(enc *OpusEncoder) {
+		enc.SetUseInbandFEC(true)
+		enc.SetPacketLossPercent(10)
+	}
+	count := func(packets [][]byte) int {
+		n := 0
+		for _, p := range packets {
+			if PacketHasLBRR(p, 0, len(p)) {
+				n++
+			}
+		}
+		return n
+	}
+	none := testSilkConfigPackets(t, pcm, 24000, SilkConfig{}, nil, nil)
+	auto := testSilkConfigPackets(t, pcm, 24000, SilkConfig{}, fec, nil)
+	fine := testSilkConfigPackets(t, pcm, 24000, SilkConfig{LBRRGainIncrease: 1}, fec, nil)
+	coarse := testSilkConfigPackets(t, pcm, 24000, SilkConfig{LBRRGainIncrease: 12}, fec, nil)
+	high := testSilkConfigPackets(t, pcm, 24000, SilkConfig{LBRRMinBitrate: 30000}, fec, nil)
+	/* 10 kbit/s is below the default wideband threshold */
+	thin := testSilkConfigPackets(t, pcm, 10000, SilkConfig{}, fec, nil)
+	low := testSilkConfigPackets(t, pcm, 10000, SilkConfig{LBRRMinBitrate: 5000}, fec, nil)
+	t.Logf("LBRR in %d packets at 24 kbit/s, %d above 30 kbit/s, %d at 10 kbit/s, %d above 5 kbit/s",
+		count(auto), count(high), count(thin), count(low))
+	if count(none) != 0 || count(auto) == 0 || count(high) != 0 || count(thin) != 0 || count(low) == 0 {
+		t.Fatal("LBRR threshold not applied")
+	}
+	if count(fine) == 0 || count(coarse) == 0 {
+		t.Fatal("LBRR missing")
+	}
+
+	/* Every fifth packet is lost and recovered from the LBRR frame of the
+	   next; coarser LBRR frames recover it less accurately */
+	recovery := func(packets [][]byte) float64 {
+		ref := testDecodePackets(t, packets, 1, 48000)
+		dec, _ := NewOpusDecoder(48000, 1)
+		out := make([]int16, 960)
+		dist := 0.0
+		for i, p := range packets {
+			if i%5 == 4 && i+1 < len(packets) {
+				if _, e := dec.Decode(packets[i+1], 0, len(packets[i+1]), out, 0, 960, true); e != nil {
+					t.Fatal(e)
+				}
+				dist += opus_pcm_energy(testSubtract(ref[960*i:960*(i+1)], out), 0, 960)
+				continue
+			}
+			if _, e := dec.Decode(p, 0, len(p), out, 0, 960, false); e != nil {
+				t.Fatal(e)
+			}
+		}
+		return opus_energy_db(dist)
+	}
+	t.Logf("recovery error %.1f dB with 1 step, %.1f dB with 12", recovery(fine), recovery(coarse))
+	if recovery(coarse) < recovery(fine)+3 {
+		t.Errorf("recovery error %.1f dB with 12 steps, %.1f dB with 1", recovery(coarse), recovery(fine))
+	}
+}
+
+func TestSilkConfigBits(t *testing.T) {
+	pcm := testSpeechSignal(1, 48000, 48000)
+	capped := testSilkConfigPackets(t, pcm, 32000, SilkConfig{MaxBits: 320}, nil, nil)
+	for i, p := range capped {
+		if len(p) > 41 {
+			t.Fatalf("packet %d has %d bytes with a 320 bit cap", i, len(p))
+		}
+	}
+
+	spread := func(packets [][]byte) float64 {
+		mean := float64(testTotalBytes(packets)) / float64(len(packets))
+		v := 0.0
+		for _, p := range packets[5:] {
+			v += (float64(len(p)) - mean) * (float64(len(p)) - mean)
+		}
+		return math.Sqrt(v / float64(len(packets)-5))
+	}
+	vbr := testSilkConfigPackets(t, pcm, 24000, SilkConfig{}, nil, nil)
+	cbr := testSilkConfigPackets(t, pcm, 24000, SilkConfig{UseCBR: true}, nil, nil)
+	t.Logf("packet size spread %.1f bytes in VBR, %.1f with CBR SILK", spread(vbr), spread(cbr))
+	if spread(cbr) >= spread(vbr)/2 {
+		t.Fatal("CBR SILK frames vary as much as VBR ones")
+	}
+}
@@ -0,0 +1,387 @@
+package opus
+
+import (
+	"math"
+)
+
+/* Pitch-synchronous WSOLA for jitter buffers. Accelerate drops one pitch
+   period from a frame and Decelerate repeats one, cross-fading the two
+   periods so that both ends of the frame are left untouched. Expand makes up
+   audio when the buffer runs dry by repeating the last period of the output,
+   fading out after 20 ms, and the next frame is cross-faded into the
+   repetition. The period comes from the SILK pitch estimator up to 16 kHz and
+   from the CELT one above, checked against the decoder's pitch when one is
+   given, and refined against the samples being spliced. */
+
+const (
+	stretch_correlation = 0.9  // normalised correlation needed to splice
+	stretch_quiet       = 1024 // mean energy below which any period will do
+	stretch_voiced_gain = 0.3  // remove_doubling gain taken as voiced
+	stretch_refine      = 2    // samples searched around each candidate
+)
+
+// TimeStretcher changes the duration of decoded audio without changing its
+// pitch, for adaptive jitter buffers. Every decoded frame must go through
+// one of Normal, Accelerate or Decelerate, and Expand is called in place of
+// a frame when none is available, so that the stretcher sees the audio that
+// was actually played.
+type TimeStretcher struct {
+	Fs         int
+	channels   int
+	min_period int
+	max_period int
+	pitch_hint int // from the decoder, at 48 kHz
+
+	hist        []int16 // last output, interleaved
+	frame       []int16
+	prev_lag    int
+	prev_period int
+	prev_gain   int
+
+	expand_period int
+	expand_buf    []int16 // period repeated by Expand, interleaved
+	expand_pos    int
+	expand_gain   float32
+	expanded      int // samples made up since the last frame
+}
+
+// NewTimeStretcher creates a stretcher for audio at Fs (8, 12, 16, 24 or 48
+// kHz) with 1 or 2 interleaved channels.
+func NewTimeStretcher(Fs int, channels int) (*TimeStretcher, error) {
+	if Fs != 48000 && Fs != 24000 && Fs != 16000 && Fs != 12000 && Fs != 8000 {
+		return nil, bad_arg("Fs", "Sample rate is invalid (must be 8/12/16/24/48 Khz)")
+	}
+	if channels != 1 && channels != 2 {
+		return nil, bad_arg("channels", "Number of channels must be 1 or 2")
+	}
+	st := &TimeStretcher{
+		Fs:         Fs,
+		channels:   channels,
+		min_period: Fs / 400,
+		max_period: PE_MAX_LAG_MS * Fs / 1000,
+	}
+	st.hist = make([]int16, 2*Fs/50*channels)
+	st.expand_buf = make([]int16, st.max_period*channels)
+	st.Reset()
+	return st, nil
+}
+
+// Reset clears the history, as after a seek.
+func (st *TimeStretcher) Reset() {
+	for i := range st.hist {
+		st.hist[i] = 0
+	}
+	st.pitch_hint = 0
+	st.prev_lag = 0
+	st.prev_period = 0
+	st.prev_gain = 0
+	st.expand_period = 0
+	st.expand_pos = 0
+	st.expand_gain = 1
+	st.expanded = 0
+}
+
+// SetPitchHint gives the pitch period of the next frame in samples at 48
+// kHz, as returned by OpusDecoder.GetPitch, or 0 when it is not known.
+func (st *TimeStretcher) SetPitchHint(period int) {
+	st.pitch_hint = IMAX(period, 0)
+}
+
+func (st *TimeStretcher) GetPitchHint() int {
+	return st.pitch_hint
+}
+
+// Normal passes frame_size samples per channel through unchanged, apart
+// from the fade out of a preceding Expand, and returns frame_size.
+func (st *TimeStretcher) Normal(pcm []int16, pcm_offset, frame_size int, out []int16, out_offset int) (int, error) {
+	x, err := st.begin(pcm, pcm_offset, frame_size, out, out_offset, frame_size)
+	if err != nil {
+		return 0, err
+	}
+	copy(out[out_offset:], x)
+	st.update(out[out_offset : out_offset+frame_size*st.channels])
+	return frame_size, nil
+}
+
+// Accelerate removes one pitch period from the frame when it is periodic
+// enough, or quiet, and returns the number of samples per channel written to
+// out, which is frame_size when nothing could be removed. A frame must span
+// two periods, so 20 ms frames and longer work best.
+func (st *TimeStretcher) Accelerate(pcm []int16, pcm_offset, frame_size int, out []int16, out_offset int) (int, error) {
+	x, err := st.begin(pcm, pcm_offset, frame_size, out, out_offset, frame_size)
+	if err != nil {
+		return 0, err
+	}
+	C := st.channels
+	T := st.splice_period(x, frame_size)
+	if T == 0 {
+		copy(out[out_offset:], x)
+		st.update(out[out_offset : out_offset+frame_size*C])
+		return frame_size, nil
+	}
+	/* Fade from the first period into the second */
+	for i := 0; i < T; i++ {
+		for c := 0; c < C; c++ {
+			a, b := int(x[i*C+c]), int(x[(i+T)*C+c])
+			out[out_offset+i*C+c] = int16((a*(T-i) + b*(i+1)) / (T + 1))
+		}
+	}
+	copy(out[out_offset+T*C:], x[2*T*C:])
+	N := frame_size - T
+	st.update(out[out_offset : out_offset+N*C])
+	return N, nil
+}
+
+// Decelerate repeats one pitch period of the frame when it is periodic
+// enough, or quiet, and returns the number of samples per channel written to
+// out. out must have room for frame_size*3/2 samples per channel.
+func (st *TimeStretcher) Decelerate(pcm []int16, pcm_offset, frame_size int, out []int16, out_offset int) (int, error) {
+	x, err := st.begin(pcm, pcm_offset, frame_size, out, out_offset, frame_size+frame_size/2)
+	if err != nil {
+		return 0, err
+	}
+	C := st.channels
+	T := st.splice_period(x, frame_size)
+	if T == 0 {
+		copy(out[out_offset:], x)
+		st.update(out[out_offset : out_offset+frame_size*C])
+		return frame_size, nil
+	}
+	/* The first period, a fade from the second back into the first, then the
+	   rest of the frame from the second period on */
+	copy(out[out_offset:], x[:T*C])
+	for i := 0; i < T; i++ {
+		for c := 0; c < C; c++ {
+			a, b := int(x[(i+T)*C+c]), int(x[i*C+c])
+			out[out_offset+(T+i)*C+c] = int16((a*(T-i) + b*(i+1)) / (T + 1))
+		}
+	}
+	copy(out[out_offset+2*T*C:], x[T*C:])
+	N := frame_size + T
+	st.update(out[out_offset : out_offset+N*C])
+	return N, nil
+}
+
+// Expand writes frame_size samples per channel that continue the last
+// output, for when no frame is available, and returns frame_size. The
+// repetition fades out after 20 ms.
+func (st *TimeStretcher) Expand(out []int16, out_offset, frame_size int) (int, error) {
+	C := st.channels
+	if frame_size <= 0 || out_offset+frame_size*C > len(out) {
+		return 0, bad_arg("out", "Output buffer is too small")
+	}
+	if st.expanded == 0 {
+		st.start_expand()
+	}
+	st.extend(out[out_offset:out_offset+frame_size*C], frame_size)
+	st.update(out[out_offset : out_offset+frame_size*C])
+	return frame_size, nil
+}
+
+// Checks the arguments and returns the frame, cross-faded from a preceding
+// Expand.
+func (st *TimeStretcher) begin(pcm []int16, pcm_offset, frame_size int, out []int16, out_offset int, out_size int) ([]int16, error) {
+	C := st.channels
+	if frame_size <= 0 || pcm_offset+frame_size*C > len(pcm) {
+		return nil, bad_arg("pcm", "Not enough samples provided in input signal")
+	}
+	if out_offset+out_size*C > len(out) {
+		return nil, bad_arg("out", "Output buffer is too small")
+	}
+	if cap(st.frame) < frame_size*C {
+		st.frame = make([]int16, frame_size*C)
+	}
+	x := st.frame[:frame_size*C]
+	copy(x, pcm[pcm_offset:])
+	if st.expanded > 0 {
+		L := IMIN(st.expand_period, frame_size)
+		fade := make([]int16, L*C)
+		st.extend(fade, L)
+		for i := 0; i < L; i++ {
+			for c := 0; c < C; c++ {
+				a, b := int(fade[i*C+c]), int(x[i*C+c])
+				x[i*C+c] = int16((a*(L-i) + b*(i+1)) / (L + 1))
+			}
+		}
+		st.expanded = 0
+	}
+	return x, nil
+}
+
+// Appends the output to the history.
+func (st *TimeStretcher) update(out []int16) {
+	if len(out) >= len(st.hist) {
+		copy(st.hist, out[len(out)-len(st.hist):])
+		return
+	}
+	n := copy(st.hist, st.hist[len(out):])
+	copy(st.hist[n:], out)
+}
+
+// Picks the period repeated by Expand from the end of the history.
+func (st *TimeStretcher) start_expand() {
+	C := st.channels
+	H := len(st.hist) / C
+	mono := st.downmix(st.hist, H)
+	T := st.best_period(mono, -1, st.candidates(mono), st.max_period)
+	if T == 0 {
+		T = st.max_period
+	}
+	/* Repeat the last period, matched against the one before it */
+	copy(st.expand_buf, st.hist[(H-T)*C:])
+	st.expand_period = T
+	st.expand_pos = 0
+	st.expand_gain = 1
+}
+
+// Writes n samples per channel of the repetition.
+func (st *TimeStretcher) extend(out []int16, n int) {
+	C := st.channels
+	T := st.expand_period
+	hold := st.Fs / 50
+	/* Halve every 20 ms once the first 20 ms have been played */
+	decay := float32(math.Pow(0.5, 1/float64(hold)))
+	for i := 0; i < n; i++ {
+		for c := 0; c < C; c++ {
+			out[i*C+c] = int16(st.expand_gain * float32(st.expand_buf[st.expand_pos*C+c]))
+		}
+		if st.expand_pos++; st.expand_pos == T {
+			st.expand_pos = 0
+		}
+		if st.expanded++; st.expanded > hold {
+			st.expand_gain *= decay
+		}
+	}
+}
+
+// Mono mix of the last n samples per channel of x, as ints.
+func (st *TimeStretcher) downmix(x []int16, n int) []int {
+	C := st.channels
+	mono := make([]int, n)
+	off := len(x)/C - n
+	for i := range mono {
+		s := 0
+		for c := 0; c < C; c++ {
+			s += int(x[(off+i)*C+c])
+		}
+		mono[i] = s / C
+	}
+	return mono
+}
+
+// Period to splice at the start of frame x, or 0 when the frame is neither
+// periodic nor quiet.
+func (st *TimeStretcher) splice_period(x []int16, frame_size int) int {
+	C := st.channels
+	max_period := IMIN(st.max_period, frame_size/2)
+	if max_period < st.min_period {
+		return 0
+	}
+	/* The analysis sees the history followed by the frame */
+	H := len(st.hist) / C
+	buf := make([]int16, (H+frame_size)*C)
+	copy(buf, st.hist)
+	copy(buf[H*C:], x)
+	mono := st.downmix(buf, H+frame_size)
+
+	energy := 0
+	for _, v := range mono[H : H+frame_size] {
+		energy += v * v / frame_size
+	}
+	T := st.best_period(mono, H, st.candidates(mono), max_period)
+	if T == 0 && energy < stretch_quiet {
+		/* Nothing to hear, so remove or repeat as much as possible */
+		T = max_period
+	}
+	return T
+}
+
+// Candidate periods for the latest samples of mono: the estimate of the
+// pitch analysis when it finds the signal voiced, and the decoder's pitch.
+func (st *TimeStretcher) candidates(mono []int) []int {
+	var periods []int
+	if T := st.estimate_period(mono); T > 0 {
+		periods = append(periods, T)
+	}
+	if st.pitch_hint > 0 {
+		periods = append(periods, st.pitch_hint*st.Fs/48000)
+	}
+	return periods
+}
+
+// Runs the SILK or CELT pitch estimator on the end of mono, returning 0 when
+// the signal is not voiced.
+func (st *TimeStretcher) estimate_period(mono []int) int {
+	Fs := st.Fs
+	if Fs <= 16000 {
+		/* 20 ms of LTP memory and four 5 ms subframes */
+		n := (PE_LTP_MEM_LENGTH_MS + PE_MAX_NB_SUBFR*PE_SUBFR_LENGTH_MS) * Fs / 1000
+		frame := make([]int16, n)
+		for i := range frame {
+			frame[i] = int16(mono[len(mono)-n+i])
+		}
+		var pitch_out [PE_MAX_NB_SUBFR]int
+		lagIndex := BoxedValueShort{0}
+		contourIndex := BoxedValueByte{0}
+		LTPCorr_Q15 := BoxedValueInt{0}
+		if silk_pitch_analysis_core(frame, pitch_out[:], &lagIndex, &contourIndex, &LTPCorr_Q15, st.prev_lag,
+			int(math.Floor(0.7*(1<<16)+0.5)), int(math.Floor(0.6*(1<<13)+0.5)), Fs/1000, SILK_PE_MAX_COMPLEX, PE_MAX_NB_SUBFR) != 0 {
+			st.prev_lag = 0
+			return 0
+		}
+		st.prev_lag = pitch_out[PE_MAX_NB_SUBFR-1]
+		return st.prev_lag
+	}
+
+	/* The 20 ms frame and max_period before it, decimated by 2 */
+	maxperiod := st.max_period &^ 1
+	N := Fs / 50
+	x := mono[len(mono)-maxperiod-N:]
+	pitch_buf := make([]int, (maxperiod+N)>>1)
+	pitch_downsample([][]int{x}, pitch_buf, maxperiod+N, 1)
+	pitch_index := BoxedValueInt{0}
+	pitch_search(pitch_buf, maxperiod>>1, pitch_buf, N, maxperiod-3*st.min_period, &pitch_index)
+	pitch_index.Val = maxperiod - pitch_index.Val
+	gain := remove_doubling(pitch_buf, maxperiod, st.min_period, N, &pitch_index, st.prev_period, st.prev_gain)
+	if pitch_index.Val > maxperiod-2 {
+		pitch_index.Val = maxperiod - 2
+	}
+	st.prev_period = pitch_index.Val
+	st.prev_gain = gain
+	if float32(gain) < stretch_voiced_gain*float32(CeltConstants.Q15ONE) {
+		return 0
+	}
+	return pitch_index.Val
+}
+
+// Searches around each candidate for the period whose two consecutive
+// periods from mono[pos], or ending at the end of mono when pos is negative,
+// correlate best, returning 0 when none reaches stretch_correlation.
+func (st *TimeStretcher) best_period(mono []int, pos int, candidates []int, max_period int) int {
+	best, best_corr := 0, float32(stretch_correlation)
+	for _, c := range candidates {
+		for T := c - stretch_refine; T <= c+stretch_refine; T++ {
+			if T < st.min_period || T > max_period {
+				continue
+			}
+			pos := pos
+			if pos < 0 {
+				pos = len(mono) - 2*T
+			}
+			if pos < 0 || pos+2*T > len(mono) {
+				continue
+			}
+			var xy, xx, yy float32
+			for i := 0; i < T; i++ {
+				a, b := float32(mono[pos+i]), float32(mono[pos+T+i])
+				xy += a * b
+				xx += a * a
+				yy += b * b
+			}
+			if corr := xy / float32(math.Sqrt(float64(xx*yy)+1)); corr > best_corr {
+				best, best_corr = T, corr
+			}
+		}
+	}
+	return best
+}
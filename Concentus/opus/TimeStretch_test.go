@@ -0,0 +1,161 @@
+package opus
+
+import (
+	"math"
+	"testing"
+)
+
+// Largest step between consecutive samples of each channel.
+func testMaxStep(x []int16, channels int) int {
+	step := 0
+	for i := channels; i < len(x); i++ {
+		step = IMAX(step, abs(int(x[i])-int(x[i-channels])))
+	}
+	return step
+}
+
+func TestTimeStretchPeriodic(t *testing.T) {
+	for _, Fs := range []int{8000, 16000, 24000, 48000} {
+		for _, channels := range []int{1, 2} {
+			period := Fs / 200
+			frame := Fs / 50
+			pcm := testPeriodic(channels, 10*frame, float64(period))
+			st, err := NewTimeStretcher(Fs, channels)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := make([]int16, 2*frame*channels)
+			var played []int16
+			for f := 0; f < 10; f++ {
+				off := f * frame * channels
+				var n int
+				switch {
+				case f < 3 || f == 6:
+					n, err = st.Normal(pcm, off, frame, out, 0)
+				case f == 3 || f == 4:
+					n, err = st.Accelerate(pcm, off, frame, out, 0)
+				case f == 5:
+					n, err = st.Decelerate(pcm, off, frame, out, 0)
+				case f == 7:
+					n, err = st.Expand(out, 0, frame)
+				default:
+					n, err = st.Normal(pcm, off-frame*channels, frame, out, 0)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := frame
+				if f == 3 || f == 4 {
+					want = frame - period
+				} else if f == 5 {
+					want = frame + period
+				}
+				if n != want {
+					t.Fatalf("%d Hz, %d channels, frame %d: %d samples, want %d", Fs, channels, f, n, want)
+				}
+				played = append(played, out[:n*channels]...)
+			}
+
+			/* Splicing whole periods of a periodic signal leaves it intact */
+			var err2, ref float64
+			for i := range played {
+				j := i
+				if i >= 3*frame*channels {
+					j += period * channels
+				}
+				if j < len(pcm) {
+					d := float64(played[i]) - float64(pcm[j])
+					err2 += d * d
+					ref += float64(pcm[j]) * float64(pcm[j])
+				}
+			}
+			if snr := 10 * math.Log10(ref/(err2+1)); snr < 30 {
+				t.Errorf("%d Hz, %d channels: %.1f dB SNR after splicing", Fs, channels, snr)
+			}
+			if step := testMaxStep(played, channels); step > testMaxStep(pcm, channels)*11/10 {
+				t.Errorf("%d Hz, %d channels: step of %d, %d in the input", Fs, channels, step, testMaxStep(pcm, channels))
+			}
+		}
+	}
+}
+
+func TestTimeStretchNoise(t *testing.T) {
+	noise := testNoise(960, 3000, 1)
+	pcm := make([]int16, len(noise))
+	for i, v := range noise {
+		pcm[i] = int16(v)
+	}
+	st, _ := NewTimeStretcher(48000, 1)
+	out := make([]int16, 2*960)
+	if n, _ := st.Accelerate(pcm, 0, 960, out, 0); n != 960 {
+		t.Fatalf("noise shortened to %d samples", n)
+	}
+	if n, _ := st.Decelerate(pcm, 0, 960, out, 0); n != 960 {
+		t.Fatalf("noise lengthened to %d samples", n)
+	}
+	/* Silence can be cut anywhere */
+	if n, _ := st.Accelerate(make([]int16, 960), 0, 960, out, 0); n >= 960 {
+		t.Fatal("silence not shortened")
+	}
+
+	if _, err := NewTimeStretcher(44100, 1); err == nil {
+		t.Fatal("44.1 kHz accepted")
+	}
+	if _, err := st.Accelerate(pcm, 0, 961, out, 0); err == nil {
+		t.Fatal("short input accepted")
+	}
+	if _, err := st.Decelerate(pcm, 0, 960, out[:1000], 0); err == nil {
+		t.Fatal("short output accepted")
+	}
+}
+
+// Decodes speech and stretches it to the target length with the decoder's
+// pitch as a hint, as a jitter buffer draining or filling would.
+func TestTimeStretchSpeech(t *testing.T) {
+	for _, Fs := range []int{16000, 48000} {
+		for _, speed := range []float64{0.9, 1.1} {
+			pcm := testSpeechSignal(1, 4*48000, 48000)
+			packets := testSilkPackets(t, pcm, 1, 24000)
+			dec, _ := NewOpusDecoder(Fs, 1)
+			st, _ := NewTimeStretcher(Fs, 1)
+			frame := Fs / 50
+			buf := make([]int16, frame)
+			out := make([]int16, 2*frame)
+			var played []int16
+			total := 0
+			for _, p := range packets {
+				n, err := dec.Decode(p, 0, len(p), buf, 0, frame, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				total += n
+				st.SetPitchHint(dec.GetPitch())
+				target := int(float64(total) / speed)
+				switch {
+				case len(played)+n > target+frame/4:
+					n, err = st.Accelerate(buf, 0, n, out, 0)
+				case len(played)+n < target-frame/4:
+					n, err = st.Decelerate(buf, 0, n, out, 0)
+				default:
+					n, err = st.Normal(buf, 0, n, out, 0)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				played = append(played, out[:n]...)
+			}
+			/* Unvoiced stretches cannot be spliced, so the output may trail
+			   the target by a few frames */
+			target := int(float64(total) / speed)
+			done := float64(len(played)-total) / float64(target-total)
+			t.Logf("%d Hz at %.1fx: %d samples, target %d, %.0f%% of the change", Fs, speed, len(played), target, 100*done)
+			if done < 0.9 || abs(len(played)-target) > 3*frame {
+				t.Errorf("%d Hz at %.1fx: %d samples, want %d", Fs, speed, len(played), target)
+			}
+			decoded := testDecodePackets(t, packets, 1, Fs)
+			if step := testMaxStep(played, 1); step > testMaxStep(decoded, 1)*11/10 {
+				t.Errorf("%d Hz at %.1fx: step of %d, %d in the input", Fs, speed, step, testMaxStep(decoded, 1))
+			}
+		}
+	}
+}
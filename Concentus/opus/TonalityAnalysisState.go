@@ -38,7 +38,10 @@ type TonalityAnalysisState struct {
 }
 
 func NewTonalityAnalysisState() TonalityAnalysisState {
-	t := TonalityAnalysisState{}
+	t := TonalityAnalysisState{
+		inmem:        make([]int, ANALYSIS_BUF_SIZE),
+		subframe_mem: make([]float32, 3),
+	}
 	for i := 0; i < DETECT_SIZE; i++ {
 		t.info[i] = &AnalysisInfo{}
 	}
@@ -0,0 +1,175 @@
+package opus
+
+import (
+	"context"
+	"log/slog"
+)
+
+/* Per-instance tracing. An encoder or decoder with no tracer set only pays
+   for a nil check at each trace point; events are built only when a tracer
+   is present. */
+
+// TraceEvent is implemented by TraceSymbol, TraceModeDecision and TraceFrame.
+type TraceEvent interface {
+	traceEvent()
+}
+
+// TraceSymbol is emitted for each symbol written by the range coder of an
+// encoder or read by that of a decoder. Both sides emit the same sequence
+// for a packet, so an encoder trace can be compared with a decoder one.
+type TraceSymbol struct {
+	Stream int    // stream index within a multistream encoder or decoder
+	Op     string // coder primitive: "symbol", "bit_logp", "icdf", "uint" or "bits"
+	Symbol int    // symbol, value or low end of the coded range
+	Bits   int    // precision of the distribution in bits
+	Range  uint32 // range after the symbol
+	Tell   int    // bits used so far
+}
+
+// TraceModeDecision is emitted once the encoder has settled the coding mode
+// and bandwidth of a frame.
+type TraceModeDecision struct {
+	Stream    int
+	Mode      int
+	Bandwidth int
+	Channels  int // coded channels
+	FrameSize int // samples per channel
+	Bitrate   int
+}
+
+// TraceFrame is emitted after each packet is encoded or each frame is
+// decoded or concealed.
+type TraceFrame struct {
+	Stream     int
+	Encoder    bool
+	Mode       int
+	Bandwidth  int
+	Channels   int
+	FrameSize  int  // samples per channel
+	Bytes      int  // packet or frame size, 0 for concealed frames
+	Lost       bool // concealed with PLC
+	FEC        bool // rebuilt from in-band FEC
+	DTX        bool // comfort noise during a pause in transmission
+	FinalRange uint32
+}
+
+func (TraceSymbol) traceEvent()       {}
+func (TraceModeDecision) traceEvent() {}
+func (TraceFrame) traceEvent()        {}
+
+// Tracer receives the events of the encoders and decoders it is set on. A
+// tracer shared by several instances must be safe for concurrent use.
+type Tracer interface {
+	Trace(event TraceEvent)
+}
+
+// TracerFunc adapts a function to the Tracer interface.
+type TracerFunc func(event TraceEvent)
+
+func (f TracerFunc) Trace(event TraceEvent) {
+	f(event)
+}
+
+type slogTracer struct {
+	logger *slog.Logger
+	level  slog.Level
+}
+
+// NewSlogTracer returns a tracer logging each event as a structured record at
+// the given level.
+func NewSlogTracer(logger *slog.Logger, level slog.Level) Tracer {
+	return &slogTracer{logger: logger, level: level}
+}
+
+func (t *slogTracer) Trace(event TraceEvent) {
+	ctx := context.Background()
+	if !t.logger.Enabled(ctx, t.level) {
+		return
+	}
+	switch e := event.(type) {
+	case TraceSymbol:
+		t.logger.LogAttrs(ctx, t.level, "opus.symbol",
+			slog.Int("stream", e.Stream), slog.String("op", e.Op), slog.Int("symbol", e.Symbol),
+			slog.Int("bits", e.Bits), slog.Uint64("rng", uint64(e.Range)), slog.Int("tell", e.Tell))
+	case TraceModeDecision:
+		t.logger.LogAttrs(ctx, t.level, "opus.mode",
+			slog.Int("stream", e.Stream), slog.Int("mode", e.Mode), slog.Int("bandwidth", e.Bandwidth),
+			slog.Int("channels", e.Channels), slog.Int("frame_size", e.FrameSize), slog.Int("bitrate", e.Bitrate))
+	case TraceFrame:
+		t.logger.LogAttrs(ctx, t.level, "opus.frame",
+			slog.Int("stream", e.Stream), slog.Bool("encoder", e.Encoder), slog.Int("mode", e.Mode),
+			slog.Int("bandwidth", e.Bandwidth), slog.Int("channels", e.Channels), slog.Int("frame_size", e.FrameSize),
+			slog.Int("bytes", e.Bytes), slog.Bool("lost", e.Lost), slog.Bool("fec", e.FEC), slog.Bool("dtx", e.DTX),
+			slog.Uint64("final_range", uint64(e.FinalRange)))
+	}
+}
+
+// Tags the events of one stream of a multistream encoder or decoder.
+type streamTracer struct {
+	tracer Tracer
+	stream int
+}
+
+func (t streamTracer) Trace(event TraceEvent) {
+	switch e := event.(type) {
+	case TraceSymbol:
+		e.Stream = t.stream
+		t.tracer.Trace(e)
+	case TraceModeDecision:
+		e.Stream = t.stream
+		t.tracer.Trace(e)
+	case TraceFrame:
+		e.Stream = t.stream
+		t.tracer.Trace(e)
+	default:
+		t.tracer.Trace(event)
+	}
+}
+
+func stream_tracer(tracer Tracer, stream int) Tracer {
+	if tracer == nil {
+		return nil
+	}
+	return streamTracer{tracer, stream}
+}
+
+func (ec *EntropyCoder) trace(op string, symbol int, bits int) {
+	e := TraceSymbol{
+		Op:     op,
+		Symbol: symbol,
+		Bits:   bits,
+		Range:  uint32(ec.rng),
+		Tell:   ec.tell(),
+	}
+	if ec.held {
+		ec.symbols = append(ec.symbols, e)
+	} else {
+		ec.tracer.Trace(e)
+	}
+}
+
+// Replaces the placeholder symbol reserving the initial bits of a packet
+// with the single bits later patched in, as the decoder reads them.
+func (ec *EntropyCoder) trace_initial_bits(_val int64, _nbits int) {
+	if len(ec.symbols) == 0 || ec.symbols[0].Tell > _nbits+1 {
+		return
+	}
+	bits := make([]TraceSymbol, _nbits)
+	rng := int64(EC_CODE_TOP)
+	nbits_total := EC_CODE_BITS + 1
+	for i := range bits {
+		rng >>= 1
+		for rng <= EC_CODE_BOT {
+			rng <<= EC_SYM_BITS
+			nbits_total += EC_SYM_BITS
+		}
+		bits[i] = TraceSymbol{
+			Op:     "bit_logp",
+			Symbol: int(_val>>(_nbits-1-i)) & 1,
+			Bits:   1,
+			Range:  uint32(rng),
+			Tell:   nbits_total - EC_ILOG(rng),
+		}
+	}
+	ec.symbols = append(bits, ec.symbols[1:]...)
+}
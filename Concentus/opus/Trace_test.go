@@ -0,0 +1,224 @@
+package opus
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"testing"
+)
+
+type testTraceCounter struct {
+	mu      sync.Mutex
+	symbols int
+	modes   int
+	frames  []TraceFrame
+	streams map[int]bool
+}
+
+func (c *testTraceCounter) Trace(event TraceEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.streams == nil {
+		c.streams = map[int]bool{}
+	}
+	switch e := event.(type) {
+	case TraceSymbol:
+		c.symbols++
+		c.streams[e.Stream] = true
+	case TraceModeDecision:
+		c.modes++
+	case TraceFrame:
+		c.frames = append(c.frames, e)
+	}
+}
+
+func TestTraceEncoderDecoder(t *testing.T) {
+	const frames = 5
+	pcm := testSpeechSignal(1, 960*frames, 48000)
+	enc, err := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+	encTrace := &testTraceCounter{}
+	enc.SetTracer(encTrace)
+	dec, err := NewOpusDecoder(48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decTrace := &testTraceCounter{}
+	dec.SetTracer(decTrace)
+
+	buf := make([]byte, 1275)
+	out := make([]int16, 960)
+	for i := 0; i < frames; i++ {
+		n, err := enc.Encode(pcm, i*960, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f := encTrace.frames[len(encTrace.frames)-1]; f.Bytes != n || f.FinalRange != uint32(enc.GetFinalRange()) || !f.Encoder {
+			t.Fatalf("frame %d: encoder trace %+v does not match packet of %d bytes", i, f, n)
+		}
+		if _, err := dec.Decode(buf, 0, n, out, 0, 960, false); err != nil {
+			t.Fatal(err)
+		}
+		if f := decTrace.frames[len(decTrace.frames)-1]; f.Mode != MODE_SILK_ONLY || f.FrameSize != 960 || f.Lost {
+			t.Fatalf("frame %d: unexpected decoder trace %+v", i, f)
+		}
+	}
+	if _, err := dec.DecodeLost(out, 0, 960); err != nil {
+		t.Fatal(err)
+	}
+	if !decTrace.frames[len(decTrace.frames)-1].Lost {
+		t.Fatal("concealed frame not traced as lost")
+	}
+	if encTrace.symbols == 0 || encTrace.modes != frames || len(encTrace.frames) != frames {
+		t.Fatalf("encoder traced %d symbols, %d modes, %d frames", encTrace.symbols, encTrace.modes, len(encTrace.frames))
+	}
+	if len(decTrace.frames) != frames+1 {
+		t.Fatalf("decoder traced %d frames, want %d", len(decTrace.frames), frames+1)
+	}
+
+	/* Removing the tracer stops the events */
+	enc.SetTracer(nil)
+	if _, err := enc.Encode(pcm, 0, 960, buf, 0, len(buf)); err != nil {
+		t.Fatal(err)
+	}
+	if len(encTrace.frames) != frames {
+		t.Fatal("events emitted after the tracer was removed")
+	}
+}
+
+func TestTraceSymbolsMatch(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		mode     int
+		channels int
+		bitrate  int
+	}{
+		{"SILK", MODE_SILK_ONLY, 1, 20000},
+		{"hybrid", MODE_HYBRID, 2, 32000},
+		{"CELT", MODE_CELT_ONLY, 2, 64000},
+	} {
+		pcm := testSpeechSignal(tc.channels, 960*10, 48000)
+		enc, err := NewOpusEncoder(48000, tc.channels, OPUS_APPLICATION_AUDIO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc.SetForceMode(tc.mode)
+		enc.SetBitrate(tc.bitrate)
+		dec, err := NewOpusDecoder(48000, tc.channels)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var encSymbols, decSymbols []TraceSymbol
+		enc.SetTracer(TracerFunc(func(event TraceEvent) {
+			if e, ok := event.(TraceSymbol); ok {
+				encSymbols = append(encSymbols, e)
+			}
+		}))
+		dec.SetTracer(TracerFunc(func(event TraceEvent) {
+			if e, ok := event.(TraceSymbol); ok {
+				decSymbols = append(decSymbols, e)
+			}
+		}))
+
+		buf := make([]byte, 1275)
+		out := make([]int16, 960*tc.channels)
+		for i := 0; i < 10; i++ {
+			encSymbols, decSymbols = encSymbols[:0], decSymbols[:0]
+			n, err := enc.Encode(pcm, i*960*tc.channels, 960, buf, 0, len(buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := dec.Decode(buf, 0, n, out, 0, 960, false); err != nil {
+				t.Fatal(err)
+			}
+			if len(encSymbols) == 0 || len(encSymbols) != len(decSymbols) {
+				t.Fatalf("%s frame %d: encoder traced %d symbols, decoder %d", tc.name, i, len(encSymbols), len(decSymbols))
+			}
+			for j := range encSymbols {
+				if encSymbols[j] != decSymbols[j] {
+					t.Fatalf("%s frame %d symbol %d: encoder %+v, decoder %+v", tc.name, i, j, encSymbols[j], decSymbols[j])
+				}
+			}
+		}
+	}
+}
+
+func TestTraceMultistreamTagsStreams(t *testing.T) {
+	const channels = 4
+	enc, dec := newTestMSCodec(t, channels, 1, 0)
+	trace := &testTraceCounter{}
+	enc.SetTracer(trace)
+	dec.SetTracer(trace)
+	pcm := testMultichannelSignal(channels, 960)
+	buf := make([]byte, 4000)
+	n, err := enc.EncodeMultistream(pcm, 0, 960, buf, 0, len(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dec.DecodeMultistream(buf, 0, n, make([]int16, 960*channels), 0, 960, false); err != nil {
+		t.Fatal(err)
+	}
+	streams := map[int]int{}
+	for _, f := range trace.frames {
+		streams[f.Stream]++
+	}
+	if len(streams) != 2 || streams[0] != 2 || streams[1] != 2 || !trace.streams[1] {
+		t.Fatalf("frames per stream %v, want one encoded and one decoded frame for each of 2 streams", streams)
+	}
+}
+
+// Independent instances with their own tracers may run concurrently.
+func TestTraceConcurrentInstances(t *testing.T) {
+	pcm := testSpeechSignal(1, 960*4, 48000)
+	want := testSilkPackets(t, pcm, 1, 24000)
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			enc, _ := NewOpusEncoder(48000, 1, OPUS_APPLICATION_AUDIO)
+			enc.SetForceMode(MODE_SILK_ONLY)
+			enc.SetMaxBandwidth(OPUS_BANDWIDTH_WIDEBAND)
+			enc.SetBitrate(24000)
+			trace := &testTraceCounter{}
+			enc.SetTracer(trace)
+			buf := make([]byte, 1275)
+			for i := range want {
+				n, err := enc.Encode(pcm, i*960, 960, buf, 0, len(buf))
+				if err != nil || !bytes.Equal(buf[:n], want[i]) {
+					t.Errorf("packet %d differs with tracing enabled", i)
+					return
+				}
+			}
+			if len(trace.frames) != len(want) {
+				t.Errorf("traced %d frames, want %d", len(trace.frames), len(want))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSlogTracer(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	tracer := NewSlogTracer(logger, slog.LevelDebug)
+	tracer.Trace(TraceFrame{Stream: 1, Mode: MODE_SILK_ONLY, FrameSize: 960, Bytes: 60})
+	var rec map[string]any
+	if err := json.Unmarshal(out.Bytes(), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec["msg"] != "opus.frame" || rec["stream"] != 1.0 || rec["bytes"] != 60.0 {
+		t.Fatalf("unexpected record %v", rec)
+	}
+
+	out.Reset()
+	NewSlogTracer(logger, slog.LevelDebug-4).Trace(TraceSymbol{Op: "bits"})
+	if out.Len() != 0 {
+		t.Fatal("record logged below the handler level")
+	}
+}
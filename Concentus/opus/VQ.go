@@ -218,8 +218,15 @@ func alg_unquant(X []int, X_ptr int, N int, K int, spread int, B int, dec *Entro
 	return collapse_mask
 }
 
+// Decodes the pulses of a band without reconstructing it, for bands that are
+// parsed but not synthesized.
+func alg_unquant_pulses(N int, K int, B int, dec *EntropyCoder) int {
+	iy := make([]int, N)
+	decode_pulses(iy, N, K, dec)
+	return extract_collapse_mask(iy, N, B)
+}
+
 func renormalise_vector(X []int, X_ptr int, N int, gain int) {
-	//PrintFuncArgs(X, X_ptr, N, gain)
 
 	var i int
 	var k int
@@ -1,7 +1,6 @@
 package opus
 
 import (
-	"fmt"
 	"math"
 )
 
@@ -16,6 +15,8 @@ type band_ctx struct {
 	remaining_bits int
 	bandE          [][]int
 	seed           int
+	disable_inv    int
+	resynth        int // reconstruct the band, not only code or parse it
 }
 
 type split_ctx struct {
@@ -45,7 +46,8 @@ func hysteresis_decision(val int, thresholds []int, hysteresis []int, N int, pre
 }
 
 func celt_lcg_rand(seed int) int {
-	return 1664525*seed + 1013904223
+	/* Wraps at 32 bits like the reference */
+	return int(1664525*int32(seed) + 1013904223)
 }
 
 func bitexact_cos(x int) int {
@@ -590,7 +592,7 @@ func compute_theta1(ctx *band_ctx, sctx *split_ctx, X []int, X_ptr int, Y []int,
 	} else if stereo != 0 {
 
 		if encode != 0 {
-			if itheta > 8192 {
+			if itheta > 8192 && ctx.disable_inv == 0 {
 				inv = 1
 				for j := 0; j < N; j++ {
 					Y[Y_ptr+j] = -Y[Y_ptr+j]
@@ -607,6 +609,10 @@ func compute_theta1(ctx *band_ctx, sctx *split_ctx, X []int, X_ptr int, Y []int,
 		} else {
 			inv = 0
 		}
+		/* The flag is still coded, but ignored */
+		if ctx.disable_inv != 0 {
+			inv = 0
+		}
 		itheta = 0
 	}
 	qalloc := int(ec.tell_frac()) - tell
@@ -779,7 +785,7 @@ func compute_theta(ctx *band_ctx, sctx *split_ctx, X []int, X_ptr int, Y []int,
 		}
 	} else if stereo != 0 {
 		if encode != 0 {
-			if itheta > 8192 {
+			if itheta > 8192 && ctx.disable_inv == 0 {
 				inv = 1
 			} else {
 				inv = 0
@@ -800,6 +806,10 @@ func compute_theta(ctx *band_ctx, sctx *split_ctx, X []int, X_ptr int, Y []int,
 		} else {
 			inv = 0
 		}
+		/* The flag is still coded, but ignored */
+		if ctx.disable_inv != 0 {
+			inv = 0
+		}
 		itheta = 0
 	}
 	qalloc = int(ec.tell_frac()) - tell
@@ -830,10 +840,7 @@ func compute_theta(ctx *band_ctx, sctx *split_ctx, X []int, X_ptr int, Y []int,
 }
 
 func quant_band_n1(ctx *band_ctx, X []int, X_ptr int, Y []int, Y_ptr int, b int, lowband_out []int, lowband_out_ptr int) int {
-	resynth := 0
-	if ctx.encode == 0 {
-		resynth = 1
-	}
+	resynth := ctx.resynth
 	stereo := 0
 	if Y != nil {
 		stereo = 1
@@ -885,10 +892,7 @@ func quant_partition(ctx *band_ctx, X []int, X_ptr int, N int, b int, B int, low
 	mid := 0
 	side := 0
 	cm := 0
-	resynth := 0
-	if ctx.encode == 0 {
-		resynth = 1
-	}
+	resynth := ctx.resynth
 	Y := 0
 	encode := ctx.encode
 	m := ctx.m
@@ -982,8 +986,10 @@ func quant_partition(ctx *band_ctx, X []int, X_ptr int, N int, b int, B int, low
 		K := get_pulses(q)
 		if encode != 0 {
 			cm = alg_quant(X, X_ptr, N, K, spread, B, ec)
-		} else {
+		} else if resynth != 0 {
 			cm = alg_unquant(X, X_ptr, N, K, spread, B, ec, gain)
+		} else {
+			cm = alg_unquant_pulses(N, K, B, ec)
 		}
 	} else {
 		var j = 0
@@ -1013,9 +1019,7 @@ func quant_partition(ctx *band_ctx, X []int, X_ptr int, N int, b int, B int, low
 						ctx.seed = celt_lcg_rand(ctx.seed)
 						/* About 48 dB below the "normal" folding level */
 						tmp = int(math.Floor(0.5 + (1.0/256)*((1)<<(10))))
-						if ((ctx.seed) & 0x8000) != 0 {
-							tmp = tmp
-						} else {
+						if ((ctx.seed) & 0x8000) == 0 {
 							tmp = 0 - tmp
 						}
 
@@ -1046,12 +1050,7 @@ func quant_band(ctx *band_ctx, X []int, X_ptr int, N int, b int, B int, lowband
 	recombine := 0
 	var longBlocks int
 	cm := 0
-	var resynth int
-	if ctx.encode == 0 {
-		resynth = 1
-	} else {
-		resynth = 0
-	}
+	resynth := ctx.resynth
 	var k int
 	var encode int
 	var tf_change int
@@ -1088,14 +1087,6 @@ func quant_band(ctx *band_ctx, X []int, X_ptr int, N int, b int, B int, lowband
 		if lowband != nil {
 			haar1(lowband, lowband_ptr, N>>k, 1<<k)
 		}
-		idx1 := fill & 0xF
-		idx2 := fill >> 4
-		if idx1 < 0 {
-			fmt.Println("e")
-		}
-		if idx2 < 0 {
-			fmt.Println("e")
-		}
 		fill = int(bit_interleave_table[fill&0xF]) | int(bit_interleave_table[fill>>4])<<2
 	}
 
@@ -1171,10 +1162,7 @@ func quant_band_stereo(ctx *band_ctx, X []int, X_ptr int, Y []int, Y_ptr int, N
 	iside := 0
 	inv := 0
 	cm := 0
-	resynth := 0
-	if ctx.encode == 0 {
-		resynth = 1
-	}
+	resynth := ctx.resynth
 	encode := ctx.encode
 	ec := ctx.ec
 	orig_fill := fill
@@ -1285,7 +1273,7 @@ func quant_band_stereo(ctx *band_ctx, X []int, X_ptr int, Y []int, Y_ptr int, N
 	return cm
 }
 
-func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ []int, collapse_masks []int16, bandE [][]int, pulses []int, shortBlocks int, spread int, dual_stereo int, intensity int, tf_res []int, total_bits int, balance int, ec *EntropyCoder, LM int, codedBands int, seed *BoxedValueInt) {
+func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ []int, collapse_masks []int16, bandE [][]int, pulses []int, shortBlocks int, spread int, dual_stereo int, intensity int, tf_res []int, total_bits int, balance int, ec *EntropyCoder, LM int, codedBands int, seed *BoxedValueInt, disable_inv int, resynth_end int) {
 
 	eBands := m.eBands
 	M := 1 << LM
@@ -1308,21 +1296,25 @@ func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ [
 	}
 
 	ctx := &band_ctx{
-		encode:    encode,
-		m:         m,
-		intensity: intensity,
-		spread:    spread,
-		ec:        ec,
-		bandE:     bandE,
-		seed:      seed.Val,
+		encode:      encode,
+		m:           m,
+		intensity:   intensity,
+		spread:      spread,
+		ec:          ec,
+		bandE:       bandE,
+		seed:        seed.Val,
+		disable_inv: disable_inv,
 	}
 	resynth := 0
 	if encode == 0 {
 		resynth = 1
 	}
+	update_lowband := 1
 
 	for i := start; i < end; i++ {
 		ctx.i = i
+		/* Bands from resynth_end on are only parsed */
+		ctx.resynth = boolToInt(resynth != 0 && i < resynth_end)
 		last := 0
 		if i == end-1 {
 			last = 1
@@ -1346,7 +1338,6 @@ func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ [
 			b = IMAX(0, IMIN(16383, IMIN(remaining_bits+1, pulses[i]+curr_balance)))
 		}
 
-		update_lowband := 1
 		effective_lowband := -1
 		var x_cm = int64(0)
 		var y_cm = int64(0)
@@ -1422,7 +1413,7 @@ func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ [
 		if dual_stereo != 0 {
 			var lowband []int
 			var lowband_out []int
-			if effective_lowband != -1 {
+			if effective_lowband != -1 && ctx.resynth != 0 {
 				lowband = norm
 			}
 			if last == 0 {
@@ -1436,7 +1427,7 @@ func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ [
 		} else {
 			var lowband []int
 			var lowband_out []int
-			if effective_lowband != -1 {
+			if effective_lowband != -1 && ctx.resynth != 0 {
 				lowband = norm
 			}
 			if last == 0 {
@@ -1478,8 +1469,10 @@ func quant_all_bands(encode int, m *CeltMode, start int, end int, X_ []int, Y_ [
 			}
 			y_cm = x_cm
 		}
-		collapse_masks[i*C+0] = int16(x_cm)
-		collapse_masks[i*C+C-1] = int16(y_cm)
+		/* Bytes in the reference; bands left out of the resynthesis return
+		   unmasked values */
+		collapse_masks[i*C+0] = int16(x_cm & 0xFF)
+		collapse_masks[i*C+C-1] = int16(y_cm & 0xFF)
 		balance += pulses[i] + tell
 		if b > N<<BITRES {
 			update_lowband = 1
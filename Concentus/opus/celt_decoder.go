@@ -13,10 +13,13 @@ type CeltDecoder struct {
 	start                 int
 	end                   int
 	signalling            int
+	disable_inv           int
+	complexity            int
 	rng                   int
 	error                 int
 	last_pitch_index      int
 	loss_count            int
+	cng                   int // conceal with comfort noise only, as during DTX
 	postfilter_period     int
 	postfilter_period_old int
 	postfilter_gain       int
@@ -41,6 +44,8 @@ func (this *CeltDecoder) Reset() {
 	this.start = 0
 	this.end = 0
 	this.signalling = 0
+	this.disable_inv = 0
+	this.complexity = 0
 	this.PartialReset()
 }
 
@@ -49,6 +54,7 @@ func (this *CeltDecoder) PartialReset() {
 	this.error = 0
 	this.last_pitch_index = 0
 	this.loss_count = 0
+	this.cng = 0
 	this.postfilter_period = 0
 	this.postfilter_period_old = 0
 	this.postfilter_gain = 0
@@ -116,6 +122,8 @@ func (this *CeltDecoder) opus_custom_decoder_init(mode *CeltMode, channels int)
 	this.start = 0
 	this.end = this.mode.effEBands
 	this.signalling = 1
+	this.disable_inv = boolToInt(channels == 1)
+	this.complexity = 10
 	this.loss_count = 0
 	this.ResetState()
 	return OpusError.OPUS_OK
@@ -136,12 +144,13 @@ func (this *CeltDecoder) celt_decode_lost(N int, LM int) {
 	}
 
 	noise_based := 0
-	if this.loss_count >= 5 || this.start != 0 {
+	/* Below complexity 6, noise replaces the pitch search and repetition */
+	if this.loss_count >= 5 || this.start != 0 || this.cng != 0 || this.complexity < 6 {
 		noise_based = 1
 	}
 	if noise_based != 0 {
 		end := this.end
-		effEnd := IMAX(this.start, IMIN(end, mode.effEBands))
+		effEnd := IMAX(this.start, IMIN(this.synth_end(this.start, end), mode.effEBands))
 
 		X := make([][]int, C)
 		for c := range X {
@@ -155,7 +164,7 @@ func (this *CeltDecoder) celt_decode_lost(N int, LM int) {
 		for c := 0; c < C; c++ {
 			for i := this.start; i < end; i++ {
 				idx := c*nbEBands + i
-				this.oldEBands[idx] = MIN16Int(this.backgroundLogE[idx], this.oldEBands[idx]-int(decay))
+				this.oldEBands[idx] = MAX16Int(this.backgroundLogE[idx], this.oldEBands[idx]-int(decay))
 			}
 		}
 		seed := this.rng
@@ -272,7 +281,12 @@ func (this *CeltDecoder) celt_decode_lost(N int, LM int) {
 				}
 			}
 
-			comb_filter(etmp, 0, buf, CeltConstants.DECODE_BUFFER_SIZE, this.postfilter_period_old, this.postfilter_period, overlap, -this.postfilter_gain_old, -this.postfilter_gain, this.postfilter_tapset_old, this.postfilter_tapset, nil, 0)
+			/* Undo the post-filter, unless it was skipped */
+			if this.complexity >= 8 {
+				comb_filter(etmp, 0, buf, CeltConstants.DECODE_BUFFER_SIZE, this.postfilter_period_old, this.postfilter_period, overlap, -this.postfilter_gain_old, -this.postfilter_gain, this.postfilter_tapset_old, this.postfilter_tapset, nil, 0)
+			} else {
+				copy(etmp[:overlap], buf[CeltConstants.DECODE_BUFFER_SIZE:])
+			}
 
 			for i := 0; i < overlap/2; i++ {
 				buf[CeltConstants.DECODE_BUFFER_SIZE+i] = MULT16_32_Q15Int(window[i], etmp[overlap-1-i]) + MULT16_32_Q15Int(window[overlap-i-1], etmp[i])
@@ -393,15 +407,15 @@ func (ed *CeltDecoder) celt_decode_with_ec(data []byte, data_ptr int, length int
 	postfilter_tapset = 0
 	if start == 0 && tell+16 <= total_bits {
 		if dec.dec_bit_logp(1) != 0 {
-			//var qg int
+			var qg int
 			var octave int
 			octave = int(dec.dec_uint(6))
 			postfilter_pitch = (16 << octave) + dec.dec_bits(4+octave) - 1
-			dec.dec_bits(3)
+			qg = dec.dec_bits(3)
 			if dec.tell()+2 <= total_bits {
 				postfilter_tapset = dec.dec_icdf(tapset_icdf[:], 2)
 			}
-			postfilter_gain = int(math.Floor(0.5 + (0.09375)*(1<<15)))
+			postfilter_gain = int(math.Floor(0.5+(0.09375)*(1<<15))) * (qg + 1)
 		}
 		tell = dec.tell()
 	}
@@ -492,7 +506,7 @@ func (ed *CeltDecoder) celt_decode_with_ec(data []byte, data_ptr int, length int
 	unquant_fine_energy(mode, start, end, oldBandE, fine_quant, dec, C)
 	c = 0
 	for {
-		copy(ed.decode_mem[c][0:], ed.decode_mem[c][N:CeltConstants.DECODE_BUFFER_SIZE-N+overlap/2])
+		copy(ed.decode_mem[c][0:], ed.decode_mem[c][N:CeltConstants.DECODE_BUFFER_SIZE+overlap/2])
 		c++
 		if !(c < CC) {
 			break
@@ -511,7 +525,10 @@ func (ed *CeltDecoder) celt_decode_with_ec(data []byte, data_ptr int, length int
 		Y_ = X[1]
 	}
 
-	quant_all_bands(0, mode, start, end, X[0], Y_, collapse_masks, nil, pulses, shortBlocks, spread_decision, dual_stereo, intensity, tf_res, length*(8<<BITRES)-anti_collapse_rsv, balance, dec, LM, codedBands, boxed_rng)
+	/* Bands above the limit of the complexity are parsed, but left out
+	   of the synthesis */
+	synth_end := ed.synth_end(start, end)
+	quant_all_bands(0, mode, start, end, X[0], Y_, collapse_masks, nil, pulses, shortBlocks, spread_decision, dual_stereo, intensity, tf_res, length*(8<<BITRES)-anti_collapse_rsv, balance, dec, LM, codedBands, boxed_rng, ed.disable_inv, synth_end)
 
 	ed.rng = boxed_rng.Val
 
@@ -522,7 +539,7 @@ func (ed *CeltDecoder) celt_decode_with_ec(data []byte, data_ptr int, length int
 	unquant_energy_finalise(mode, start, end, oldBandE, fine_quant, fine_priority, length*8-dec.tell(), dec, C)
 
 	if anti_collapse_on != 0 {
-		anti_collapse(mode, X, collapse_masks, LM, C, N, start, end, oldBandE, oldLogE, oldLogE2, pulses, ed.rng)
+		anti_collapse(mode, X, collapse_masks, LM, C, N, start, synth_end, oldBandE, oldLogE, oldLogE2, pulses, ed.rng)
 	}
 
 	if silence != 0 {
@@ -530,19 +547,23 @@ func (ed *CeltDecoder) celt_decode_with_ec(data []byte, data_ptr int, length int
 			oldBandE[i] = -int(0.5 + 28.0*float64(int(1)<<CeltConstants.DB_SHIFT))
 		}
 	}
-	celt_synthesis(mode, X, out_syn, out_syn_ptrs, oldBandE, start, effEnd, C, CC, isTransient, LM, ed.downsample, silence)
-
-	c = 0
-	for {
-		ed.postfilter_period = IMAX(ed.postfilter_period, CeltConstants.COMBFILTER_MINPERIOD)
-		ed.postfilter_period_old = IMAX(ed.postfilter_period_old, CeltConstants.COMBFILTER_MINPERIOD)
-		comb_filter(out_syn[c], out_syn_ptrs[c], out_syn[c], out_syn_ptrs[c], ed.postfilter_period_old, ed.postfilter_period, mode.shortMdctSize, ed.postfilter_gain_old, ed.postfilter_gain, ed.postfilter_tapset_old, ed.postfilter_tapset, mode.window, overlap)
-		if LM != 0 {
-			comb_filter(out_syn[c], out_syn_ptrs[c]+mode.shortMdctSize, out_syn[c], out_syn_ptrs[c]+mode.shortMdctSize, ed.postfilter_period, postfilter_pitch, N-mode.shortMdctSize, ed.postfilter_gain, postfilter_gain, ed.postfilter_tapset, postfilter_tapset, mode.window, overlap)
-		}
-		c++
-		if !(c < CC) {
-			break
+	celt_synthesis(mode, X, out_syn, out_syn_ptrs, oldBandE, start, IMIN(effEnd, synth_end), C, CC, isTransient, LM, ed.downsample, silence)
+
+	/* Below complexity 8 the post-filter is skipped, though its parameters
+	   are still tracked */
+	if ed.complexity >= 8 {
+		c = 0
+		for {
+			ed.postfilter_period = IMAX(ed.postfilter_period, CeltConstants.COMBFILTER_MINPERIOD)
+			ed.postfilter_period_old = IMAX(ed.postfilter_period_old, CeltConstants.COMBFILTER_MINPERIOD)
+			comb_filter(out_syn[c], out_syn_ptrs[c], out_syn[c], out_syn_ptrs[c], ed.postfilter_period_old, ed.postfilter_period, mode.shortMdctSize, ed.postfilter_gain_old, ed.postfilter_gain, ed.postfilter_tapset_old, ed.postfilter_tapset, mode.window, overlap)
+			if LM != 0 {
+				comb_filter(out_syn[c], out_syn_ptrs[c]+mode.shortMdctSize, out_syn[c], out_syn_ptrs[c]+mode.shortMdctSize, ed.postfilter_period, postfilter_pitch, N-mode.shortMdctSize, ed.postfilter_gain, postfilter_gain, ed.postfilter_tapset, postfilter_tapset, mode.window, overlap)
+			}
+			c++
+			if !(c < CC) {
+				break
+			}
 		}
 	}
 	ed.postfilter_period_old = ed.postfilter_period
@@ -655,3 +676,50 @@ func (this *CeltDecoder) SetSignalling(value int) {
 func (this *CeltDecoder) GetFinalRange() int {
 	return this.rng
 }
+
+// SetPhaseInversionDisabled makes the decoder ignore the phase inversion of
+// intensity-coded bands. It is the default for mono decoders, so that a
+// stereo stream downmixed in the frequency domain does not cancel out.
+func (this *CeltDecoder) SetPhaseInversionDisabled(value bool) {
+	this.disable_inv = boolToInt(value)
+}
+
+func (this *CeltDecoder) GetPhaseInversionDisabled() bool {
+	return this.disable_inv != 0
+}
+
+// SetComfortNoise makes lost frames noise at the background level the
+// decoder has tracked, rather than an extension of the last pitch period.
+func (this *CeltDecoder) SetComfortNoise(value bool) {
+	this.cng = boolToInt(value)
+}
+
+func (this *CeltDecoder) GetComfortNoise() bool {
+	return this.cng != 0
+}
+
+// SetComplexity trades quality for CPU: below 8 the pitch post-filter is
+// skipped, below 6 lost frames are filled with noise rather than by
+// repeating the last pitch period, and below 5 and 2 the bands above 12 and
+// 8 kHz are left out of the synthesis.
+func (this *CeltDecoder) SetComplexity(value int) {
+	if value < 0 || value > 10 {
+		panic("Complexity must be between 0 and 10")
+	}
+	this.complexity = value
+}
+
+func (this *CeltDecoder) GetComplexity() int {
+	return this.complexity
+}
+
+// Band up to which the complexity lets a frame coded up to end be
+// synthesized: bands 17 and 19 are the first above 8 and 12 kHz.
+func (this *CeltDecoder) synth_end(start int, end int) int {
+	if this.complexity < 2 {
+		end = IMIN(end, 17)
+	} else if this.complexity < 5 {
+		end = IMIN(end, 19)
+	}
+	return IMAX(end, start)
+}
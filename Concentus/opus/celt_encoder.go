@@ -23,6 +23,7 @@ type CeltEncoder struct {
 	lsb_depth         int
 	variable_duration OpusFramesize
 	lfe               int
+	disable_inv       int
 	rng               int
 	spread_decision   int
 	delayedIntra      int
@@ -72,6 +73,7 @@ func (this *CeltEncoder) Reset() {
 	this.lsb_depth = 0
 	this.variable_duration = OPUS_FRAMESIZE_UNKNOWN
 	this.lfe = 0
+	this.disable_inv = 0
 	this.PartialReset()
 }
 
@@ -208,8 +210,7 @@ func (this *CeltEncoder) run_prefilter(input [][]int, prefilter_mem [][]int, CC
 		}
 	} else {
 		gain1 = 0
-		pitch_index := CeltConstants.COMBFILTER_MINPERIOD
-		pitch.Val = pitch_index
+		pitch_index.Val = CeltConstants.COMBFILTER_MINPERIOD
 	}
 
 	pf_threshold := int16(math.Floor(0.5 + 0.2*(1<<15)))
@@ -223,10 +224,10 @@ func (this *CeltEncoder) run_prefilter(input [][]int, prefilter_mem [][]int, CC
 		pf_threshold += int16(math.Floor(0.5 + 0.1*(1<<15)))
 	}
 	if this.prefilter_gain > int(math.Floor(0.5+0.4*(1<<15))) {
-		pf_threshold += int16(math.Floor(0.5 + 0.1*(1<<15)))
+		pf_threshold -= int16(math.Floor(0.5 + 0.1*(1<<15)))
 	}
 	if this.prefilter_gain > int(math.Floor(0.5+0.55*(1<<15))) {
-		pf_threshold += int16(math.Floor(0.5 + 0.1*(1<<15)))
+		pf_threshold -= int16(math.Floor(0.5 + 0.1*(1<<15)))
 	}
 	pf_threshold = MAX16(pf_threshold, int16(math.Floor(0.5+0.2*(1<<15))))
 
@@ -238,7 +239,7 @@ func (this *CeltEncoder) run_prefilter(input [][]int, prefilter_mem [][]int, CC
 		if ABS32(gain1-this.prefilter_gain) < int(math.Floor(0.5+0.1*float64(1<<15))) {
 			gain1 = this.prefilter_gain
 		}
-		qg = ((gain1 + 1536) >> 10) / 3
+		qg = ((gain1+1536)>>10)/3 - 1
 		if qg < 0 {
 			qg = 0
 		} else if qg > 7 {
@@ -259,9 +260,9 @@ func (this *CeltEncoder) run_prefilter(input [][]int, prefilter_mem [][]int, CC
 		}
 		copy(input[c][:overlap], this.in_mem[c])
 		if offset != 0 {
-			comb_filter(input[c][:overlap], overlap, pre[c][:CeltConstants.COMBFILTER_MAXPERIOD], CeltConstants.COMBFILTER_MAXPERIOD, this.prefilter_period, this.prefilter_period, offset, -this.prefilter_gain, -this.prefilter_gain, this.prefilter_tapset, this.prefilter_tapset, nil, 0)
+			comb_filter(input[c], overlap, pre[c], CeltConstants.COMBFILTER_MAXPERIOD, this.prefilter_period, this.prefilter_period, offset, -this.prefilter_gain, -this.prefilter_gain, this.prefilter_tapset, this.prefilter_tapset, nil, 0)
 		}
-		comb_filter(input[c][overlap:overlap+offset], overlap+offset, pre[c][CeltConstants.COMBFILTER_MAXPERIOD+offset:], CeltConstants.COMBFILTER_MAXPERIOD+offset, this.prefilter_period, pitch.Val, N-offset, -this.prefilter_gain, -gain1, this.prefilter_tapset, prefilter_tapset, mode.window, overlap)
+		comb_filter(input[c], overlap+offset, pre[c], CeltConstants.COMBFILTER_MAXPERIOD+offset, this.prefilter_period, pitch.Val, N-offset, -this.prefilter_gain, -gain1, this.prefilter_tapset, prefilter_tapset, mode.window, overlap)
 		copy(this.in_mem[c], input[c][N:N+overlap])
 		if N > CeltConstants.COMBFILTER_MAXPERIOD {
 			copy(prefilter_mem[c], pre[c][N:N+CeltConstants.COMBFILTER_MAXPERIOD])
@@ -275,7 +276,6 @@ func (this *CeltEncoder) run_prefilter(input [][]int, prefilter_mem [][]int, CC
 }
 
 func (this *CeltEncoder) celt_encode_with_ec(pcm []int16, pcm_ptr int, frame_size int, compressed []byte, compressed_ptr int, nbCompressedBytes int, enc *EntropyCoder) int {
-	//PrintFuncArgs(pcm, pcm_ptr, frame_size, compressed, compressed_ptr, nbCompressedBytes, enc)
 
 	var i, c, N int
 	var bits int
@@ -1003,7 +1003,7 @@ func (this *CeltEncoder) celt_encode_with_ec(pcm []int16, pcm_ptr int, frame_siz
 	quant_all_bands(1, mode, start, end, X[0], temp1, collapse_masks,
 		bandE, pulses, shortBlocks, this.spread_decision,
 		dual_stereo, this.intensity, tf_res, nbCompressedBytes*(8<<BITRES)-anti_collapse_rsv,
-		balance, enc, LM, codedBands, &boxed_rng)
+		balance, enc, LM, codedBands, &boxed_rng, this.disable_inv, end)
 	this.rng = boxed_rng.Val
 
 	if anti_collapse_rsv > 0 {
@@ -1219,4 +1219,15 @@ func (this *CeltEncoder) SetEnergyMask(value []int) {
 	this.energy_mask = value
 }
 
+// SetPhaseInversionDisabled stops intensity-coded bands from being sent in
+// opposite phase, which keeps the stereo output safe to downmix to mono at a
+// small cost in stereo image.
+func (this *CeltEncoder) SetPhaseInversionDisabled(value bool) {
+	this.disable_inv = boolToInt(value)
+}
+
+func (this *CeltEncoder) GetPhaseInversionDisabled() bool {
+	return this.disable_inv != 0
+}
+
 // Additional helper functions (like MULT16_16_Q15, ABS32, etc.) would be defined elsewhere
@@ -81,6 +81,8 @@ type SilkChannelEncoder struct {
 	useInBandFEC                  int
 	LBRR_enabled                  int
 	LBRR_GainIncreases            int
+	LBRR_userGainIncreases        int
+	LBRR_userMinRate_bps          int
 	indices_LBRR                  []*SideInfoIndices
 	pulses_LBRR                   [MAX_FRAMES_PER_PACKET][]int8
 	sShape                        *SilkShapeState
@@ -116,6 +118,7 @@ func NewSilkChannelEncoder() *SilkChannelEncoder {
 
 	for i := 0; i < MAX_FRAMES_PER_PACKET; i++ {
 		obj.indices_LBRR[i] = NewSideInfoIndices()
+		obj.pulses_LBRR[i] = make([]int8, SilkConstants.MAX_FRAME_LENGTH)
 	}
 	return obj
 }
@@ -210,6 +213,8 @@ func (s *SilkChannelEncoder) Reset() {
 	s.useInBandFEC = 0
 	s.LBRR_enabled = 0
 	s.LBRR_GainIncreases = 0
+	s.LBRR_userGainIncreases = 0
+	s.LBRR_userMinRate_bps = 0
 	for c := 0; c < SilkConstants.MAX_FRAMES_PER_PACKET; c++ {
 		s.indices_LBRR[c].Reset()
 		for i := range s.pulses_LBRR[c] {
@@ -235,6 +240,8 @@ func (s *SilkChannelEncoder) silk_control_encoder(encControl *EncControlState, T
 	s.minInternal_fs_Hz = encControl.minInternalSampleRate
 	s.desiredInternal_fs_Hz = encControl.desiredInternalSampleRate
 	s.useInBandFEC = encControl.useInBandFEC
+	s.LBRR_userGainIncreases = encControl.lbrrGainIncreases
+	s.LBRR_userMinRate_bps = encControl.lbrrMinRate_bps
 	s.nChannelsAPI = encControl.nChannelsAPI
 	s.nChannelsInternal = encControl.nChannelsInternal
 	s.allow_bandwidth_switch = allow_bw_switch
@@ -268,18 +275,17 @@ func (s *SilkChannelEncoder) silk_setup_resamplers(fs_kHz int) int {
 			ret += silk_resampler_init(s.resampler_state, s.API_fs_Hz, fs_kHz*1000, 1)
 		} else {
 			var x_buf_API_fs_Hz []int16
-			var temp_resampler_state SilkResamplerState
 			api_buf_samples := int(0)
 			old_buf_samples := int(0)
 			buf_length_ms := int(0)
 
 			buf_length_ms = silk_LSHIFT(s.nb_subfr*5, 1) + SilkConstants.LA_SHAPE_MS
 			old_buf_samples = buf_length_ms * s.fs_kHz
-			temp_resampler_state.Reset()
-			ret += silk_resampler_init(&temp_resampler_state, silk_SMULBB(s.fs_kHz, 1000), s.API_fs_Hz, 0)
+			temp_resampler_state := NewSilkResamplerState()
+			ret += silk_resampler_init(temp_resampler_state, silk_SMULBB(s.fs_kHz, 1000), s.API_fs_Hz, 0)
 			api_buf_samples = buf_length_ms * silk_DIV32_16(s.API_fs_Hz, 1000)
 			x_buf_API_fs_Hz = make([]int16, api_buf_samples)
-			ret += silk_resampler(&temp_resampler_state, x_buf_API_fs_Hz, 0, s.x_buf[:], 0, old_buf_samples)
+			ret += silk_resampler(temp_resampler_state, x_buf_API_fs_Hz, 0, s.x_buf[:], 0, old_buf_samples)
 			ret += silk_resampler_init(s.resampler_state, s.API_fs_Hz, silk_SMULBB(fs_kHz, 1000), 1)
 			ret += silk_resampler(s.resampler_state, s.x_buf[:], 0, x_buf_API_fs_Hz, 0, api_buf_samples)
 		}
@@ -505,9 +511,14 @@ func (s *SilkChannelEncoder) silk_setup_LBRR(TargetRate_bps int) int {
 		//	LBRR_rate_thres_bps = silk_SMULWB(silk_MUL(LBRR_rate_thres_bps, 125-silk_min(s.PacketLoss_perc, 25)), silk_SMULWB(0.01, 1<<16))
 
 		LBRR_rate_thres_bps = silk_SMULWB(silk_MUL(LBRR_rate_thres_bps, 125-silk_min(s.PacketLoss_perc, 25)), int(math.Floor(0.01*(1<<(16))+0.5)))
+		if s.LBRR_userMinRate_bps != 0 {
+			LBRR_rate_thres_bps = s.LBRR_userMinRate_bps
+		}
 
 		if TargetRate_bps > LBRR_rate_thres_bps {
-			if LBRR_in_previous_packet == 0 {
+			if s.LBRR_userGainIncreases != 0 {
+				s.LBRR_GainIncreases = s.LBRR_userGainIncreases
+			} else if LBRR_in_previous_packet == 0 {
 				s.LBRR_GainIncreases = 7
 			} else {
 				s.LBRR_GainIncreases = silk_max_int(7-silk_SMULWB(s.PacketLoss_perc, int(math.Floor((0.4)*(1<<(16))+0.5))), 2)
@@ -679,7 +690,7 @@ func (s *SilkChannelEncoder) silk_encode_frame(pnBytesOut *BoxedValueInt, psRang
 		s.silk_LBRR_encode(sEncCtrl, xfw_Q3, condCoding)
 
 		maxIter = 6
-		gainMult_Q8 = int16(silk_SMULWB(1, 1<<8))
+		gainMult_Q8 = int16(1 << 8)
 		found_lower = 0
 		found_upper = 0
 		gainsID = silk_gains_ID(s.indices.GainsIndices[:], s.nb_subfr)
@@ -764,7 +775,7 @@ func (s *SilkChannelEncoder) silk_encode_frame(pnBytesOut *BoxedValueInt, psRang
 				gain_factor_Q16 := silk_log2lin(silk_LSHIFT(nBits-maxBits, 7)/s.frame_length + int(math.Floor(16)*(1<<(7))+0.5))
 				gain_factor_Q16 = silk_min_32(gain_factor_Q16, int(math.Floor((2)*(1<<(16))+0.5)))
 				if nBits > maxBits {
-					gain_factor_Q16 = silk_max_32(gain_factor_Q16, int(math.Floor(1.3)*(1<<(16))+0.5))
+					gain_factor_Q16 = silk_max_32(gain_factor_Q16, int(math.Floor(1.3*(1<<(16))+0.5)))
 				}
 				gainMult_Q8 = int16(silk_SMULWB(gain_factor_Q16, int(gainMult_Q8)))
 			} else {
@@ -805,13 +816,14 @@ func (s *SilkChannelEncoder) silk_LBRR_encode(thisCtrl *SilkEncoderControl, xfw_
 	sNSQ_LBRR := NewSilkNSQState()
 	psIndices_LBRR := s.indices_LBRR[s.nFramesEncoded]
 	TempGains_Q16 := make([]int, s.nb_subfr)
-	if s.LBRR_enabled != 0 && s.speech_activity_Q8 > silk_SMULWB(int(TuningParameters.LBRR_SPEECH_ACTIVITY_THRES), 1<<8) {
+	if s.LBRR_enabled != 0 && s.speech_activity_Q8 > int(float64(TuningParameters.LBRR_SPEECH_ACTIVITY_THRES)*(1<<8)+0.5) {
 		s.LBRR_flags[s.nFramesEncoded] = 1
 
 		sNSQ_LBRR.Assign(s.sNSQ)
 		psIndices_LBRR.Assign(s.indices)
 		copy(TempGains_Q16, thisCtrl.Gains_Q16[:])
 		if s.nFramesEncoded == 0 || s.LBRR_flags[s.nFramesEncoded-1] == 0 {
+			s.LBRRprevLastGainIndex = byte(s.sShape.LastGainIndex)
 			psIndices_LBRR.GainsIndices[0] = int8(silk_min_int(int(psIndices_LBRR.GainsIndices[0])+s.LBRR_GainIncreases, SilkConstants.N_LEVELS_QGAIN-1))
 
 		}
@@ -0,0 +1,232 @@
+// Package quality compares decoded audio with its reference. OpusCompare is
+// the pass/fail measure of the reference opus_compare tool used for the test
+// vectors; Perceptual estimates a MOS in the manner of ViSQOL. Neither
+// depends on the codec, so they judge any decoder alike.
+package quality
+
+import (
+	"errors"
+	"math"
+)
+
+/* Port of opus_compare.c from the reference tools */
+
+const compareNBands = 21
+const compareNFreqs = 240
+const compareWinSize = 480
+const compareWinStep = 120
+
+/*Bands on which we compute the pseudo-NMR (Bark-derived CELT bands).*/
+var compareBands = [compareNBands + 1]int{
+	0, 2, 4, 6, 8, 10, 12, 14, 16, 20, 24, 28, 32, 40, 48, 56, 68, 80, 96, 120, 156, 200,
+}
+
+func compareBandEnergy(out []float32, ps []float32, bands []int, nbands int, in []float32, nchannels int, nframes int, windowSize int, step int, downsample int) {
+	window := make([]float32, windowSize)
+	c := make([]float32, windowSize)
+	s := make([]float32, windowSize)
+	x := make([]float32, nchannels*windowSize)
+	psSize := windowSize / 2
+	for xj := 0; xj < windowSize; xj++ {
+		window[xj] = 0.5 - 0.5*float32(math.Cos(float64(2*math.Pi/float32(windowSize-1)*float32(xj))))
+	}
+	for xj := 0; xj < windowSize; xj++ {
+		c[xj] = float32(math.Cos(float64(2 * math.Pi / float32(windowSize) * float32(xj))))
+	}
+	for xj := 0; xj < windowSize; xj++ {
+		s[xj] = float32(math.Sin(float64(2 * math.Pi / float32(windowSize) * float32(xj))))
+	}
+	for xi := 0; xi < nframes; xi++ {
+		for ci := 0; ci < nchannels; ci++ {
+			for xk := 0; xk < windowSize; xk++ {
+				x[ci*windowSize+xk] = window[xk] * in[(xi*step+xk)*nchannels+ci]
+			}
+		}
+		xj := 0
+		for bi := 0; bi < nbands; bi++ {
+			p := [2]float32{}
+			for ; xj < bands[bi+1]; xj++ {
+				for ci := 0; ci < nchannels; ci++ {
+					var re, im float32
+					ti := 0
+					for xk := 0; xk < windowSize; xk++ {
+						re += c[ti] * x[ci*windowSize+xk]
+						im -= s[ti] * x[ci*windowSize+xk]
+						ti += xj
+						if ti >= windowSize {
+							ti -= windowSize
+						}
+					}
+					re *= float32(downsample)
+					im *= float32(downsample)
+					ps[(xi*psSize+xj)*nchannels+ci] = re*re + im*im + 100000
+					p[ci] += ps[(xi*psSize+xj)*nchannels+ci]
+				}
+			}
+			if out != nil {
+				out[(xi*nbands+bi)*nchannels] = p[0] / float32(bands[bi+1]-bands[bi])
+				if nchannels == 2 {
+					out[(xi*nbands+bi)*nchannels+1] = p[1] / float32(bands[bi+1]-bands[bi])
+				}
+			}
+		}
+	}
+}
+
+// OpusCompare computes the quality measure used by the reference opus_compare
+// tool. x is the 48 kHz reference, y the decoded signal at rate, both
+// interleaved with the given number of channels (1 or 2). The result is the
+// tool's quality percentage; a test vector passes when it is not negative.
+func OpusCompare(x []int16, y []int16, channels int, rate int) (float64, error) {
+	if channels != 1 && channels != 2 {
+		return 0, errors.New("quality: number of channels must be 1 or 2")
+	}
+	ybands := compareNBands
+	yfreqs := compareNFreqs
+	downsample := 1
+	switch rate {
+	case 8000:
+		ybands = 13
+	case 12000:
+		ybands = 15
+	case 16000:
+		ybands = 17
+	case 24000:
+		ybands = 19
+	case 48000:
+	default:
+		return 0, errors.New("quality: sample rate must be 8, 12, 16, 24 or 48 kHz")
+	}
+	downsample = 48000 / rate
+	yfreqs = compareNFreqs / downsample
+
+	xlength := len(x) / channels
+	ylength := len(y) / channels
+	if xlength != ylength*downsample {
+		return 0, errors.New("quality: sample counts do not match")
+	}
+	if xlength < compareWinSize {
+		return 0, errors.New("quality: insufficient sample data")
+	}
+	xf := make([]float32, xlength*channels)
+	for i := range xf {
+		xf[i] = float32(x[i])
+	}
+	yf := make([]float32, ylength*channels)
+	for i := range yf {
+		yf[i] = float32(y[i])
+	}
+
+	nframes := (xlength - compareWinSize + compareWinStep) / compareWinStep
+	xb := make([]float32, nframes*compareNBands*channels)
+	X := make([]float32, nframes*compareNFreqs*channels)
+	Y := make([]float32, nframes*yfreqs*channels)
+	/*Compute the per-band spectral energy of the original signal
+	  and the error.*/
+	compareBandEnergy(xb, X, compareBands[:], compareNBands, xf, channels, nframes, compareWinSize, compareWinStep, 1)
+	compareBandEnergy(nil, Y, compareBands[:], ybands, yf, channels, nframes, compareWinSize/downsample, compareWinStep/downsample, downsample)
+
+	for xi := 0; xi < nframes; xi++ {
+		/*Frequency masking (low to high): 10 dB/Bark slope.*/
+		for bi := 1; bi < compareNBands; bi++ {
+			for ci := 0; ci < channels; ci++ {
+				xb[(xi*compareNBands+bi)*channels+ci] += 0.1 * xb[(xi*compareNBands+bi-1)*channels+ci]
+			}
+		}
+		/*Frequency masking (high to low): 15 dB/Bark slope.*/
+		for bi := compareNBands - 2; bi >= 0; bi-- {
+			for ci := 0; ci < channels; ci++ {
+				xb[(xi*compareNBands+bi)*channels+ci] += 0.03 * xb[(xi*compareNBands+bi+1)*channels+ci]
+			}
+		}
+		if xi > 0 {
+			/*Temporal masking: -3 dB/2.5ms slope.*/
+			for bi := 0; bi < compareNBands; bi++ {
+				for ci := 0; ci < channels; ci++ {
+					xb[(xi*compareNBands+bi)*channels+ci] += 0.5 * xb[((xi-1)*compareNBands+bi)*channels+ci]
+				}
+			}
+		}
+		/* Allowing some cross-talk */
+		if channels == 2 {
+			for bi := 0; bi < compareNBands; bi++ {
+				l := xb[(xi*compareNBands+bi)*channels+0]
+				r := xb[(xi*compareNBands+bi)*channels+1]
+				xb[(xi*compareNBands+bi)*channels+0] += 0.01 * r
+				xb[(xi*compareNBands+bi)*channels+1] += 0.01 * l
+			}
+		}
+
+		/* Apply masking */
+		for bi := 0; bi < ybands; bi++ {
+			for xj := compareBands[bi]; xj < compareBands[bi+1]; xj++ {
+				for ci := 0; ci < channels; ci++ {
+					X[(xi*compareNFreqs+xj)*channels+ci] += 0.1 * xb[(xi*compareNBands+bi)*channels+ci]
+					Y[(xi*yfreqs+xj)*channels+ci] += 0.1 * xb[(xi*compareNBands+bi)*channels+ci]
+				}
+			}
+		}
+	}
+
+	/* Average of consecutive frames to make comparison slightly less sensitive */
+	for bi := 0; bi < ybands; bi++ {
+		for xj := compareBands[bi]; xj < compareBands[bi+1]; xj++ {
+			for ci := 0; ci < channels; ci++ {
+				xtmp := X[xj*channels+ci]
+				ytmp := Y[xj*channels+ci]
+				for xi := 1; xi < nframes; xi++ {
+					xtmp2 := X[(xi*compareNFreqs+xj)*channels+ci]
+					ytmp2 := Y[(xi*yfreqs+xj)*channels+ci]
+					X[(xi*compareNFreqs+xj)*channels+ci] += xtmp
+					Y[(xi*yfreqs+xj)*channels+ci] += ytmp
+					xtmp = xtmp2
+					ytmp = ytmp2
+				}
+			}
+		}
+	}
+
+	/*If working at a lower sampling rate, don't take into account the last
+	  300 Hz to allow for different transition bands.
+	  For 12 kHz, we don't skip anything, because the last band already skips
+	  400 Hz.*/
+	var maxCompare int
+	if rate == 48000 {
+		maxCompare = compareBands[compareNBands]
+	} else if rate == 12000 {
+		maxCompare = compareBands[ybands]
+	} else {
+		maxCompare = compareBands[ybands] - 3
+	}
+	err := 0.0
+	for xi := 0; xi < nframes; xi++ {
+		Ef := 0.0
+		for bi := 0; bi < ybands; bi++ {
+			Eb := 0.0
+			for xj := compareBands[bi]; xj < compareBands[bi+1] && xj < maxCompare; xj++ {
+				for ci := 0; ci < channels; ci++ {
+					re := Y[(xi*yfreqs+xj)*channels+ci] / X[(xi*compareNFreqs+xj)*channels+ci]
+					im := re - float32(math.Log(float64(re))) - 1
+					/*Make comparison less sensitive around the SILK/CELT cross-over to
+					  allow for mode freedom in the filters.*/
+					if xj >= 79 && xj <= 81 {
+						im *= 0.1
+					}
+					if xj == 80 {
+						im *= 0.1
+					}
+					Eb += float64(im)
+				}
+			}
+			Eb /= float64((compareBands[bi+1] - compareBands[bi]) * channels)
+			Ef += Eb * Eb
+		}
+		/*Using a fixed normalization value means we're willing to accept slightly
+		  lower quality for lower sampling rates.*/
+		Ef /= compareNBands
+		Ef *= Ef
+		err += Ef * Ef
+	}
+	err = math.Pow(err/float64(nframes), 1.0/16)
+	return float64(float32(100 * (1 - 0.5*math.Log(1+err)/math.Log(1.13)))), nil
+}
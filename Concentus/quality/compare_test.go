@@ -0,0 +1,79 @@
+package quality
+
+import (
+	"math"
+	"testing"
+)
+
+// A voiced/unvoiced alternation below 4 kHz.
+func testSpeechSignal(channels, samples, rate int) []int16 {
+	out := make([]int16, samples*channels)
+	phase, lp := 0.0, 0.0
+	seed := uint32(1)
+	for i := 0; i < samples; i++ {
+		t := float64(i) / float64(rate)
+		f0 := 140 + 40*math.Sin(2*math.Pi*0.7*t)
+		phase += 2 * math.Pi * f0 / float64(rate)
+		voiced := 0.0
+		for h := 1; float64(h)*f0 < 3800; h++ {
+			voiced += math.Sin(float64(h)*phase) / float64(h)
+		}
+		seed = seed*1664525 + 1013904223
+		lp = 0.7*lp + 0.3*float64(int32(seed)>>16)/32768
+		s := 6000*math.Max(0, math.Sin(2*math.Pi*3*t))*voiced + 3000*math.Max(0, -math.Sin(2*math.Pi*3*t))*lp
+		for c := 0; c < channels; c++ {
+			out[i*channels+c] = int16(s * (1 - 0.2*float64(c)))
+		}
+	}
+	return out
+}
+
+func TestOpusCompareIdentical(t *testing.T) {
+	for _, channels := range []int{1, 2} {
+		ref := testSpeechSignal(channels, 48000, 48000)
+		q, err := OpusCompare(ref, ref, channels, 48000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q != 100 {
+			t.Errorf("%d channels: identical signals scored %v, want 100", channels, q)
+		}
+	}
+}
+
+func TestOpusCompareRejectsMismatch(t *testing.T) {
+	ref := testSpeechSignal(1, 48000, 48000)
+	if _, err := OpusCompare(ref, ref[:len(ref)/2], 1, 16000); err == nil {
+		t.Error("mismatched lengths were accepted")
+	}
+	if _, err := OpusCompare(ref, ref, 1, 44100); err == nil {
+		t.Error("44.1 kHz was accepted")
+	}
+	if _, err := OpusCompare(ref, ref, 3, 48000); err == nil {
+		t.Error("3 channels were accepted")
+	}
+}
+
+// A lower rate copy of the reference, decimated by averaging, only loses
+// the top of the spectrum, which the comparison leaves out.
+func TestOpusCompareLowerRates(t *testing.T) {
+	ref := testSpeechSignal(1, 48000, 48000)
+	for _, rate := range []int{8000, 12000, 16000, 24000} {
+		factor := 48000 / rate
+		deg := make([]int16, len(ref)/factor)
+		for i := range deg {
+			sum := 0
+			for j := 0; j < factor; j++ {
+				sum += int(ref[i*factor+j])
+			}
+			deg[i] = int16(sum / factor)
+		}
+		q, err := OpusCompare(ref, deg, 1, rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q < 0 {
+			t.Errorf("%d Hz: quality %.1f is below the pass threshold", rate, q)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package quality
+
+import (
+	"concentus/internal/fft"
+	"errors"
+	"math"
+)
+
+/* A ViSQOL-style spectro-temporal similarity estimator. Both signals are
+   turned into band energy spectrograms over the CELT band layout, globally
+   aligned, and compared patch by patch with the neurogram similarity index
+   (NSIM). */
+
+const patchFrames = 30
+const floorDB = 45
+const maxDelayMs = 100
+
+// Score is the result of Perceptual.
+type Score struct {
+	NSIM  float64 // mean patch similarity, 0 to 1
+	MOS   float64 // NSIM mapped onto a 1 to 5 scale
+	Delay int     // samples by which the degraded signal lags the reference
+}
+
+func downmix(pcm []int16, channels int) []int {
+	n := len(pcm) / channels
+	out := make([]int, n)
+	for i := 0; i < n; i++ {
+		sum := 0
+		for c := 0; c < channels; c++ {
+			sum += int(pcm[i*channels+c])
+		}
+		out[i] = sum / channels
+	}
+	return out
+}
+
+/* Band edges of the 5 ms CELT bands, in units of 200 Hz */
+var eband5ms = [...]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 10, 12, 14, 16, 20, 24, 28, 34, 40, 48, 60, 78, 100}
+
+/* Picks the FFT size of the 48 kHz CELT mode giving frames of 10 to 15 ms */
+func perceptualFFT(rate int) *fft.State {
+	nfft := 480
+	for nfft > 60 && nfft*1000 > 15*rate {
+		nfft /= 2
+	}
+	return fft.New(nfft)
+}
+
+/* Band edges in FFT bins, from the CELT eBands */
+func perceptualBands(nfft int, rate int) []int {
+	bands := []int{0}
+	for i := 1; i < len(eband5ms); i++ {
+		edge := (eband5ms[i]*200*nfft + rate/2) / rate
+		if edge > nfft/2 {
+			break
+		}
+		if edge > bands[len(bands)-1] {
+			bands = append(bands, edge)
+		}
+	}
+	return bands
+}
+
+/* Returns the log band energies, one row of len(bands)-1 values per hop */
+func spectrogram(x []int, st *fft.State, bands []int) [][]float64 {
+	nfft := st.Size()
+	hop := nfft / 2
+	if len(x) < nfft {
+		return nil
+	}
+	window := make([]float64, nfft)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(nfft))
+	}
+	fin := make([]complex128, nfft)
+	fout := make([]complex128, nfft)
+	frames := (len(x)-nfft)/hop + 1
+	spec := make([][]float64, frames)
+	for f := 0; f < frames; f++ {
+		for i := 0; i < nfft; i++ {
+			/* Scaled to the Q6 input the fixed point CELT FFT was given, so
+			   that the energies keep the same offset against the floor */
+			fin[i] = complex(window[i]*float64(x[f*hop+i]<<6), 0)
+		}
+		st.Forward(fin, fout)
+		row := make([]float64, len(bands)-1)
+		for b := 0; b+1 < len(bands); b++ {
+			E := 0.0
+			for j := bands[b]; j < bands[b+1]; j++ {
+				E += real(fout[j])*real(fout[j]) + imag(fout[j])*imag(fout[j])
+			}
+			row[b] = 10 * math.Log10(1+E/float64(bands[b+1]-bands[b]))
+		}
+		spec[f] = row
+	}
+	return spec
+}
+
+// Finds the lag of y relative to x with the best normalized correlation,
+// first on a decimated copy of (at most) the first three seconds, then
+// sample by sample around it.
+func align(x []int, y []int, maxDelay int, rate int) int {
+	correlate := func(a []float64, b []float64, lag int) float64 {
+		var ab, aa, bb float64
+		for i := max(0, -lag); i < len(a) && i+lag < len(b); i++ {
+			ab += a[i] * b[i+lag]
+			aa += a[i] * a[i]
+			bb += b[i+lag] * b[i+lag]
+		}
+		if aa == 0 || bb == 0 {
+			return 0
+		}
+		return ab / math.Sqrt(aa*bb)
+	}
+	decimate := func(s []int, factor int, n int) []float64 {
+		out := make([]float64, min(len(s), n)/factor)
+		for i := range out {
+			for j := 0; j < factor; j++ {
+				out[i] += float64(s[i*factor+j])
+			}
+		}
+		return out
+	}
+	factor := max(1, rate/12000)
+	n := 3 * rate
+	dx := decimate(x, factor, n)
+	dy := decimate(y, factor, n+maxDelay)
+	bestLag, best := 0, math.Inf(-1)
+	for lag := -maxDelay / factor; lag <= maxDelay/factor; lag++ {
+		if c := correlate(dx, dy, lag); c > best {
+			best, bestLag = c, lag
+		}
+	}
+	fx := decimate(x, 1, n)
+	fy := decimate(y, 1, n+maxDelay)
+	delay := bestLag * factor
+	best = math.Inf(-1)
+	for lag := bestLag*factor - factor; lag <= bestLag*factor+factor; lag++ {
+		if c := correlate(fx, fy, lag); c > best {
+			best, delay = c, lag
+		}
+	}
+	return delay
+}
+
+/* NSIM over one patch, with 3x3 Gaussian weighted local statistics */
+func nsim(r [][]float64, d [][]float64, C1 float64, C2 float64) float64 {
+	w := [3][3]float64{{0.0113, 0.0838, 0.0113}, {0.0838, 0.6193, 0.0838}, {0.0113, 0.0838, 0.0113}}
+	T := len(r)
+	B := len(r[0])
+	sum := 0.0
+	n := 0
+	for t := 1; t+1 < T; t++ {
+		for b := 1; b+1 < B; b++ {
+			var mr, md float64
+			for i := -1; i <= 1; i++ {
+				for j := -1; j <= 1; j++ {
+					mr += w[i+1][j+1] * r[t+i][b+j]
+					md += w[i+1][j+1] * d[t+i][b+j]
+				}
+			}
+			var vr, vd, cov float64
+			for i := -1; i <= 1; i++ {
+				for j := -1; j <= 1; j++ {
+					a := r[t+i][b+j] - mr
+					c := d[t+i][b+j] - md
+					vr += w[i+1][j+1] * a * a
+					vd += w[i+1][j+1] * c * c
+					cov += w[i+1][j+1] * a * c
+				}
+			}
+			intensity := (2*mr*md + C1) / (mr*mr + md*md + C1)
+			structure := (cov + C2) / (math.Sqrt(vr*vd) + C2)
+			sum += intensity * structure
+			n++
+		}
+	}
+	if n == 0 {
+		return 1
+	}
+	return sum / float64(n)
+}
+
+// Perceptual estimates how close the degraded signal sounds to the
+// reference. Both are interleaved at the same rate (8 to 48 kHz) with the
+// given channel count, and are mixed down to mono. Any delay of up to 100 ms
+// in the degraded signal is compensated for.
+func Perceptual(ref []int16, deg []int16, channels int, rate int) (Score, error) {
+	if channels < 1 {
+		return Score{}, errors.New("quality: number of channels must be positive")
+	}
+	if rate != 48000 && rate != 24000 && rate != 16000 && rate != 12000 && rate != 8000 {
+		return Score{}, errors.New("quality: sample rate must be 8, 12, 16, 24 or 48 kHz")
+	}
+	x := downmix(ref, channels)
+	y := downmix(deg, channels)
+	st := perceptualFFT(rate)
+	bands := perceptualBands(st.Size(), rate)
+	if len(x) < (patchFrames+1)*st.Size()/2 || len(y) < st.Size() {
+		return Score{}, errors.New("quality: insufficient sample data")
+	}
+
+	delay := align(x, y, maxDelayMs*rate/1000, rate)
+	if delay >= 0 {
+		y = y[min(delay, len(y)):]
+	} else {
+		x = x[min(-delay, len(x)):]
+	}
+	n := min(len(x), len(y))
+	R := spectrogram(x[:n], st, bands)
+	D := spectrogram(y[:n], st, bands)
+	if len(R) < patchFrames {
+		return Score{}, errors.New("quality: insufficient sample data")
+	}
+
+	/* Clamp both to a floor below the reference peak so inaudible detail
+	   does not count */
+	peak := 0.0
+	for _, row := range R {
+		for _, v := range row {
+			peak = math.Max(peak, v)
+		}
+	}
+	floor := peak - floorDB
+	for f := range R {
+		for b := range R[f] {
+			R[f][b] = math.Max(R[f][b], floor) - floor
+			D[f][b] = math.Max(D[f][b], floor) - floor
+		}
+	}
+	L := float64(floorDB)
+	C1 := (0.01 * L) * (0.01 * L)
+	C2 := (0.03 * L) * (0.03 * L) / 2
+
+	/* Patches that are silent in the reference are skipped, as in ViSQOL */
+	total, patches := 0.0, 0
+	for p := 0; p+patchFrames <= len(R); p += patchFrames {
+		r := R[p : p+patchFrames]
+		active := false
+		for _, row := range r {
+			for _, v := range row {
+				if v > L/2 {
+					active = true
+				}
+			}
+		}
+		if !active {
+			continue
+		}
+		total += nsim(r, D[p:p+patchFrames], C1, C2)
+		patches++
+	}
+	score := Score{NSIM: 1, Delay: delay}
+	if patches > 0 {
+		score.NSIM = math.Max(0, math.Min(1, total/float64(patches)))
+	}
+	/* Linear map that puts NSIM 0.5 at the bottom of the MOS scale */
+	score.MOS = 1 + 4*math.Max(0, (score.NSIM-0.5)/0.5)
+	return score, nil
+}
@@ -0,0 +1,57 @@
+package quality
+
+import (
+	"testing"
+)
+
+func TestPerceptualDelay(t *testing.T) {
+	ref := testSpeechSignal(1, 48000, 48000)
+	for _, delay := range []int{0, 1, 50, 312, 4000} {
+		deg := make([]int16, len(ref)+delay)
+		copy(deg[delay:], ref)
+		score, err := Perceptual(ref, deg, 1, 48000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if score.Delay != delay || score.NSIM < 0.999 {
+			t.Errorf("delay %d: got %+v", delay, score)
+		}
+	}
+}
+
+func TestPerceptualNoise(t *testing.T) {
+	ref := testSpeechSignal(1, 48000, 48000)
+	last := 1.0
+	for _, amp := range []int32{30, 300, 3000} {
+		deg := make([]int16, len(ref))
+		seed := uint32(7)
+		for i := range deg {
+			seed = seed*1664525 + 1013904223
+			deg[i] = ref[i] + int16((int32(seed)>>16)*amp>>15)
+		}
+		score, err := Perceptual(ref, deg, 1, 48000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if score.NSIM >= last {
+			t.Errorf("noise %d: NSIM %.3f did not drop below %.3f", amp, score.NSIM, last)
+		}
+		last = score.NSIM
+	}
+}
+
+func TestPerceptualRates(t *testing.T) {
+	for _, rate := range []int{8000, 12000, 16000, 24000, 48000} {
+		ref := testSpeechSignal(2, rate, rate)
+		score, err := Perceptual(ref, ref, 2, rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if score.Delay != 0 || score.NSIM < 0.999 {
+			t.Errorf("%d Hz: got %+v", rate, score)
+		}
+	}
+	if _, err := Perceptual(make([]int16, 100), make([]int16, 100), 1, 48000); err == nil {
+		t.Error("100 samples were accepted")
+	}
+}
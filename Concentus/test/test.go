@@ -69,14 +69,8 @@ func main() {
 		pcm, _ := BytesToShorts(inBuf, 0, len(inBuf))
 
 		fmt.Printf("imput md5:%s\r\n", ByteSliceToMD5(inBuf))
-		//encoder.PrintAllFields()
 
 		bytesEncoded, err := encoder.Encode(pcm, 0, packetSamples, data_packet, 0, 1275)
-		if i == 37 {
-			//	opus.Debug = true
-		} else {
-			opus.Debug = false
-		}
 		//fmt.Printf("data_packet:%s\r\n", formatSignedBytes(data_packet))
 
 		//fmt.Printf("encoder:%s\r\n", encoder.ResetState())
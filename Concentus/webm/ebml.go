@@ -0,0 +1,325 @@
+package webm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+/* Element IDs, with their length marker, as in the Matroska specification */
+const (
+	idEBML               = 0x1A45DFA3
+	idEBMLVersion        = 0x4286
+	idEBMLReadVersion    = 0x42F7
+	idEBMLMaxIDLength    = 0x42F2
+	idEBMLMaxSizeLength  = 0x42F3
+	idDocType            = 0x4282
+	idDocTypeVersion     = 0x4287
+	idDocTypeReadVersion = 0x4285
+	idVoid               = 0xEC
+
+	idSegment            = 0x18538067
+	idSeekHead           = 0x114D9B74
+	idSeek               = 0x4DBB
+	idSeekID             = 0x53AB
+	idSeekPosition       = 0x53AC
+	idInfo               = 0x1549A966
+	idTimestampScale     = 0x2AD7B1
+	idDuration           = 0x4489
+	idMuxingApp          = 0x4D80
+	idWritingApp         = 0x5741
+	idTracks             = 0x1654AE6B
+	idTrackEntry         = 0xAE
+	idTrackNumber        = 0xD7
+	idTrackUID           = 0x73C5
+	idTrackType          = 0x83
+	idFlagLacing         = 0x9C
+	idCodecID            = 0x86
+	idCodecPrivate       = 0x63A2
+	idCodecDelay         = 0x56AA
+	idSeekPreRoll        = 0x56BB
+	idAudio              = 0xE1
+	idSamplingFrequency  = 0xB5
+	idChannels           = 0x9F
+	idCluster            = 0x1F43B675
+	idTimestamp          = 0xE7
+	idSimpleBlock        = 0xA3
+	idBlockGroup         = 0xA0
+	idBlock              = 0xA1
+	idDiscardPadding     = 0x75A2
+	idCues               = 0x1C53BB6B
+	idCuePoint           = 0xBB
+	idCueTime            = 0xB3
+	idCueTrackPositions  = 0xB7
+	idCueTrack           = 0xF7
+	idCueClusterPosition = 0xF1
+)
+
+const unknownSize = -1
+
+var errMalformed = errors.New("webm: malformed EBML")
+
+// Appends an element ID, which already carries its length marker.
+func appendID(b []byte, id uint32) []byte {
+	switch {
+	case id >= 1<<24:
+		return append(b, byte(id>>24), byte(id>>16), byte(id>>8), byte(id))
+	case id >= 1<<16:
+		return append(b, byte(id>>16), byte(id>>8), byte(id))
+	case id >= 1<<8:
+		return append(b, byte(id>>8), byte(id))
+	}
+	return append(b, byte(id))
+}
+
+// Appends a size in the shortest variable length form.
+func appendSize(b []byte, size int) []byte {
+	n := 1
+	for n < 8 && uint64(size) >= 1<<(7*n)-1 {
+		n++
+	}
+	return appendSizeN(b, size, n)
+}
+
+// Appends a size coded on exactly n bytes.
+func appendSizeN(b []byte, size int, n int) []byte {
+	v := uint64(size) | 1<<(7*n)
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, byte(v>>(8*i)))
+	}
+	return b
+}
+
+func appendElement(b []byte, id uint32, data []byte) []byte {
+	b = appendID(b, id)
+	b = appendSize(b, len(data))
+	return append(b, data...)
+}
+
+func appendUint(b []byte, id uint32, v uint64) []byte {
+	n := 1
+	for n < 8 && v>>(8*n) != 0 {
+		n++
+	}
+	b = appendID(b, id)
+	b = appendSize(b, n)
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, byte(v>>(8*i)))
+	}
+	return b
+}
+
+func appendInt(b []byte, id uint32, v int64) []byte {
+	n := 1
+	for n < 8 && (v < -(1<<(8*n-1)) || v >= 1<<(8*n-1)) {
+		n++
+	}
+	b = appendID(b, id)
+	b = appendSize(b, n)
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, byte(v>>(8*i)))
+	}
+	return b
+}
+
+func appendFloat(b []byte, id uint32, v float64) []byte {
+	b = appendID(b, id)
+	b = appendSize(b, 8)
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(v))
+}
+
+func appendString(b []byte, id uint32, s string) []byte {
+	return appendElement(b, id, []byte(s))
+}
+
+// Counts the bytes read, so that elements can be located for seeking.
+type ebmlReader struct {
+	r   *bufio.Reader
+	src io.Reader
+	pos int64
+}
+
+func newEBMLReader(r io.Reader) *ebmlReader {
+	return &ebmlReader{r: bufio.NewReader(r), src: r}
+}
+
+// Moves to an absolute position; the source must be an io.Seeker.
+func (e *ebmlReader) seek(pos int64) error {
+	s, ok := e.src.(io.Seeker)
+	if !ok {
+		return errors.New("webm: source is not seekable")
+	}
+	if _, err := s.Seek(pos, io.SeekStart); err != nil {
+		return err
+	}
+	e.r.Reset(e.src)
+	e.pos = pos
+	return nil
+}
+
+func (e *ebmlReader) readVint(keepMarker bool) (uint64, int, error) {
+	first, err := e.r.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	e.pos++
+	n := 1
+	for n <= 8 && first&(0x80>>(n-1)) == 0 {
+		n++
+	}
+	if n > 8 {
+		return 0, 0, errMalformed
+	}
+	v := uint64(first)
+	if !keepMarker {
+		v &= 0xFF >> n
+	}
+	for i := 1; i < n; i++ {
+		c, err := e.r.ReadByte()
+		if err != nil {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		e.pos++
+		v = v<<8 | uint64(c)
+	}
+	return v, n, nil
+}
+
+// Reads an element header. The size is unknownSize for elements of unknown
+// length, which only masters may have.
+func (e *ebmlReader) readHeader() (uint32, int64, error) {
+	id, n, err := e.readVint(true)
+	if err != nil {
+		return 0, 0, err
+	}
+	if n > 4 {
+		return 0, 0, errMalformed
+	}
+	size, n, err := e.readVint(false)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, 0, err
+	}
+	if size == 1<<(7*n)-1 {
+		return uint32(id), unknownSize, nil
+	}
+	if size > math.MaxInt32 {
+		return 0, 0, errMalformed
+	}
+	return uint32(id), int64(size), nil
+}
+
+// Elements up to this size are read into a buffer of their size. Larger ones
+// grow their buffer as the data arrives, so that a corrupt size cannot make
+// the reader allocate more than the stream holds.
+const maxPreallocSize = 1 << 16
+
+func (e *ebmlReader) readBytes(size int64) ([]byte, error) {
+	if size < 0 {
+		return nil, errMalformed
+	}
+	if size > maxPreallocSize {
+		var buf bytes.Buffer
+		n, err := buf.ReadFrom(io.LimitReader(e.r, size))
+		e.pos += n
+		if err != nil {
+			return nil, err
+		}
+		if n < size {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return buf.Bytes(), nil
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(e.r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	e.pos += size
+	return b, nil
+}
+
+func (e *ebmlReader) skip(size int64) error {
+	if size < 0 {
+		return errMalformed
+	}
+	n, err := e.r.Discard(int(size))
+	e.pos += int64(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (e *ebmlReader) readUint(size int64) (uint64, error) {
+	if size > 8 {
+		return 0, errMalformed
+	}
+	b, err := e.readBytes(size)
+	if err != nil {
+		return 0, err
+	}
+	return decodeUint(b), nil
+}
+
+func (e *ebmlReader) readFloat(size int64) (float64, error) {
+	b, err := e.readBytes(size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 0:
+		return 0, nil
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	}
+	return 0, errMalformed
+}
+
+func decodeUint(b []byte) uint64 {
+	v := uint64(0)
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func decodeInt(b []byte) int64 {
+	if len(b) == 0 {
+		return 0
+	}
+	v := int64(int8(b[0]))
+	for _, c := range b[1:] {
+		v = v<<8 | int64(c)
+	}
+	return v
+}
+
+// Reads a variable length integer from a buffer, as used by block headers
+// and EBML lacing.
+func parseVint(b []byte) (uint64, int, error) {
+	if len(b) == 0 {
+		return 0, 0, errMalformed
+	}
+	n := 1
+	for n <= 8 && b[0]&(0x80>>(n-1)) == 0 {
+		n++
+	}
+	if n > 8 || len(b) < n {
+		return 0, 0, errMalformed
+	}
+	v := uint64(b[0]) & (0xFF >> n)
+	for i := 1; i < n; i++ {
+		v = v<<8 | uint64(b[i])
+	}
+	return v, n, nil
+}
@@ -0,0 +1,501 @@
+package webm
+
+import (
+	"concentus/opus"
+	"errors"
+	"io"
+	"sort"
+)
+
+// Packet is an Opus packet read from a file.
+type Packet struct {
+	Data      []byte
+	Timestamp int64 // in 48 kHz samples, pre-skip included
+	Duration  int   // in 48 kHz samples
+	Discard   int   // samples at the end of the packet that are not part of the stream
+}
+
+// Reader demuxes the first Opus track of a WebM or Matroska file. Blocks of
+// other tracks, such as the video of a browser recording, are skipped.
+type Reader struct {
+	e     *ebmlReader
+	head  *opus.OpusHead
+	track uint64
+
+	scale       int64 // ns per tick
+	codecDelay  int   // 48 kHz samples
+	preRoll     int   // 48 kHz samples
+	segmentData int64
+	cuesPos     int64 // relative to segmentData, -1 if unknown
+	cues        []cuePoint
+
+	clusterTime int64
+	pending     []Packet // frames of a laced block not returned yet
+}
+
+// NewReader reads the file header up to the first cluster. Seeking requires
+// r to be an io.ReadSeeker.
+func NewReader(r io.Reader) (*Reader, error) {
+	rd := &Reader{e: newEBMLReader(r), scale: timestampScale, cuesPos: -1}
+	id, size, err := rd.e.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	if id != idEBML || size == unknownSize {
+		return nil, errors.New("webm: not an EBML file")
+	}
+	end := rd.e.pos + size
+	for rd.e.pos < end {
+		id, size, err := rd.e.readHeader()
+		if err != nil {
+			return nil, err
+		}
+		if id == idDocType {
+			doc, err := rd.e.readBytes(size)
+			if err != nil {
+				return nil, err
+			}
+			if string(doc) != "webm" && string(doc) != "matroska" {
+				return nil, errors.New("webm: unsupported document type " + string(doc))
+			}
+		} else if err := rd.e.skip(size); err != nil {
+			return nil, err
+		}
+	}
+	if id, _, err = rd.e.readHeader(); err != nil {
+		return nil, err
+	}
+	if id != idSegment {
+		return nil, errors.New("webm: missing segment")
+	}
+	rd.segmentData = rd.e.pos
+
+	for {
+		id, size, err := rd.e.readHeader()
+		if err != nil {
+			return nil, err
+		}
+		switch id {
+		case idInfo:
+			err = rd.readMaster(size, rd.readInfo)
+		case idTracks:
+			err = rd.readMaster(size, rd.readTracks)
+		case idSeekHead:
+			err = rd.readMaster(size, rd.readSeekHead)
+		case idCues:
+			err = rd.readMaster(size, rd.readCues)
+		case idCluster:
+			if rd.head == nil {
+				return nil, errors.New("webm: no Opus track")
+			}
+			rd.loadCues()
+			return rd, nil
+		default:
+			err = rd.e.skip(size)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Head returns the identification header of the track.
+func (rd *Reader) Head() *opus.OpusHead {
+	return rd.head
+}
+
+// CodecDelay returns the number of samples at 48 kHz to discard from the
+// start of the decoded output.
+func (rd *Reader) CodecDelay() int {
+	return rd.codecDelay
+}
+
+// SeekPreRoll returns the number of samples at 48 kHz that have to be
+// decoded before the output is accurate after a seek.
+func (rd *Reader) SeekPreRoll() int {
+	return rd.preRoll
+}
+
+// Calls fn for each child of a master element of known size.
+func (rd *Reader) readMaster(size int64, fn func(id uint32, size int64) error) error {
+	if size == unknownSize {
+		return errMalformed
+	}
+	end := rd.e.pos + size
+	for rd.e.pos < end {
+		id, size, err := rd.e.readHeader()
+		if err != nil {
+			return err
+		}
+		if size == unknownSize || rd.e.pos+size > end {
+			return errMalformed
+		}
+		if err := fn(id, size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rd *Reader) readInfo(id uint32, size int64) error {
+	if id != idTimestampScale {
+		return rd.e.skip(size)
+	}
+	scale, err := rd.e.readUint(size)
+	if err == nil && scale != 0 {
+		rd.scale = int64(scale)
+	}
+	return err
+}
+
+func (rd *Reader) readTracks(id uint32, size int64) error {
+	if id != idTrackEntry || rd.head != nil {
+		return rd.e.skip(size)
+	}
+	var number, delay, preroll uint64
+	var codec string
+	var private []byte
+	err := rd.readMaster(size, func(id uint32, size int64) error {
+		var err error
+		switch id {
+		case idTrackNumber:
+			number, err = rd.e.readUint(size)
+		case idCodecID:
+			var b []byte
+			b, err = rd.e.readBytes(size)
+			codec = string(b)
+		case idCodecPrivate:
+			private, err = rd.e.readBytes(size)
+		case idCodecDelay:
+			delay, err = rd.e.readUint(size)
+		case idSeekPreRoll:
+			preroll, err = rd.e.readUint(size)
+		default:
+			err = rd.e.skip(size)
+		}
+		return err
+	})
+	if err != nil || codec != "A_OPUS" {
+		return err
+	}
+	head, err := opus.ParseOpusHead(private)
+	if err != nil {
+		return err
+	}
+	rd.head = head
+	rd.track = number
+	rd.codecDelay = int((delay*48000 + 500000000) / 1000000000)
+	if delay == 0 {
+		rd.codecDelay = head.PreSkip
+	}
+	rd.preRoll = int(preroll * 48000 / 1000000000)
+	return nil
+}
+
+func (rd *Reader) readSeekHead(id uint32, size int64) error {
+	if id != idSeek {
+		return rd.e.skip(size)
+	}
+	var seekID []byte
+	var position uint64
+	err := rd.readMaster(size, func(id uint32, size int64) error {
+		var err error
+		switch id {
+		case idSeekID:
+			seekID, err = rd.e.readBytes(size)
+		case idSeekPosition:
+			position, err = rd.e.readUint(size)
+		default:
+			err = rd.e.skip(size)
+		}
+		return err
+	})
+	if err == nil && decodeUint(seekID) == idCues {
+		rd.cuesPos = int64(position)
+	}
+	return err
+}
+
+func (rd *Reader) readCues(id uint32, size int64) error {
+	if id != idCuePoint {
+		return rd.e.skip(size)
+	}
+	var c cuePoint
+	track := uint64(0)
+	err := rd.readMaster(size, func(id uint32, size int64) error {
+		var err error
+		var v uint64
+		switch id {
+		case idCueTime:
+			v, err = rd.e.readUint(size)
+			c.time = int64(v)
+		case idCueTrackPositions:
+			err = rd.readMaster(size, func(id uint32, size int64) error {
+				var err error
+				switch id {
+				case idCueTrack:
+					track, err = rd.e.readUint(size)
+				case idCueClusterPosition:
+					v, err = rd.e.readUint(size)
+					c.position = int64(v)
+				default:
+					err = rd.e.skip(size)
+				}
+				return err
+			})
+		default:
+			err = rd.e.skip(size)
+		}
+		return err
+	})
+	if err == nil && (track == rd.track || rd.track == 0) {
+		rd.cues = append(rd.cues, c)
+	}
+	return err
+}
+
+// Reads the cues referenced by the SeekHead when they come after the
+// clusters, and goes back to the first cluster. Files that cannot be seeked
+// are read without cues.
+func (rd *Reader) loadCues() {
+	if rd.cues != nil || rd.cuesPos < 0 {
+		return
+	}
+	if _, ok := rd.e.src.(io.Seeker); !ok {
+		return
+	}
+	back := rd.e.pos
+	if rd.e.seek(rd.segmentData+rd.cuesPos) == nil {
+		if id, size, err := rd.e.readHeader(); err == nil && id == idCues {
+			if rd.readMaster(size, rd.readCues) != nil {
+				rd.cues = nil
+			}
+		}
+	}
+	rd.e.seek(back)
+	sort.Slice(rd.cues, func(i, j int) bool { return rd.cues[i].time < rd.cues[j].time })
+}
+
+// ReadPacket returns the next packet of the track, or io.EOF at the end of
+// the file.
+func (rd *Reader) ReadPacket() (*Packet, error) {
+	for len(rd.pending) == 0 {
+		id, size, err := rd.e.readHeader()
+		if err != nil {
+			return nil, err
+		}
+		switch id {
+		case idSegment, idCluster:
+			/* Descend; clusters may have an unknown size */
+		case idTimestamp:
+			v, err := rd.e.readUint(size)
+			if err != nil {
+				return nil, err
+			}
+			rd.clusterTime = int64(v)
+		case idSimpleBlock:
+			b, err := rd.e.readBytes(size)
+			if err != nil {
+				return nil, err
+			}
+			if err := rd.parseBlock(b, 0); err != nil {
+				return nil, err
+			}
+		case idBlockGroup:
+			var block []byte
+			discard := int64(0)
+			err := rd.readMaster(size, func(id uint32, size int64) error {
+				var err error
+				var b []byte
+				switch id {
+				case idBlock:
+					block, err = rd.e.readBytes(size)
+				case idDiscardPadding:
+					b, err = rd.e.readBytes(size)
+					discard = decodeInt(b)
+				default:
+					err = rd.e.skip(size)
+				}
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			if block != nil {
+				if err := rd.parseBlock(block, int((discard*48000+500000000)/1000000000)); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			if size == unknownSize {
+				return nil, errMalformed
+			}
+			if err := rd.e.skip(size); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p := rd.pending[0]
+	rd.pending = rd.pending[1:]
+	return &p, nil
+}
+
+// Seek moves to the last cluster starting at least SeekPreRoll before the
+// given timestamp, in 48 kHz samples with the pre-skip included, so that the
+// packets read next let a decoder converge before reaching it. Only
+// io.SeekStart is supported. Returns the timestamp of the cluster. The
+// decoder should be reset before decoding from the new position. Seeking
+// requires cues and a seekable source.
+func (rd *Reader) Seek(timestamp int64, whence int) (int64, error) {
+	if whence != io.SeekStart {
+		return 0, errors.New("webm: only io.SeekStart is supported")
+	}
+	if len(rd.cues) == 0 {
+		return 0, errors.New("webm: file has no cues")
+	}
+	target := timestamp - int64(rd.preRoll)
+	i := sort.Search(len(rd.cues), func(i int) bool {
+		return rd.ticksToSamples(rd.cues[i].time) > target
+	})
+	c := rd.cues[max(i-1, 0)]
+	rd.pending = nil
+	if err := rd.e.seek(rd.segmentData + c.position); err != nil {
+		return 0, err
+	}
+	return rd.ticksToSamples(c.time), nil
+}
+
+func (rd *Reader) ticksToSamples(t int64) int64 {
+	return t * rd.scale * 48000 / 1000000000
+}
+
+// DecodePacket reads the next packet and decodes it with dec, which must
+// have been created for the track, for instance with Head().CreateDecoder.
+// The codec delay at the start of the stream and the discard padding at its
+// end are removed. Returns the number of samples per channel left in pcm,
+// which may be zero.
+func (rd *Reader) DecodePacket(dec *opus.OpusDecoder, pcm []int16) (int, error) {
+	p, err := rd.ReadPacket()
+	if err != nil {
+		return 0, err
+	}
+	channels := rd.head.Channels
+	n, err := dec.Decode(p.Data, 0, len(p.Data), pcm, 0, len(pcm)/channels, false)
+	if err != nil {
+		return 0, err
+	}
+	Fs := int64(dec.GetSampleRate())
+	skip := int(max(0, int64(rd.codecDelay)-p.Timestamp) * Fs / 48000)
+	end := n - int(int64(p.Discard)*Fs/48000)
+	if skip >= end {
+		return 0, nil
+	}
+	copy(pcm, pcm[skip*channels:end*channels])
+	return end - skip, nil
+}
+
+// Splits a block into packets. discard applies to the last one.
+func (rd *Reader) parseBlock(b []byte, discard int) error {
+	track, n, err := parseVint(b)
+	if err != nil || len(b) < n+3 {
+		return errMalformed
+	}
+	if track != rd.track {
+		return nil
+	}
+	rel := int64(int16(uint16(b[n])<<8 | uint16(b[n+1])))
+	flags := b[n+2]
+	data := b[n+3:]
+	var frames [][]byte
+	switch flags & 0x06 {
+	case 0x00:
+		frames = [][]byte{data}
+	default:
+		if frames, err = unlace(data, flags&0x06); err != nil {
+			return err
+		}
+	}
+	ts := rd.ticksToSamples(rd.clusterTime + rel)
+	for i, f := range frames {
+		duration := opus.GetNumSamples(f, 0, len(f), 48000)
+		if duration < 0 {
+			return opus.ErrorFromCode(duration)
+		}
+		p := Packet{Data: f, Timestamp: ts, Duration: duration}
+		if i == len(frames)-1 {
+			p.Discard = discard
+		}
+		rd.pending = append(rd.pending, p)
+		ts += int64(duration)
+	}
+	return nil
+}
+
+// Splits the data of a laced block into frames.
+func unlace(data []byte, lacing byte) ([][]byte, error) {
+	if len(data) < 1 {
+		return nil, errMalformed
+	}
+	count := int(data[0]) + 1
+	data = data[1:]
+	sizes := make([]int, count)
+	switch lacing {
+	case 0x02: /* Xiph */
+		for i := 0; i < count-1; i++ {
+			for {
+				if len(data) == 0 {
+					return nil, errMalformed
+				}
+				c := data[0]
+				data = data[1:]
+				sizes[i] += int(c)
+				if c != 255 {
+					break
+				}
+			}
+		}
+	case 0x06: /* EBML */
+		v, n, err := parseVint(data)
+		if err != nil {
+			return nil, err
+		}
+		sizes[0] = int(v)
+		data = data[n:]
+		for i := 1; i < count-1; i++ {
+			v, n, err := parseVint(data)
+			if err != nil {
+				return nil, err
+			}
+			/* Signed difference to the previous size */
+			diff := int(v) - (1<<(7*n-1) - 1)
+			sizes[i] = sizes[i-1] + diff
+			data = data[n:]
+		}
+	case 0x04: /* fixed */
+		if len(data)%count != 0 {
+			return nil, errMalformed
+		}
+		for i := range sizes {
+			sizes[i] = len(data) / count
+		}
+	}
+	if lacing != 0x04 {
+		total := 0
+		for i := 0; i < count-1; i++ {
+			if sizes[i] < 0 {
+				return nil, errMalformed
+			}
+			total += sizes[i]
+		}
+		if total > len(data) {
+			return nil, errMalformed
+		}
+		sizes[count-1] = len(data) - total
+	}
+	frames := make([][]byte, count)
+	for i, s := range sizes {
+		frames[i] = data[:s]
+		data = data[s:]
+	}
+	return frames, nil
+}
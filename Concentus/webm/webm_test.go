@@ -0,0 +1,251 @@
+package webm
+
+import (
+	"bytes"
+	"concentus/opus"
+	"errors"
+	"io"
+	"math"
+	"runtime"
+	"testing"
+)
+
+// An in-memory io.WriteSeeker.
+type seekBuffer struct {
+	data []byte
+	pos  int
+}
+
+func (b *seekBuffer) Write(p []byte) (int, error) {
+	if need := b.pos + len(p); need > len(b.data) {
+		b.data = append(b.data, make([]byte, need-len(b.data))...)
+	}
+	copy(b.data[b.pos:], p)
+	b.pos += len(p)
+	return len(p), nil
+}
+
+func (b *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		b.pos = int(offset)
+	case io.SeekCurrent:
+		b.pos += int(offset)
+	case io.SeekEnd:
+		b.pos = len(b.data) + int(offset)
+	}
+	return int64(b.pos), nil
+}
+
+func testEncoder(t *testing.T) *opus.OpusEncoder {
+	enc, err := opus.NewOpusEncoder(48000, 1, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.SetForceMode(opus.MODE_SILK_ONLY)
+	enc.SetMaxBandwidth(opus.OPUS_BANDWIDTH_WIDEBAND)
+	enc.SetBitrate(16000)
+	return enc
+}
+
+func testPackets(t *testing.T, enc *opus.OpusEncoder, count int) [][]byte {
+	pcm := make([]int16, 960)
+	buf := make([]byte, 1275)
+	var packets [][]byte
+	for f := 0; f < count; f++ {
+		for i := range pcm {
+			pcm[i] = int16(8000 * math.Sin(2*math.Pi*440*float64(f*960+i)/48000))
+		}
+		n, err := enc.Encode(pcm, 0, 960, buf, 0, len(buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		packets = append(packets, append([]byte(nil), buf[:n]...))
+	}
+	return packets
+}
+
+func TestWebMRoundTrip(t *testing.T) {
+	enc := testEncoder(t)
+	packets := testPackets(t, enc, 600) /* 12 s, three clusters */
+	head := enc.GetOpusHead()
+	out := &seekBuffer{}
+	w, err := NewWriter(out, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, p := range packets {
+		if i == len(packets)-1 {
+			err = w.WritePacketTrimmed(p, 500)
+		} else {
+			err = w.WritePacket(p)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(bytes.NewReader(out.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Head(); got.Channels != 1 || got.PreSkip != head.PreSkip || r.CodecDelay() != head.PreSkip {
+		t.Fatalf("head %+v, codec delay %d, want pre-skip %d", got, r.CodecDelay(), head.PreSkip)
+	}
+	if r.SeekPreRoll() != 3840 || len(r.cues) != 3 {
+		t.Fatalf("pre-roll %d, %d cues", r.SeekPreRoll(), len(r.cues))
+	}
+	for i, want := range packets {
+		p, err := r.ReadPacket()
+		if err != nil {
+			t.Fatalf("packet %d: %v", i, err)
+		}
+		if !bytes.Equal(p.Data, want) || p.Timestamp != int64(i*960) || p.Duration != 960 {
+			t.Fatalf("packet %d: timestamp %d duration %d, %d bytes", i, p.Timestamp, p.Duration, len(p.Data))
+		}
+		if (i == len(packets)-1) != (p.Discard == 500) {
+			t.Fatalf("packet %d: discard %d", i, p.Discard)
+		}
+	}
+	if _, err := r.ReadPacket(); err != io.EOF {
+		t.Fatalf("got %v at the end of the file", err)
+	}
+
+	/* Seeking lands on a cluster early enough for the pre-roll */
+	target := int64(7 * 48000)
+	ts, err := r.Seek(target, io.SeekStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := r.ReadPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts != 5*48000 || p.Timestamp != ts || !bytes.Equal(p.Data, packets[ts/960]) {
+		t.Fatalf("seek to %d landed at %d, next packet at %d", target, ts, p.Timestamp)
+	}
+}
+
+func TestWebMDecodeTrimsDelayAndPadding(t *testing.T) {
+	enc := testEncoder(t)
+	packets := testPackets(t, enc, 50)
+	var out bytes.Buffer /* not seekable: live layout, no cues */
+	w, _ := NewWriter(&out, enc.GetOpusHead())
+	for _, p := range packets[:len(packets)-1] {
+		w.WritePacket(p)
+	}
+	w.WritePacketTrimmed(packets[len(packets)-1], 720)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err == nil {
+		t.Fatal("seeking a file without cues succeeded")
+	}
+	dec, err := r.Head().CreateDecoder(16000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pcm := make([]int16, 5760)
+	total := 0
+	for {
+		n, err := r.DecodePacket(dec, pcm)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += n
+	}
+	want := (len(packets)*960 - enc.GetOpusHead().PreSkip - 720) / 3
+	if total != want {
+		t.Fatalf("decoded %d samples, want %d", total, want)
+	}
+}
+
+func TestWebMMultichannelHead(t *testing.T) {
+	streams := opus.BoxedValueInt{}
+	coupled := opus.BoxedValueInt{}
+	mapping := make([]int16, 6)
+	ms, err := opus.CreateSurroundOpusMSEncoder(48000, 6, 1, &streams, &coupled, mapping, opus.OPUS_APPLICATION_AUDIO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := ms.GetOpusHead()
+	var out bytes.Buffer
+	w, _ := NewWriter(&out, head)
+	/* Any packet will do, the track is only checked for its header */
+	w.WritePacket(testPackets(t, testEncoder(t), 1)[0])
+	w.Close()
+	r, err := NewReader(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := r.Head()
+	if got.Channels != 6 || got.MappingFamily != 1 || got.StreamCount != 4 || got.CoupledCount != 2 ||
+		!bytes.Equal(got.ChannelMapping, []byte{0, 4, 1, 2, 3, 5}) {
+		t.Fatalf("head %+v", got)
+	}
+	if _, err := got.CreateDecoder(48000); !errors.Is(err, opus.ErrBadArg) {
+		t.Fatalf("CreateDecoder on a surround head: %v", err)
+	}
+	if _, err := got.CreateMSDecoder(48000); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnlace(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		lacing byte
+		data   []byte
+		sizes  []int
+	}{
+		{"xiph", 0x02, append([]byte{2, 255, 1, 3}, make([]byte, 256+3+5)...), []int{256, 3, 5}},
+		{"ebml", 0x06, append([]byte{2, 0x81, 0xBF + 2}, make([]byte, 1+3+4)...), []int{1, 3, 4}},
+		{"fixed", 0x04, append([]byte{1}, make([]byte, 8)...), []int{4, 4}},
+	} {
+		frames, err := unlace(tc.data, tc.lacing)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if len(frames) != len(tc.sizes) {
+			t.Fatalf("%s: %d frames", tc.name, len(frames))
+		}
+		for i, f := range frames {
+			if len(f) != tc.sizes[i] {
+				t.Fatalf("%s: frame %d has %d bytes, want %d", tc.name, i, len(f), tc.sizes[i])
+			}
+		}
+	}
+}
+
+func TestReadBytesCorruptSize(t *testing.T) {
+	data := bytes.Repeat([]byte{0xAA}, 3*maxPreallocSize)
+	for _, size := range []int64{10, maxPreallocSize + 1, 2 * maxPreallocSize} {
+		b, err := newEBMLReader(bytes.NewReader(data)).readBytes(size)
+		if err != nil || int64(len(b)) != size {
+			t.Fatalf("size %d: read %d bytes, %v", size, len(b), err)
+		}
+	}
+
+	/* A size near the limit of a header, over a short stream */
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := newEBMLReader(bytes.NewReader(data)).readBytes(math.MaxInt32)
+	runtime.ReadMemStats(&after)
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 64*maxPreallocSize {
+		t.Fatalf("allocated %d bytes for a %d byte stream", alloc, len(data))
+	}
+}
@@ -0,0 +1,239 @@
+// Package webm reads and writes Opus audio in WebM and Matroska files.
+//
+// Timestamps are kept in samples at 48 kHz. Block timestamps start at zero
+// with the first packet, pre-skip included; the pre-skip is signalled with
+// CodecDelay as the WebM Opus mapping requires.
+package webm
+
+import (
+	"concentus/opus"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+const (
+	timestampScale = 1000000 // ns per tick
+	seekPreRoll    = 80000000
+	trackNumber    = 1
+
+	/* A new cluster is started after this long, and always before the
+	   16-bit relative block timestamps would overflow */
+	clusterDuration = 5000
+
+	/* Room left after the track header for a SeekHead pointing at the cues */
+	seekHeadReserve = 64
+)
+
+type cuePoint struct {
+	time     int64 // ticks
+	position int64 // cluster offset from the start of the segment data
+}
+
+// Writer muxes Opus packets into a single-track WebM file. When the
+// destination is an io.WriteSeeker, Close fills in the segment size, the
+// duration and a SeekHead pointing at the cues; otherwise the file is
+// written in live form with an unknown segment size.
+type Writer struct {
+	w    io.Writer
+	head *opus.OpusHead
+	pos  int64
+
+	segmentSizePos int64 // where the 8-byte segment size goes
+	segmentData    int64
+	durationPos    int64 // where the 8-byte duration goes
+	seekHeadPos    int64
+
+	cluster     []byte
+	clusterTime int64
+	samples     int64 // 48 kHz samples written so far
+	cues        []cuePoint
+	closed      bool
+}
+
+// NewWriter writes the file header for an Opus track described by head,
+// which is usually obtained with GetOpusHead from the encoder.
+func NewWriter(w io.Writer, head *opus.OpusHead) (*Writer, error) {
+	wr := &Writer{w: w, head: head}
+
+	var b []byte
+	var hdr []byte
+	hdr = appendUint(hdr, idEBMLVersion, 1)
+	hdr = appendUint(hdr, idEBMLReadVersion, 1)
+	hdr = appendUint(hdr, idEBMLMaxIDLength, 4)
+	hdr = appendUint(hdr, idEBMLMaxSizeLength, 8)
+	hdr = appendString(hdr, idDocType, "webm")
+	hdr = appendUint(hdr, idDocTypeVersion, 4)
+	hdr = appendUint(hdr, idDocTypeReadVersion, 2)
+	b = appendElement(b, idEBML, hdr)
+
+	b = appendID(b, idSegment)
+	wr.segmentSizePos = int64(len(b))
+	b = append(b, 0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+	wr.segmentData = int64(len(b))
+
+	var info []byte
+	info = appendUint(info, idTimestampScale, timestampScale)
+	info = appendString(info, idMuxingApp, "concentus")
+	info = appendString(info, idWritingApp, opus.GetVersionString())
+	info = appendID(info, idDuration)
+	info = appendSize(info, 8)
+	infoDuration := len(info)
+	info = binary.BigEndian.AppendUint64(info, math.Float64bits(0))
+	b = appendID(b, idInfo)
+	b = appendSize(b, len(info))
+	wr.durationPos = int64(len(b) + infoDuration)
+	b = append(b, info...)
+
+	var audio []byte
+	audio = appendFloat(audio, idSamplingFrequency, 48000)
+	audio = appendUint(audio, idChannels, uint64(head.Channels))
+	var track []byte
+	track = appendUint(track, idTrackNumber, trackNumber)
+	track = appendUint(track, idTrackUID, trackNumber)
+	track = appendUint(track, idTrackType, 2)
+	track = appendUint(track, idFlagLacing, 0)
+	track = appendString(track, idCodecID, "A_OPUS")
+	track = appendElement(track, idCodecPrivate, head.Marshal())
+	track = appendUint(track, idCodecDelay, uint64(head.PreSkip)*1000000000/48000)
+	track = appendUint(track, idSeekPreRoll, seekPreRoll)
+	track = appendElement(track, idAudio, audio)
+	b = appendElement(b, idTracks, appendElement(nil, idTrackEntry, track))
+
+	wr.seekHeadPos = int64(len(b))
+	b = appendVoid(b, seekHeadReserve)
+
+	if err := wr.write(b); err != nil {
+		return nil, err
+	}
+	return wr, nil
+}
+
+// A Void element of exactly n bytes, n >= 9.
+func appendVoid(b []byte, n int) []byte {
+	b = appendID(b, idVoid)
+	b = appendSizeN(b, n-9, 8)
+	return append(b, make([]byte, n-9)...)
+}
+
+func (wr *Writer) write(b []byte) error {
+	n, err := wr.w.Write(b)
+	wr.pos += int64(n)
+	return err
+}
+
+// WritePacket appends a packet. Its duration is read from the TOC.
+func (wr *Writer) WritePacket(packet []byte) error {
+	return wr.WritePacketTrimmed(packet, 0)
+}
+
+// WritePacketTrimmed appends a packet whose last discard samples, at 48 kHz,
+// are not part of the stream. This is how the end of the final packet is
+// trimmed to the exact input length.
+func (wr *Writer) WritePacketTrimmed(packet []byte, discard int) error {
+	if wr.closed {
+		return errors.New("webm: writer is closed")
+	}
+	duration := opus.GetNumSamples(packet, 0, len(packet), 48000)
+	if duration < 0 {
+		return opus.ErrorFromCode(duration)
+	}
+	if discard < 0 || discard > duration {
+		return errors.New("webm: discard exceeds the packet duration")
+	}
+	time := wr.samples * 1000000000 / 48000 / timestampScale
+	if wr.cluster != nil && time-wr.clusterTime >= clusterDuration {
+		if err := wr.flushCluster(); err != nil {
+			return err
+		}
+	}
+	if wr.cluster == nil {
+		wr.clusterTime = time
+		wr.cluster = appendUint(wr.cluster, idTimestamp, uint64(time))
+	}
+
+	block := []byte{0x80 | trackNumber, 0, 0, 0}
+	binary.BigEndian.PutUint16(block[1:], uint16(int16(time-wr.clusterTime)))
+	if discard == 0 {
+		block[3] = 0x80 /* keyframe */
+		wr.cluster = appendElement(wr.cluster, idSimpleBlock, append(block, packet...))
+	} else {
+		var group []byte
+		group = appendElement(group, idBlock, append(block, packet...))
+		group = appendInt(group, idDiscardPadding, int64(discard)*1000000000/48000)
+		wr.cluster = appendElement(wr.cluster, idBlockGroup, group)
+	}
+	wr.samples += int64(duration)
+	return nil
+}
+
+func (wr *Writer) flushCluster() error {
+	if wr.cluster == nil {
+		return nil
+	}
+	wr.cues = append(wr.cues, cuePoint{wr.clusterTime, wr.pos - wr.segmentData})
+	err := wr.write(appendElement(nil, idCluster, wr.cluster))
+	wr.cluster = nil
+	return err
+}
+
+// Close writes the last cluster and the cues. It does not close the
+// underlying writer.
+func (wr *Writer) Close() error {
+	if wr.closed {
+		return nil
+	}
+	wr.closed = true
+	if err := wr.flushCluster(); err != nil {
+		return err
+	}
+	cuesPos := wr.pos - wr.segmentData
+	var cues []byte
+	for _, c := range wr.cues {
+		var tp []byte
+		tp = appendUint(tp, idCueTrack, trackNumber)
+		tp = appendUint(tp, idCueClusterPosition, uint64(c.position))
+		var cp []byte
+		cp = appendUint(cp, idCueTime, uint64(c.time))
+		cp = appendElement(cp, idCueTrackPositions, tp)
+		cues = appendElement(cues, idCuePoint, cp)
+	}
+	if len(cues) > 0 {
+		if err := wr.write(appendElement(nil, idCues, cues)); err != nil {
+			return err
+		}
+	}
+
+	ws, ok := wr.w.(io.WriteSeeker)
+	if !ok {
+		return nil
+	}
+	end := wr.pos
+	patch := func(pos int64, b []byte) error {
+		if _, err := ws.Seek(pos, io.SeekStart); err != nil {
+			return err
+		}
+		_, err := ws.Write(b)
+		return err
+	}
+	if err := patch(wr.segmentSizePos, appendSizeN(nil, int(end-wr.segmentData), 8)); err != nil {
+		return err
+	}
+	duration := float64(wr.samples) * 1000000000 / 48000 / timestampScale
+	if err := patch(wr.durationPos, binary.BigEndian.AppendUint64(nil, math.Float64bits(duration))); err != nil {
+		return err
+	}
+	if len(cues) > 0 {
+		var seek []byte
+		seek = appendElement(seek, idSeekID, appendID(nil, idCues))
+		seek = appendUint(seek, idSeekPosition, uint64(cuesPos))
+		seekHead := appendElement(nil, idSeekHead, appendElement(nil, idSeek, seek))
+		seekHead = appendVoid(seekHead, seekHeadReserve-len(seekHead))
+		if err := patch(wr.seekHeadPos, seekHead); err != nil {
+			return err
+		}
+	}
+	_, err := ws.Seek(end, io.SeekStart)
+	return err
+}
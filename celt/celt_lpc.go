@@ -43,6 +43,9 @@ func _celt_lpc(_lpc []opus_val16, ac []opus_val32, p int) {
 		}
 	}
 }
+
+// celt_fir_c filters the N samples of x that follow its first ord ones, which
+// hold the history of the filter.
 func celt_fir_c(x []opus_val16, num []opus_val16, y []opus_val16, N int, ord int, arch int) {
 	var (
 		i int
@@ -54,21 +57,20 @@ func celt_fir_c(x []opus_val16, num []opus_val16, y []opus_val16, N int, ord int
 	}
 	for i = 0; i < N-3; i += 4 {
 		var sum [4]opus_val32
-		sum[0] = opus_val32(x[i])
-		sum[1] = opus_val32(x[i+1])
-		sum[2] = opus_val32(x[i+2])
-		sum[3] = opus_val32(x[i+3])
-		_ = arch
-		xcorr_kernel_c(rnum, x[i-ord:], sum, ord)
+		sum[0] = opus_val32(x[ord+i])
+		sum[1] = opus_val32(x[ord+i+1])
+		sum[2] = opus_val32(x[ord+i+2])
+		sum[3] = opus_val32(x[ord+i+3])
+		xcorr_kernel(rnum, x[i:], &sum, ord, arch)
 		y[i] = opus_val16(sum[0])
 		y[i+1] = opus_val16(sum[1])
 		y[i+2] = opus_val16(sum[2])
 		y[i+3] = opus_val16(sum[3])
 	}
 	for ; i < N; i++ {
-		var sum opus_val32 = opus_val32(x[i])
+		var sum opus_val32 = opus_val32(x[ord+i])
 		for j = 0; j < ord; j++ {
-			sum = sum + opus_val32(rnum[j])*opus_val32(x[i+j-ord])
+			sum = sum + opus_val32(rnum[j]*x[i+j])
 		}
 		y[i] = opus_val16(sum)
 	}
@@ -95,8 +97,7 @@ func celt_iir(_x []opus_val32, den []opus_val16, _y []opus_val32, N int, ord int
 		sum[1] = _x[i+1]
 		sum[2] = _x[i+2]
 		sum[3] = _x[i+3]
-		_ = arch
-		xcorr_kernel_c(rden, y[i:], sum, ord)
+		xcorr_kernel(rden, y[i:], &sum, ord, arch)
 		y[i+ord] = opus_val16(-(sum[0]))
 		_y[i] = sum[0]
 		sum[1] = (sum[1]) + opus_val32(y[i+ord])*opus_val32(den[0])
@@ -147,7 +148,7 @@ func _celt_autocorr(x []opus_val16, ac []opus_val32, window []opus_val16, overla
 		xptr = xx
 	}
 	shift = 0
-	PitchXcorrC(xptr, xptr, ac, fastN, lag+1, arch)
+	PitchXcorr(xptr, xptr, ac, fastN, lag+1, arch)
 	for k = 0; k <= lag; k++ {
 		for func() opus_val32 {
 			i = k + fastN
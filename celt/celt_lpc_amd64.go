@@ -0,0 +1,43 @@
+//go:build !purego
+
+package celt
+
+/* The filters run the whole of their blocks of outputs in assembly, rather
+   than calling the xcorr kernel once per block, each output lane summing its
+   taps in the order of the C code. */
+
+//go:noescape
+func celt_fir_sse_asm(rnum []opus_val16, x []opus_val16, y []opus_val16, N int, ord int)
+
+//go:noescape
+func celt_fir_avx2_asm(rnum []opus_val16, x []opus_val16, y []opus_val16, N int, ord int)
+
+var celt_fir_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val16, int, int, int){
+	celt_fir_c, celt_fir_sse, celt_fir_sse, celt_fir_sse,
+	celt_fir_avx2, celt_fir_avx2, celt_fir_avx2, celt_fir_avx2,
+}
+
+func celt_fir_sse(x []opus_val16, num []opus_val16, y []opus_val16, N int, ord int, arch int) {
+	rnum := reversed(num, ord)
+	n := N &^ 3
+	if n > 0 {
+		_, _ = x[ord+n-1], y[n-1]
+		celt_fir_sse_asm(rnum, x, y, n, ord)
+	}
+	celt_fir_tail(x, rnum, y, n, N, ord)
+}
+
+func celt_fir_avx2(x []opus_val16, num []opus_val16, y []opus_val16, N int, ord int, arch int) {
+	rnum := reversed(num, ord)
+	n := N &^ 7
+	if n > 0 {
+		_, _ = x[ord+n-1], y[n-1]
+		celt_fir_avx2_asm(rnum, x, y, n, ord)
+	}
+	if N-n >= 4 {
+		_, _ = x[ord+n+3], y[n+3]
+		celt_fir_sse_asm(rnum, x[n:], y[n:], 4, ord)
+		n += 4
+	}
+	celt_fir_tail(x, rnum, y, n, N, ord)
+}
@@ -0,0 +1,75 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func celt_fir_sse_asm(rnum []opus_val16, x []opus_val16, y []opus_val16, N int, ord int)
+// y[i] = x[ord+i] + rnum[j]*x[i+j] summed over j < ord in order, four
+// outputs at a time; N is a positive multiple of 4.
+TEXT ·celt_fir_sse_asm(SB), NOSPLIT, $0-88
+	MOVQ rnum_base+0(FP), R8
+	MOVQ x_base+24(FP), SI
+	MOVQ y_base+48(FP), DI
+	MOVQ N+72(FP), BX
+	MOVQ ord+80(FP), R9
+
+fir4_block:
+	MOVUPS (SI)(R9*4), X0
+	MOVQ   R8, DX
+	MOVQ   SI, AX
+	MOVQ   R9, CX
+	TESTQ  CX, CX
+	JZ     fir4_store
+
+fir4_tap:
+	MOVSS  (DX), X1
+	SHUFPS $0x00, X1, X1
+	MOVUPS (AX), X2
+	MULPS  X1, X2
+	ADDPS  X2, X0
+	ADDQ   $4, DX
+	ADDQ   $4, AX
+	DECQ   CX
+	JNZ    fir4_tap
+
+fir4_store:
+	MOVUPS X0, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DI
+	SUBQ   $4, BX
+	JNZ    fir4_block
+	RET
+
+// func celt_fir_avx2_asm(rnum []opus_val16, x []opus_val16, y []opus_val16, N int, ord int)
+// As celt_fir_sse_asm, eight outputs at a time; N is a positive multiple of 8.
+TEXT ·celt_fir_avx2_asm(SB), NOSPLIT, $0-88
+	MOVQ rnum_base+0(FP), R8
+	MOVQ x_base+24(FP), SI
+	MOVQ y_base+48(FP), DI
+	MOVQ N+72(FP), BX
+	MOVQ ord+80(FP), R9
+
+fir8_block:
+	VMOVUPS (SI)(R9*4), Y0
+	MOVQ    R8, DX
+	MOVQ    SI, AX
+	MOVQ    R9, CX
+	TESTQ   CX, CX
+	JZ      fir8_store
+
+fir8_tap:
+	VBROADCASTSS (DX), Y1
+	VMULPS       (AX), Y1, Y2
+	VADDPS       Y2, Y0, Y0
+	ADDQ         $4, DX
+	ADDQ         $4, AX
+	DECQ         CX
+	JNZ          fir8_tap
+
+fir8_store:
+	VMOVUPS Y0, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DI
+	SUBQ    $8, BX
+	JNZ     fir8_block
+	VZEROUPPER
+	RET
@@ -0,0 +1,23 @@
+//go:build !purego
+
+package celt
+
+/* As on x86, the filter runs whole blocks of four outputs in assembly. */
+
+//go:noescape
+func celt_fir_neon_asm(rnum []opus_val16, x []opus_val16, y []opus_val16, N int, ord int)
+
+var celt_fir_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val16, int, int, int){
+	celt_fir_c, celt_fir_c, celt_fir_c, celt_fir_neon,
+	celt_fir_neon, celt_fir_neon, celt_fir_neon, celt_fir_neon,
+}
+
+func celt_fir_neon(x []opus_val16, num []opus_val16, y []opus_val16, N int, ord int, arch int) {
+	rnum := reversed(num, ord)
+	n := N &^ 3
+	if n > 0 {
+		_, _ = x[ord+n-1], y[n-1]
+		celt_fir_neon_asm(rnum, x, y, n, ord)
+	}
+	celt_fir_tail(x, rnum, y, n, N, ord)
+}
@@ -0,0 +1,41 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// The assembler has no mnemonics for the vector FMUL and FADD.
+#define FMUL_V2_V2_V1 WORD $0x6E21DC42 // FMUL V2.4S, V2.4S, V1.4S
+#define FADD_V0_V0_V2 WORD $0x4E22D400 // FADD V0.4S, V0.4S, V2.4S
+
+// func celt_fir_neon_asm(rnum []opus_val16, x []opus_val16, y []opus_val16, N int, ord int)
+// y[i] = x[ord+i] + rnum[j]*x[i+j] summed over j < ord in order, four
+// outputs at a time; N is a positive multiple of 4.
+TEXT ·celt_fir_neon_asm(SB), NOSPLIT, $0-88
+	MOVD rnum_base+0(FP), R0
+	MOVD x_base+24(FP), R1
+	MOVD y_base+48(FP), R2
+	MOVD N+72(FP), R3
+	MOVD ord+80(FP), R4
+
+fir_block:
+	ADD  R4<<2, R1, R5
+	VLD1 (R5), [V0.S4]
+	MOVD R0, R6
+	MOVD R1, R7
+	MOVD R4, R8
+	CBZ  R8, fir_store
+
+fir_tap:
+	VLD1R.P 4(R6), [V1.S4]
+	VLD1    (R7), [V2.S4]
+	ADD     $4, R7
+	FMUL_V2_V2_V1
+	FADD_V0_V0_V2
+	SUBS    $1, R8
+	BNE     fir_tap
+
+fir_store:
+	VST1.P [V0.S4], 16(R2)
+	ADD    $16, R1
+	SUBS   $4, R3
+	BNE    fir_block
+	RET
@@ -0,0 +1,8 @@
+//go:build purego || (!amd64 && !arm64)
+
+package celt
+
+var celt_fir_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val16, int, int, int){
+	celt_fir_c, celt_fir_c, celt_fir_c, celt_fir_c,
+	celt_fir_c, celt_fir_c, celt_fir_c, celt_fir_c,
+}
@@ -0,0 +1,27 @@
+package celt
+
+// Architecture levels, as returned by OpusSelectArch and threaded through the
+// DSP routines as arch. The values follow celt/cpu_support.h; the x86 and ARM
+// levels overlap, each build only knowing its own.
+const (
+	OPUS_ARCH_C = 0
+
+	OPUS_ARCH_X86_SSE    = 1
+	OPUS_ARCH_X86_SSE2   = 2
+	OPUS_ARCH_X86_SSE4_1 = 3
+	OPUS_ARCH_X86_AVX2   = 4
+
+	OPUS_ARCH_ARM_EDSP  = 1
+	OPUS_ARCH_ARM_MEDIA = 2
+	OPUS_ARCH_ARM_NEON  = 3
+
+	OPUS_ARCHMASK = 7
+)
+
+var selected_arch = cpu_arch()
+
+// OpusSelectArch returns the highest architecture level the CPU supports,
+// detected once at start-up.
+func OpusSelectArch() int {
+	return selected_arch
+}
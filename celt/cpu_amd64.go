@@ -0,0 +1,29 @@
+//go:build !purego
+
+package celt
+
+func cpuid(eax uint32, ecx uint32) (a uint32, b uint32, c uint32, d uint32)
+
+func xgetbv() (eax uint32, edx uint32)
+
+func cpu_arch() int {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 1 {
+		return OPUS_ARCH_X86_SSE2
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&(1<<19) == 0 {
+		return OPUS_ARCH_X86_SSE2
+	}
+	/* AVX2 also needs the OS to save the YMM registers */
+	if maxID < 7 || ecx1&(1<<27) == 0 || ecx1&(1<<28) == 0 {
+		return OPUS_ARCH_X86_SSE4_1
+	}
+	if xcr0, _ := xgetbv(); xcr0&0x6 != 0x6 {
+		return OPUS_ARCH_X86_SSE4_1
+	}
+	if _, ebx7, _, _ := cpuid(7, 0); ebx7&(1<<5) == 0 {
+		return OPUS_ARCH_X86_SSE4_1
+	}
+	return OPUS_ARCH_X86_AVX2
+}
@@ -0,0 +1,8 @@
+//go:build !purego
+
+package celt
+
+/* Advanced SIMD is part of every ARMv8-A core */
+func cpu_arch() int {
+	return OPUS_ARCH_ARM_NEON
+}
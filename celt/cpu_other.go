@@ -0,0 +1,7 @@
+//go:build purego || (!amd64 && !arm64)
+
+package celt
+
+func cpu_arch() int {
+	return OPUS_ARCH_C
+}
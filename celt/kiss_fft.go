@@ -0,0 +1,73 @@
+package celt
+
+// KissFftCpx is a complex sample or twiddle of the float KISS FFT.
+type KissFftCpx struct {
+	R float32
+	I float32
+}
+
+// Products are rounded to float32 one by one, as C_MUL does, so that no
+// multiply-add is fused and the kernels can match them bit for bit.
+func c_mul(a KissFftCpx, b KissFftCpx) KissFftCpx {
+	return KissFftCpx{
+		R: float32(a.R*b.R) - float32(a.I*b.I),
+		I: float32(a.R*b.I) + float32(a.I*b.R),
+	}
+}
+
+// One radix-4 butterfly over Fout[0], Fout[m], Fout[2m] and Fout[3m].
+func kf_bfly4_one(Fout []KissFftCpx, m int, tw1 KissFftCpx, tw2 KissFftCpx, tw3 KissFftCpx) {
+	var scratch [6]KissFftCpx
+	scratch[0] = c_mul(Fout[m], tw1)
+	scratch[1] = c_mul(Fout[2*m], tw2)
+	scratch[2] = c_mul(Fout[3*m], tw3)
+	scratch[5] = KissFftCpx{Fout[0].R - scratch[1].R, Fout[0].I - scratch[1].I}
+	Fout[0].R += scratch[1].R
+	Fout[0].I += scratch[1].I
+	scratch[3] = KissFftCpx{scratch[0].R + scratch[2].R, scratch[0].I + scratch[2].I}
+	scratch[4] = KissFftCpx{scratch[0].R - scratch[2].R, scratch[0].I - scratch[2].I}
+	Fout[2*m] = KissFftCpx{Fout[0].R - scratch[3].R, Fout[0].I - scratch[3].I}
+	Fout[0].R += scratch[3].R
+	Fout[0].I += scratch[3].I
+	Fout[m] = KissFftCpx{scratch[5].R + scratch[4].I, scratch[5].I - scratch[4].R}
+	Fout[3*m] = KissFftCpx{scratch[5].R - scratch[4].I, scratch[5].I + scratch[4].R}
+}
+
+func kf_bfly4_c(Fout []KissFftCpx, fstride int, twiddles []KissFftCpx, m int, N int, mm int) {
+	for i := 0; i < N; i++ {
+		kf_bfly4_cols(Fout[i*mm:], fstride, twiddles, m, 0, m)
+	}
+}
+
+// The butterflies of columns j0 <= j < j1 of one group of 4*m outputs.
+func kf_bfly4_cols(Fout []KissFftCpx, fstride int, twiddles []KissFftCpx, m int, j0 int, j1 int) {
+	for j := j0; j < j1; j++ {
+		kf_bfly4_one(Fout[j:], m, twiddles[j*fstride], twiddles[2*j*fstride], twiddles[3*j*fstride])
+	}
+}
+
+// KfBfly4 runs the radix-4 butterflies of one stage of the float KISS FFT
+// (kf_bfly4 in celt/kiss_fft.c) on N groups mm apart, each of 4*m outputs.
+// The twiddles of column j are twiddles[j*fstride], twiddles[2*j*fstride]
+// and twiddles[3*j*fstride]. arch is the level selected once for the FFT
+// state, as for the other kernels, not looked up per call.
+func KfBfly4(Fout []KissFftCpx, fstride int, twiddles []KissFftCpx, m int, N int, mm int, arch int) {
+	if m == 1 {
+		/* Degenerate case where all the twiddles are 1 */
+		for i := 0; i < N; i++ {
+			f := Fout[4*i : 4*i+4]
+			scratch0 := KissFftCpx{f[0].R - f[2].R, f[0].I - f[2].I}
+			f[0].R += f[2].R
+			f[0].I += f[2].I
+			scratch1 := KissFftCpx{f[1].R + f[3].R, f[1].I + f[3].I}
+			f[2] = KissFftCpx{f[0].R - scratch1.R, f[0].I - scratch1.I}
+			f[0].R += scratch1.R
+			f[0].I += scratch1.I
+			scratch1 = KissFftCpx{f[1].R - f[3].R, f[1].I - f[3].I}
+			f[1] = KissFftCpx{scratch0.R + scratch1.I, scratch0.I - scratch1.R}
+			f[3] = KissFftCpx{scratch0.R - scratch1.I, scratch0.I + scratch1.R}
+		}
+		return
+	}
+	kf_bfly4_impl[arch&OPUS_ARCHMASK](Fout, fstride, twiddles, m, N, mm)
+}
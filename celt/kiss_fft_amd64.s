@@ -0,0 +1,100 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// a = a*t for the two complexes of each vector, products rounded one by one
+// as in C_MUL. X14 holds the sign of the real lanes; r is scratch and t is
+// overwritten.
+#define CMUL(a, t, r) \
+	MOVAPS t, r        \
+	SHUFPS $0xA0, r, r \
+	MULPS  a, r        \
+	SHUFPS $0xF5, t, t \
+	SHUFPS $0xB1, a, a \
+	MULPS  t, a        \
+	XORPS  X14, a      \
+	ADDPS  r, a
+
+// func kf_bfly4_sse_asm(Fout []KissFftCpx, twiddles []KissFftCpx, fstride int, m int, N int, mm int)
+// Columns j < m&^1 of the N groups of butterflies; m >= 2, N > 0.
+TEXT ·kf_bfly4_sse_asm(SB), NOSPLIT, $0-80
+	MOVQ Fout_base+0(FP), DI
+	MOVQ fstride+48(FP), R8
+	MOVQ m+56(FP), R9
+	MOVQ N+64(FP), R10
+	MOVQ mm+72(FP), R11
+	SHLQ $3, R8
+	LEAQ (R8)(R8*1), R13
+	LEAQ (R13)(R8*1), R14
+	MOVQ R9, R12
+	SHLQ $3, R12
+	SHLQ $3, R11
+	SHRQ $1, R9
+
+	// X15 holds the sign of the imaginary lanes, X14 that of the real ones.
+	PCMPEQL X15, X15
+	PSLLQ   $63, X15
+	MOVAPS  X15, X14
+	PSRLQ   $32, X14
+
+bfly4_group:
+	MOVQ twiddles_base+24(FP), AX
+	MOVQ AX, CX
+	MOVQ AX, R15
+	MOVQ DI, BX
+	LEAQ (BX)(R12*2), DX
+	ADDQ R12, DX
+	MOVQ R9, SI
+
+bfly4_pair:
+	MOVLPS (AX), X4
+	MOVHPS (AX)(R8*1), X4
+	MOVLPS (CX), X5
+	MOVHPS (CX)(R13*1), X5
+	MOVLPS (R15), X6
+	MOVHPS (R15)(R14*1), X6
+	MOVUPS (BX)(R12*1), X1
+	MOVUPS (BX)(R12*2), X2
+	MOVUPS (DX), X3
+	CMUL(X1, X4, X7)
+	CMUL(X2, X5, X7)
+	CMUL(X3, X6, X7)
+
+	// X5 = scratch[5], X0 = Fout[0] + scratch[1]
+	MOVUPS (BX), X0
+	MOVAPS X0, X5
+	SUBPS  X2, X5
+	ADDPS  X2, X0
+
+	// X4 = scratch[3], X1 = scratch[4]
+	MOVAPS X1, X4
+	ADDPS  X3, X4
+	SUBPS  X3, X1
+
+	MOVAPS X0, X2
+	SUBPS  X4, X2
+	ADDPS  X4, X0
+
+	// X1 = (scratch[4].I, -scratch[4].R), added for Fout[m], subtracted for Fout[3m]
+	SHUFPS $0xB1, X1, X1
+	XORPS  X15, X1
+	MOVAPS X5, X3
+	SUBPS  X1, X3
+	ADDPS  X1, X5
+
+	MOVUPS X0, (BX)
+	MOVUPS X5, (BX)(R12*1)
+	MOVUPS X2, (BX)(R12*2)
+	MOVUPS X3, (DX)
+	ADDQ   $16, BX
+	ADDQ   $16, DX
+	LEAQ   (AX)(R8*2), AX
+	LEAQ   (CX)(R13*2), CX
+	LEAQ   (R15)(R14*2), R15
+	DECQ   SI
+	JNZ    bfly4_pair
+
+	ADDQ R11, DI
+	DECQ R10
+	JNZ  bfly4_group
+	RET
@@ -0,0 +1,29 @@
+//go:build !purego
+
+package celt
+
+/* As on x86, two columns of butterflies share each vector, the twiddles of
+   both gathered into it. A column left over when m is odd is done in Go. */
+
+//go:noescape
+func kf_bfly4_neon_asm(Fout []KissFftCpx, twiddles []KissFftCpx, fstride int, m int, N int, mm int)
+
+var kf_bfly4_impl = [OPUS_ARCHMASK + 1]func([]KissFftCpx, int, []KissFftCpx, int, int, int){
+	kf_bfly4_c, kf_bfly4_c, kf_bfly4_c, kf_bfly4_neon,
+	kf_bfly4_neon, kf_bfly4_neon, kf_bfly4_neon, kf_bfly4_neon,
+}
+
+func kf_bfly4_neon(Fout []KissFftCpx, fstride int, twiddles []KissFftCpx, m int, N int, mm int) {
+	if N <= 0 {
+		return
+	}
+	_, _ = Fout[(N-1)*mm+4*m-1], twiddles[3*(m-1)*fstride]
+	if m >= 2 {
+		kf_bfly4_neon_asm(Fout, twiddles, fstride, m, N, mm)
+	}
+	if m&1 != 0 {
+		for i := 0; i < N; i++ {
+			kf_bfly4_cols(Fout[i*mm:], fstride, twiddles, m, m-1, m)
+		}
+	}
+}
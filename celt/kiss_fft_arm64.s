@@ -0,0 +1,106 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// The assembler has no mnemonics for the vector FMUL, FADD and FSUB. The
+// permutes are encoded the same way, so that all the macros take register
+// numbers.
+#define FMUL_4S(d, n, m)  WORD $(0x6E20DC00 | (m<<16) | (n<<5) | d)
+#define FADD_4S(d, n, m)  WORD $(0x4E20D400 | (m<<16) | (n<<5) | d)
+#define FSUB_4S(d, n, m)  WORD $(0x4EA0D400 | (m<<16) | (n<<5) | d)
+#define TRN1_4S(d, n, m)  WORD $(0x4E802800 | (m<<16) | (n<<5) | d)
+#define TRN2_4S(d, n, m)  WORD $(0x4E806800 | (m<<16) | (n<<5) | d)
+#define REV64_4S(d, n)    WORD $(0x4EA00800 | (n<<5) | d)
+
+// Va = Va*Vt for the two complexes of each vector, products rounded one by
+// one as in C_MUL. V14 holds the sign of the real lanes; V7 is scratch and Vt
+// is overwritten.
+#define CMUL(a, t, va) \
+	TRN1_4S(7, t, t)           \
+	TRN2_4S(t, t, t)           \
+	FMUL_4S(7, a, 7)           \
+	REV64_4S(a, a)             \
+	FMUL_4S(a, a, t)           \
+	VEOR V14.B16, va.B16, va.B16 \
+	FADD_4S(a, a, 7)
+
+// func kf_bfly4_neon_asm(Fout []KissFftCpx, twiddles []KissFftCpx, fstride int, m int, N int, mm int)
+// Columns j < m&^1 of the N groups of butterflies; m >= 2, N > 0.
+TEXT ·kf_bfly4_neon_asm(SB), NOSPLIT, $0-80
+	MOVD Fout_base+0(FP), R0
+	MOVD fstride+48(FP), R2
+	MOVD m+56(FP), R6
+	MOVD N+64(FP), R4
+	MOVD mm+72(FP), R5
+	LSL  $3, R2, R2
+	ADD  R2<<1, R2, R19
+	LSL  $1, R2, R15
+	LSL  $3, R6, R3
+	LSL  $3, R5, R5
+	LSR  $1, R6, R6
+
+	// V15 holds the sign of the imaginary lanes, V14 that of the real ones.
+	MOVD $0x8000000000000000, R20
+	VDUP R20, V15.D2
+	MOVD $0x80000000, R20
+	VDUP R20, V14.D2
+
+bfly4_group:
+	MOVD twiddles_base+24(FP), R11
+	MOVD R11, R12
+	MOVD R11, R13
+	MOVD R0, R7
+	ADD  R3, R7, R8
+	ADD  R3, R8, R9
+	ADD  R3, R9, R10
+	MOVD R6, R14
+
+bfly4_pair:
+	VLD1 (R11), V4.D[0]
+	ADD  R2, R11, R20
+	VLD1 (R20), V4.D[1]
+	VLD1 (R12), V5.D[0]
+	ADD  R15, R12, R20
+	VLD1 (R20), V5.D[1]
+	VLD1 (R13), V6.D[0]
+	ADD  R19, R13, R20
+	VLD1 (R20), V6.D[1]
+	ADD  R15, R11, R11
+	ADD  R15<<1, R12, R12
+	ADD  R19<<1, R13, R13
+	VLD1 (R8), [V1.S4]
+	VLD1 (R9), [V2.S4]
+	VLD1 (R10), [V3.S4]
+	CMUL(1, 4, V1)
+	CMUL(2, 5, V2)
+	CMUL(3, 6, V3)
+
+	// V5 = scratch[5], V0 = Fout[0] + scratch[1]
+	VLD1 (R7), [V0.S4]
+	FSUB_4S(5, 0, 2)
+	FADD_4S(0, 0, 2)
+
+	// V4 = scratch[3], V1 = scratch[4]
+	FADD_4S(4, 1, 3)
+	FSUB_4S(1, 1, 3)
+
+	FSUB_4S(2, 0, 4)
+	FADD_4S(0, 0, 4)
+
+	// V1 = (scratch[4].I, -scratch[4].R), added for Fout[m], subtracted for Fout[3m]
+	REV64_4S(1, 1)
+	VEOR V15.B16, V1.B16, V1.B16
+	FSUB_4S(3, 5, 1)
+	FADD_4S(5, 5, 1)
+
+	VST1.P [V0.S4], 16(R7)
+	VST1.P [V5.S4], 16(R8)
+	VST1.P [V2.S4], 16(R9)
+	VST1.P [V3.S4], 16(R10)
+	SUBS   $1, R14
+	BNE    bfly4_pair
+
+	ADD  R5, R0
+	SUBS $1, R4
+	BNE  bfly4_group
+	RET
@@ -0,0 +1,8 @@
+//go:build purego || (!amd64 && !arm64)
+
+package celt
+
+var kf_bfly4_impl = [OPUS_ARCHMASK + 1]func([]KissFftCpx, int, []KissFftCpx, int, int, int){
+	kf_bfly4_c, kf_bfly4_c, kf_bfly4_c, kf_bfly4_c,
+	kf_bfly4_c, kf_bfly4_c, kf_bfly4_c, kf_bfly4_c,
+}
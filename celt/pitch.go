@@ -6,7 +6,10 @@ import (
 	"github.com/gotranspile/cxgo/runtime/cmath"
 )
 
-func xcorr_kernel_c(x []opus_val16, y []opus_val16, sum [4]opus_val32, len_ int) {
+// The reference kernels round every product before adding it, as explicit
+// conversions do, so that the compiler does not fuse them into
+// multiply-adds and the SIMD kernels can match them bit for bit.
+func xcorr_kernel_c(x []opus_val16, y []opus_val16, sum *[4]opus_val32, len_ int) {
 	var (
 		j   int
 		y_0 opus_val16
@@ -26,34 +29,34 @@ func xcorr_kernel_c(x []opus_val16, y []opus_val16, sum [4]opus_val32, len_ int)
 		x = x[1:]
 		y_3 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_0)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_1)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_2)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_3)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_0)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_1)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_2)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_3)
 		tmp = x[0]
 		x = x[1:]
 		y_0 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_1)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_2)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_3)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_0)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_1)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_2)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_3)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_0)
 		tmp = x[0]
 		x = x[1:]
 		y_1 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_2)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_3)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_0)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_1)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_2)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_3)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_0)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_1)
 		tmp = x[0]
 		x = x[1:]
 		y_2 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_3)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_0)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_1)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_2)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_3)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_0)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_1)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_2)
 	}
 	if func() int {
 		p := &j
@@ -65,10 +68,10 @@ func xcorr_kernel_c(x []opus_val16, y []opus_val16, sum [4]opus_val32, len_ int)
 		x = x[1:]
 		y_3 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_0)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_1)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_2)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_3)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_0)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_1)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_2)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_3)
 	}
 	if func() int {
 		p := &j
@@ -80,20 +83,20 @@ func xcorr_kernel_c(x []opus_val16, y []opus_val16, sum [4]opus_val32, len_ int)
 		x = x[1:]
 		y_0 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_1)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_2)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_3)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_0)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_1)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_2)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_3)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_0)
 	}
 	if j < len_ {
 		tmp := x[0]
 		x = x[1:]
 		y_1 = y[0]
 		y = y[1:]
-		sum[0] = (sum[0]) + opus_val32(tmp)*opus_val32(y_2)
-		sum[1] = (sum[1]) + opus_val32(tmp)*opus_val32(y_3)
-		sum[2] = (sum[2]) + opus_val32(tmp)*opus_val32(y_0)
-		sum[3] = (sum[3]) + opus_val32(tmp)*opus_val32(y_1)
+		sum[0] = (sum[0]) + opus_val32(tmp*y_2)
+		sum[1] = (sum[1]) + opus_val32(tmp*y_3)
+		sum[2] = (sum[2]) + opus_val32(tmp*y_0)
+		sum[3] = (sum[3]) + opus_val32(tmp*y_1)
 	}
 }
 func dual_inner_prod_c(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int, xy1 *opus_val32, xy2 *opus_val32) {
@@ -102,8 +105,8 @@ func dual_inner_prod_c(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int
 		xy02 opus_val32
 	)
 	for i := 0; i < N; i++ {
-		xy01 = xy01 + opus_val32(x[i])*opus_val32(y01[i])
-		xy02 = xy02 + opus_val32(x[i])*opus_val32(y02[i])
+		xy01 = xy01 + opus_val32(x[i]*y01[i])
+		xy02 = xy02 + opus_val32(x[i]*y02[i])
 	}
 	*xy1 = xy01
 	*xy2 = xy02
@@ -111,7 +114,7 @@ func dual_inner_prod_c(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int
 func celt_inner_prod_c(x []opus_val16, y []opus_val16, N int) opus_val32 {
 	var xy opus_val32
 	for i := 0; i < N; i++ {
-		xy = xy + opus_val32(x[i])*opus_val32(y[i])
+		xy = xy + opus_val32(x[i]*y[i])
 	}
 	return xy
 }
@@ -243,7 +246,7 @@ func PitchXcorrC(_x []opus_val16, _y []opus_val16, xcorr []opus_val32, len_ int,
 	var i int
 	for i = 0; i < max_pitch-3; i += 4 {
 		var sum [4]opus_val32
-		xcorr_kernel_c(_x, _y[i:], sum, len_)
+		xcorr_kernel_c(_x, _y[i:], &sum, len_)
 		xcorr[i+0] = sum[0]
 		xcorr[i+1] = sum[1]
 		xcorr[i+2] = sum[2]
@@ -272,7 +275,7 @@ func pitch_search(x_lp []opus_val16, y []opus_val16, len_ int, max_pitch int, pi
 	for j := 0; j < lag>>2; j++ {
 		y_lp4[j] = y[j*2]
 	}
-	PitchXcorrC(x_lp4, y_lp4, xcorr, len_>>2, max_pitch>>2, arch)
+	PitchXcorr(x_lp4, y_lp4, xcorr, len_>>2, max_pitch>>2, arch)
 	find_best_pitch(xcorr, y_lp4, len_>>2, max_pitch>>2, best_pitch[:])
 	for i := 0; i < max_pitch>>1; i++ {
 		var sum opus_val32
@@ -280,10 +283,7 @@ func pitch_search(x_lp []opus_val16, y []opus_val16, len_ int, max_pitch int, pi
 		if cmath.Abs(int64(i-best_pitch[0]*2)) > 2 && cmath.Abs(int64(i-best_pitch[1]*2)) > 2 {
 			continue
 		}
-		sum = func() opus_val32 {
-			_ = arch
-			return celt_inner_prod_c(x_lp, y[i:], len_>>1)
-		}()
+		sum = celt_inner_prod(x_lp, y[i:], len_>>1, arch)
 		if -1 > sum {
 			xcorr[i] = -1
 		} else {
@@ -348,9 +348,8 @@ func remove_doubling(x []opus_val16, maxperiod int, minperiod int, N int, T0_ *i
 		return T0
 	}()
 	yy_lookup = make([]opus_val32, maxperiod+1)
-	_ = arch
 	// FIXME
-	dual_inner_prod_c(x, x, x[-T0:], N, &xx, &xy)
+	dual_inner_prod(x, x, x[-T0:], N, &xx, &xy, arch)
 	yy_lookup[0] = xx
 	yy = xx
 	for i = 1; i <= maxperiod; i++ {
@@ -389,9 +388,8 @@ func remove_doubling(x []opus_val16, maxperiod int, minperiod int, N int, T0_ *i
 		} else {
 			T1b = int(uint32(int32(second_check[k]*2*T0+k)) / uint32(int32(k*2)))
 		}
-		_ = arch
 		// FIXME
-		dual_inner_prod_c(x, x[-T1:], x[-T1b:], N, &xy, &xy2)
+		dual_inner_prod(x, x[-T1:], x[-T1b:], N, &xy, &xy2, arch)
 		xy = (xy + xy2) * 0.5
 		yy = (yy_lookup[T1] + yy_lookup[T1b]) * 0.5
 		g1 = compute_pitch_gain(xy, xx, yy)
@@ -438,11 +436,8 @@ func remove_doubling(x []opus_val16, maxperiod int, minperiod int, N int, T0_ *i
 		pg = opus_val16(float32(best_xy) / (float32(best_yy) + 1))
 	}
 	for k = 0; k < 3; k++ {
-		xcorr[k] = func() opus_val32 {
-			_ = arch
-			// FIXME
-			return celt_inner_prod_c(x, x[-(T+k-1):], N)
-		}()
+		// FIXME
+		xcorr[k] = celt_inner_prod(x, x[-(T+k-1):], N, arch)
 	}
 	if (xcorr[2] - xcorr[0]) > ((xcorr[1] - xcorr[0]) * 0.7) {
 		offset = 1
@@ -0,0 +1,88 @@
+//go:build !purego
+
+package celt
+
+/* The kernels vectorise across lags, never within a sum, so that every sum
+   is accumulated in the order of the C code. The inner products have a
+   single sum and stay scalar, without the bounds checks. */
+
+//go:noescape
+func xcorr_kernel_sse_asm(x []opus_val16, y []opus_val16, sum *[4]opus_val32, len_ int)
+
+//go:noescape
+func xcorr_kernel_avx2_asm(x []opus_val16, y []opus_val16, sum *[8]opus_val32, len_ int)
+
+//go:noescape
+func celt_inner_prod_sse_asm(x []opus_val16, y []opus_val16, N int) opus_val32
+
+//go:noescape
+func dual_inner_prod_sse_asm(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int, xy1 *opus_val32, xy2 *opus_val32)
+
+var xcorr_kernel_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, *[4]opus_val32, int){
+	xcorr_kernel_c, xcorr_kernel_sse, xcorr_kernel_sse, xcorr_kernel_sse,
+	xcorr_kernel_sse, xcorr_kernel_sse, xcorr_kernel_sse, xcorr_kernel_sse,
+}
+
+var pitch_xcorr_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val32, int, int){
+	pitch_xcorr_c, pitch_xcorr_sse, pitch_xcorr_sse, pitch_xcorr_sse,
+	pitch_xcorr_avx2, pitch_xcorr_avx2, pitch_xcorr_avx2, pitch_xcorr_avx2,
+}
+
+var celt_inner_prod_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, int) opus_val32{
+	celt_inner_prod_c, celt_inner_prod_sse, celt_inner_prod_sse, celt_inner_prod_sse,
+	celt_inner_prod_sse, celt_inner_prod_sse, celt_inner_prod_sse, celt_inner_prod_sse,
+}
+
+var dual_inner_prod_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val16, int, *opus_val32, *opus_val32){
+	dual_inner_prod_c, dual_inner_prod_sse, dual_inner_prod_sse, dual_inner_prod_sse,
+	dual_inner_prod_sse, dual_inner_prod_sse, dual_inner_prod_sse, dual_inner_prod_sse,
+}
+
+func xcorr_kernel_sse(x []opus_val16, y []opus_val16, sum *[4]opus_val32, len_ int) {
+	if len_ > 0 {
+		_, _ = x[len_-1], y[len_+2]
+		xcorr_kernel_sse_asm(x, y, sum, len_)
+	}
+}
+
+func celt_inner_prod_sse(x []opus_val16, y []opus_val16, N int) opus_val32 {
+	if N <= 0 {
+		return 0
+	}
+	_, _ = x[N-1], y[N-1]
+	return celt_inner_prod_sse_asm(x, y, N)
+}
+
+func dual_inner_prod_sse(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int, xy1 *opus_val32, xy2 *opus_val32) {
+	if N <= 0 {
+		*xy1, *xy2 = 0, 0
+		return
+	}
+	_, _, _ = x[N-1], y01[N-1], y02[N-1]
+	dual_inner_prod_sse_asm(x, y01, y02, N, xy1, xy2)
+}
+
+func pitch_xcorr_sse(_x []opus_val16, _y []opus_val16, xcorr []opus_val32, len_ int, max_pitch int) {
+	var i int
+	for i = 0; i < max_pitch-3; i += 4 {
+		var sum [4]opus_val32
+		xcorr_kernel_sse(_x, _y[i:], &sum, len_)
+		copy(xcorr[i:i+4], sum[:])
+	}
+	for ; i < max_pitch; i++ {
+		xcorr[i] = celt_inner_prod_sse(_x, _y[i:], len_)
+	}
+}
+
+func pitch_xcorr_avx2(_x []opus_val16, _y []opus_val16, xcorr []opus_val32, len_ int, max_pitch int) {
+	var i int
+	if len_ > 0 {
+		for i = 0; i < max_pitch-7; i += 8 {
+			var sum [8]opus_val32
+			_, _ = _x[len_-1], _y[i+len_+6]
+			xcorr_kernel_avx2_asm(_x, _y[i:], &sum, len_)
+			copy(xcorr[i:i+8], sum[:])
+		}
+	}
+	pitch_xcorr_sse(_x, _y[i:], xcorr[i:], len_, max_pitch-i)
+}
@@ -0,0 +1,98 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func xcorr_kernel_sse_asm(x []opus_val16, y []opus_val16, sum *[4]opus_val32, len_ int)
+// sum[k] += x[j]*y[j+k] for j < len_, in order; len_ > 0.
+TEXT ·xcorr_kernel_sse_asm(SB), NOSPLIT, $0-64
+	MOVQ x_base+0(FP), SI
+	MOVQ y_base+24(FP), DI
+	MOVQ sum+48(FP), DX
+	MOVQ len_+56(FP), CX
+	MOVUPS (DX), X0
+
+xcorr_loop:
+	MOVSS  (SI), X1
+	SHUFPS $0x00, X1, X1
+	MOVUPS (DI), X2
+	MULPS  X1, X2
+	ADDPS  X2, X0
+	ADDQ   $4, SI
+	ADDQ   $4, DI
+	DECQ   CX
+	JNZ    xcorr_loop
+
+	MOVUPS X0, (DX)
+	RET
+
+// func xcorr_kernel_avx2_asm(x []opus_val16, y []opus_val16, sum *[8]opus_val32, len_ int)
+// As xcorr_kernel_sse_asm over eight lags. Multiplies and adds are kept
+// apart: fusing them would round differently from the C code.
+TEXT ·xcorr_kernel_avx2_asm(SB), NOSPLIT, $0-64
+	MOVQ x_base+0(FP), SI
+	MOVQ y_base+24(FP), DI
+	MOVQ sum+48(FP), DX
+	MOVQ len_+56(FP), CX
+	VMOVUPS (DX), Y0
+
+xcorr8_loop:
+	VBROADCASTSS (SI), Y1
+	VMULPS       (DI), Y1, Y2
+	VADDPS       Y2, Y0, Y0
+	ADDQ         $4, SI
+	ADDQ         $4, DI
+	DECQ         CX
+	JNZ          xcorr8_loop
+
+	VMOVUPS Y0, (DX)
+	VZEROUPPER
+	RET
+
+// func celt_inner_prod_sse_asm(x []opus_val16, y []opus_val16, N int) opus_val32
+TEXT ·celt_inner_prod_sse_asm(SB), NOSPLIT, $0-60
+	MOVQ  x_base+0(FP), SI
+	MOVQ  y_base+24(FP), DI
+	MOVQ  N+48(FP), CX
+	XORPS X0, X0
+
+inner_loop:
+	MOVSS (SI), X1
+	MULSS (DI), X1
+	ADDSS X1, X0
+	ADDQ  $4, SI
+	ADDQ  $4, DI
+	DECQ  CX
+	JNZ   inner_loop
+
+	MOVSS X0, ret+56(FP)
+	RET
+
+// func dual_inner_prod_sse_asm(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int, xy1 *opus_val32, xy2 *opus_val32)
+// Both sums advance together in the two low lanes.
+TEXT ·dual_inner_prod_sse_asm(SB), NOSPLIT, $0-96
+	MOVQ  x_base+0(FP), SI
+	MOVQ  y01_base+24(FP), DI
+	MOVQ  y02_base+48(FP), R8
+	MOVQ  N+72(FP), CX
+	XORPS X0, X0
+
+dual_loop:
+	MOVSS    (SI), X1
+	UNPCKLPS X1, X1
+	MOVSS    (DI), X2
+	MOVSS    (R8), X3
+	UNPCKLPS X3, X2
+	MULPS    X1, X2
+	ADDPS    X2, X0
+	ADDQ     $4, SI
+	ADDQ     $4, DI
+	ADDQ     $4, R8
+	DECQ     CX
+	JNZ      dual_loop
+
+	MOVQ   xy1+80(FP), DX
+	MOVSS  X0, (DX)
+	SHUFPS $0x55, X0, X0
+	MOVQ   xy2+88(FP), DX
+	MOVSS  X0, (DX)
+	RET
@@ -0,0 +1,53 @@
+package celt
+
+/* Run-time dispatch, as in celt/x86/x86_celt_map.c and celt/arm/arm_celt_map.c:
+   each table, defined for the architecture being built, maps an architecture
+   level to the best implementation it has. All of them give the same results
+   as the C ones, bit for bit. */
+
+func xcorr_kernel(x []opus_val16, y []opus_val16, sum *[4]opus_val32, len_ int, arch int) {
+	xcorr_kernel_impl[arch&OPUS_ARCHMASK](x, y, sum, len_)
+}
+
+// PitchXcorr computes the cross-correlation of _x with _y at lags 0 to
+// max_pitch-1, with the implementation for arch.
+func PitchXcorr(_x []opus_val16, _y []opus_val16, xcorr []opus_val32, len_ int, max_pitch int, arch int) {
+	pitch_xcorr_impl[arch&OPUS_ARCHMASK](_x, _y, xcorr, len_, max_pitch)
+}
+
+func celt_inner_prod(x []opus_val16, y []opus_val16, N int, arch int) opus_val32 {
+	return celt_inner_prod_impl[arch&OPUS_ARCHMASK](x, y, N)
+}
+
+func dual_inner_prod(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int, xy1 *opus_val32, xy2 *opus_val32, arch int) {
+	dual_inner_prod_impl[arch&OPUS_ARCHMASK](x, y01, y02, N, xy1, xy2)
+}
+
+// celt_fir filters the N samples of x that follow its first ord ones, as
+// celt_fir_c, with the implementation for arch.
+func celt_fir(x []opus_val16, num []opus_val16, y []opus_val16, N int, ord int, arch int) {
+	celt_fir_impl[arch&OPUS_ARCHMASK](x, num, y, N, ord, arch)
+}
+
+/* The outputs of celt_fir_c from i on, one at a time, with num reversed */
+func celt_fir_tail(x []opus_val16, rnum []opus_val16, y []opus_val16, i int, N int, ord int) {
+	for ; i < N; i++ {
+		var sum opus_val32 = opus_val32(x[ord+i])
+		for j := 0; j < ord; j++ {
+			sum = sum + opus_val32(rnum[j]*x[i+j])
+		}
+		y[i] = opus_val16(sum)
+	}
+}
+
+func reversed(num []opus_val16, ord int) []opus_val16 {
+	rnum := make([]opus_val16, ord)
+	for i := 0; i < ord; i++ {
+		rnum[i] = num[ord-i-1]
+	}
+	return rnum
+}
+
+func pitch_xcorr_c(_x []opus_val16, _y []opus_val16, xcorr []opus_val32, len_ int, max_pitch int) {
+	PitchXcorrC(_x, _y, xcorr, len_, max_pitch, OPUS_ARCH_C)
+}
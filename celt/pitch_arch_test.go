@@ -0,0 +1,121 @@
+package celt
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func sameBits(a []opus_val32, b []opus_val32) bool {
+	for i := range a {
+		if math.Float32bits(a[i]) != math.Float32bits(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestKernelsBitExact(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for arch := OPUS_ARCH_C + 1; arch <= OpusSelectArch(); arch++ {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 7, 8, 31, 40, 97, 240} {
+			for _, max_pitch := range []int{1, 3, 4, 5, 8, 9, 15, 16, 17, 33} {
+				x := testSignal(rng, n)
+				y := testSignal(rng, n+max_pitch)
+				want := make([]opus_val32, max_pitch)
+				got := make([]opus_val32, max_pitch)
+				PitchXcorr(x, y, want, n, max_pitch, OPUS_ARCH_C)
+				PitchXcorr(x, y, got, n, max_pitch, arch)
+				if !sameBits(got, want) {
+					t.Fatalf("arch %d: PitchXcorr over %d with %d lags differs", arch, n, max_pitch)
+				}
+			}
+
+			x, y01, y02 := testSignal(rng, n), testSignal(rng, n), testSignal(rng, n)
+			if got, want := celt_inner_prod(x, y01, n, arch), celt_inner_prod(x, y01, n, OPUS_ARCH_C); !sameBits([]opus_val32{got}, []opus_val32{want}) {
+				t.Fatalf("arch %d: celt_inner_prod over %d: %v, want %v", arch, n, got, want)
+			}
+			var got, want [2]opus_val32
+			dual_inner_prod(x, y01, y02, n, &got[0], &got[1], arch)
+			dual_inner_prod(x, y01, y02, n, &want[0], &want[1], OPUS_ARCH_C)
+			if !sameBits(got[:], want[:]) {
+				t.Fatalf("arch %d: dual_inner_prod over %d: %v, want %v", arch, n, got, want)
+			}
+		}
+	}
+}
+
+func TestFilterBitExact(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for arch := OPUS_ARCH_C + 1; arch <= OpusSelectArch(); arch++ {
+		for _, ord := range []int{4, 5, 16, 24} {
+			for _, N := range []int{1, 4, 7, 12, 64, 101} {
+				x := testSignal(rng, ord+N)
+				num := testSignal(rng, ord)
+				want := make([]opus_val16, N)
+				got := make([]opus_val16, N)
+				celt_fir_c(x, num, want, N, ord, OPUS_ARCH_C)
+				celt_fir(x, num, got, N, ord, arch)
+				for i := range got {
+					if math.Float32bits(got[i]) != math.Float32bits(want[i]) {
+						t.Fatalf("arch %d: celt_fir of order %d over %d differs at %d", arch, ord, N, i)
+					}
+				}
+
+				/* A stable all-pole filter, with small coefficients, whose
+				   memory is the last ord outputs */
+				if N < ord {
+					continue
+				}
+				in := make([]opus_val32, N)
+				for i := range in {
+					in[i] = opus_val32(rng.NormFloat64())
+				}
+				den := make([]opus_val16, ord)
+				for i := range den {
+					den[i] = opus_val16(rng.Float64()*0.2-0.1) / opus_val16(ord)
+				}
+				memWant := make([]opus_val16, ord)
+				memGot := make([]opus_val16, ord)
+				outWant := make([]opus_val32, N)
+				outGot := make([]opus_val32, N)
+				celt_iir(in, den, outWant, N, ord, memWant, OPUS_ARCH_C)
+				celt_iir(in, den, outGot, N, ord, memGot, arch)
+				if !sameBits(outGot, outWant) {
+					t.Fatalf("arch %d: celt_iir of order %d over %d differs", arch, ord, N)
+				}
+			}
+		}
+	}
+}
+
+func TestKissFftBitExact(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	twiddles := make([]KissFftCpx, 480)
+	for i := range twiddles {
+		phase := -2 * math.Pi * float64(i) / 480
+		twiddles[i] = KissFftCpx{float32(math.Cos(phase)), float32(math.Sin(phase))}
+	}
+	for arch := OPUS_ARCH_C + 1; arch <= OpusSelectArch(); arch++ {
+		/* The radix-4 stages of the 480, 240, 120 and 60 point FFTs of CELT,
+		   and odd m with a padded stride */
+		for _, s := range []struct{ fstride, m, N, mm int }{
+			{1, 1, 120, 4}, {4, 1, 30, 4}, {1, 4, 30, 16}, {8, 15, 1, 60},
+			{1, 30, 1, 120}, {2, 30, 1, 120}, {4, 15, 2, 60}, {1, 3, 5, 13},
+		} {
+			n := (s.N-1)*s.mm + 4*s.m
+			want := make([]KissFftCpx, n)
+			for i := range want {
+				want[i] = KissFftCpx{float32(rng.NormFloat64()), float32(rng.NormFloat64())}
+			}
+			got := append([]KissFftCpx(nil), want...)
+			KfBfly4(want, s.fstride, twiddles, s.m, s.N, s.mm, OPUS_ARCH_C)
+			KfBfly4(got, s.fstride, twiddles, s.m, s.N, s.mm, arch)
+			for i := range got {
+				if math.Float32bits(got[i].R) != math.Float32bits(want[i].R) || math.Float32bits(got[i].I) != math.Float32bits(want[i].I) {
+					t.Fatalf("arch %d: kf_bfly4 with m %d, N %d differs at %d: %v, want %v", arch, s.m, s.N, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// The assembler has no mnemonics for the vector FMUL and FADD.
+#define FMUL_V2_V2_V1 WORD $0x6E21DC42 // FMUL V2.4S, V2.4S, V1.4S
+#define FADD_V0_V0_V2 WORD $0x4E22D400 // FADD V0.4S, V0.4S, V2.4S
+
+// func xcorr_kernel_neon_asm(x []opus_val16, y []opus_val16, sum *[4]opus_val32, len_ int)
+// sum[k] += x[j]*y[j+k] for j < len_, in order; len_ > 0.
+TEXT ·xcorr_kernel_neon_asm(SB), NOSPLIT, $0-64
+	MOVD x_base+0(FP), R0
+	MOVD y_base+24(FP), R1
+	MOVD sum+48(FP), R2
+	MOVD len_+56(FP), R3
+	VLD1 (R2), [V0.S4]
+
+xcorr_loop:
+	VLD1R.P 4(R0), [V1.S4]
+	VLD1    (R1), [V2.S4]
+	ADD     $4, R1
+	FMUL_V2_V2_V1
+	FADD_V0_V0_V2
+	SUBS    $1, R3
+	BNE     xcorr_loop
+
+	VST1 [V0.S4], (R2)
+	RET
+
+// func celt_inner_prod_neon_asm(x []opus_val16, y []opus_val16, N int) opus_val32
+TEXT ·celt_inner_prod_neon_asm(SB), NOSPLIT, $0-60
+	MOVD x_base+0(FP), R0
+	MOVD y_base+24(FP), R1
+	MOVD N+48(FP), R3
+	VEOR V0.B16, V0.B16, V0.B16
+
+inner_loop:
+	FMOVS.P 4(R0), F1
+	FMOVS.P 4(R1), F2
+	FMULS   F1, F2, F2
+	FADDS   F2, F0, F0
+	SUBS    $1, R3
+	BNE     inner_loop
+
+	FMOVS F0, ret+56(FP)
+	RET
+
+// func dual_inner_prod_neon_asm(x []opus_val16, y01 []opus_val16, y02 []opus_val16, N int, xy1 *opus_val32, xy2 *opus_val32)
+TEXT ·dual_inner_prod_neon_asm(SB), NOSPLIT, $0-96
+	MOVD x_base+0(FP), R0
+	MOVD y01_base+24(FP), R1
+	MOVD y02_base+48(FP), R2
+	MOVD N+72(FP), R3
+	VEOR V0.B16, V0.B16, V0.B16
+	VEOR V4.B16, V4.B16, V4.B16
+
+dual_loop:
+	FMOVS.P 4(R0), F1
+	FMOVS.P 4(R1), F2
+	FMOVS.P 4(R2), F3
+	FMULS   F1, F2, F2
+	FMULS   F1, F3, F3
+	FADDS   F2, F0, F0
+	FADDS   F3, F4, F4
+	SUBS    $1, R3
+	BNE     dual_loop
+
+	MOVD  xy1+80(FP), R4
+	FMOVS F0, (R4)
+	MOVD  xy2+88(FP), R4
+	FMOVS F4, (R4)
+	RET
@@ -0,0 +1,23 @@
+//go:build purego || (!amd64 && !arm64)
+
+package celt
+
+var xcorr_kernel_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, *[4]opus_val32, int){
+	xcorr_kernel_c, xcorr_kernel_c, xcorr_kernel_c, xcorr_kernel_c,
+	xcorr_kernel_c, xcorr_kernel_c, xcorr_kernel_c, xcorr_kernel_c,
+}
+
+var pitch_xcorr_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val32, int, int){
+	pitch_xcorr_c, pitch_xcorr_c, pitch_xcorr_c, pitch_xcorr_c,
+	pitch_xcorr_c, pitch_xcorr_c, pitch_xcorr_c, pitch_xcorr_c,
+}
+
+var celt_inner_prod_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, int) opus_val32{
+	celt_inner_prod_c, celt_inner_prod_c, celt_inner_prod_c, celt_inner_prod_c,
+	celt_inner_prod_c, celt_inner_prod_c, celt_inner_prod_c, celt_inner_prod_c,
+}
+
+var dual_inner_prod_impl = [OPUS_ARCHMASK + 1]func([]opus_val16, []opus_val16, []opus_val16, int, *opus_val32, *opus_val32){
+	dual_inner_prod_c, dual_inner_prod_c, dual_inner_prod_c, dual_inner_prod_c,
+	dual_inner_prod_c, dual_inner_prod_c, dual_inner_prod_c, dual_inner_prod_c,
+}
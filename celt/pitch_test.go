@@ -0,0 +1,60 @@
+package celt
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Samples spread over several orders of magnitude, so that rounding differs
+// whenever the order of the operations does.
+func testSignal(rng *rand.Rand, n int) []opus_val16 {
+	x := make([]opus_val16, n)
+	for i := range x {
+		x[i] = opus_val16(rng.NormFloat64() * math.Exp2(float64(rng.Intn(20)-10)))
+	}
+	return x
+}
+
+func testClose(got float64, want float64) bool {
+	return math.Abs(got-want) <= 1e-4*math.Max(1, math.Abs(want))
+}
+
+func TestPitchXcorrC(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	x := testSignal(rng, 50)
+	y := testSignal(rng, 60)
+	xcorr := make([]opus_val32, 10)
+	PitchXcorrC(x, y, xcorr, len(x), len(xcorr), 0)
+	for i := range xcorr {
+		want := 0.0
+		for j := range x {
+			want += float64(x[j]) * float64(y[i+j])
+		}
+		if !testClose(float64(xcorr[i]), want) {
+			t.Fatalf("lag %d: %v, want %v", i, xcorr[i], want)
+		}
+	}
+}
+
+func TestCeltFir(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, ord := range []int{4, 5, 16, 24} {
+		for _, N := range []int{1, 4, 7, 64, 101} {
+			x := testSignal(rng, ord+N)
+			num := testSignal(rng, ord)
+			y := make([]opus_val16, N)
+			celt_fir_c(x, num, y, N, ord, 0)
+			for i := range y {
+				/* The first ord samples of x are the history */
+				want := float64(x[ord+i])
+				for k := 0; k < ord; k++ {
+					want += float64(num[k]) * float64(x[ord+i-1-k])
+				}
+				if !testClose(float64(y[i]), want) {
+					t.Fatalf("order %d over %d: y[%d] is %v, want %v", ord, N, i, y[i], want)
+				}
+			}
+		}
+	}
+}
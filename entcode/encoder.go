@@ -315,7 +315,7 @@ func (ec *Encoder) EncPatchInitialBits(val uint, nbits uint) {
 // size: The number of bytes in the new buffer. This must be large enough to contain the bits already written, and
 // must be no larger than the existing size.
 func (ec *Encoder) Shrink(_size uint32) {
-	libc.MemMove(unsafe.Pointer((*byte)(unsafe.Add(unsafe.Pointer(&ec.Buf[_size]), -int(ec.End_offs)))), unsafe.Pointer((*byte)(unsafe.Add(unsafe.Pointer(&ec.Buf[ec.Storage]), -int(ec.End_offs)))), int(uintptr(ec.End_offs)*unsafe.Sizeof(byte(0))+uintptr((int64(uintptr(unsafe.Pointer((*byte)(unsafe.Add(unsafe.Pointer(&ec.Buf[_size]), -int(ec.End_offs)))))-uintptr(unsafe.Pointer((*byte)(unsafe.Add(unsafe.Pointer(&ec.Buf[ec.Storage]), -int(ec.End_offs)))))))*0)))
+	copy(ec.Buf[_size-ec.End_offs:_size], ec.Buf[ec.Storage-ec.End_offs:ec.Storage])
 	ec.Storage = _size
 }
 
@@ -0,0 +1,264 @@
+package entcode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// testOp is one symbol of a random script, coded with one of the
+// primitives.
+type testOp struct {
+	kind int
+	fl   uint32 // the value, or the low end of its range
+	fh   uint32
+	ft   uint32 // the total, or the number of bits
+	icdf []byte
+}
+
+const (
+	opEncode = iota
+	opEncodeBin
+	opBitLogp
+	opIcdf
+	opUint
+	opBits
+	opCount
+)
+
+// testScript draws n random symbols. The first is coded on patchBits bits
+// with EncodeBin so it can be patched afterwards.
+func testScript(rng *rand.Rand, n int, patchBits uint32) []testOp {
+	ops := []testOp{{kind: opEncodeBin, fl: uint32(rng.Intn(1 << patchBits)), ft: patchBits}}
+	ops[0].fh = ops[0].fl + 1
+	for len(ops) < n {
+		op := testOp{kind: rng.Intn(opCount)}
+		switch op.kind {
+		case opEncode, opEncodeBin:
+			total := uint32(2 + rng.Intn(1<<16-1))
+			if op.kind == opEncodeBin {
+				op.ft = uint32(1 + rng.Intn(15))
+				total = 1 << op.ft
+			} else {
+				op.ft = total
+			}
+			op.fl = uint32(rng.Int63n(int64(total)))
+			op.fh = op.fl + 1 + uint32(rng.Int63n(int64(total-op.fl)))
+		case opBitLogp:
+			op.ft = uint32(1 + rng.Intn(15))
+			op.fl = uint32(rng.Intn(2))
+		case opIcdf:
+			op.ft = uint32(1 + rng.Intn(8))
+			/* A strictly decreasing table ending in 0 */
+			nsym := 2 + rng.Intn(min(7, 1<<op.ft-1))
+			picks := rng.Perm(1<<op.ft - 1)[:nsym-1]
+			for i := range picks {
+				for j := i + 1; j < len(picks); j++ {
+					if picks[j] > picks[i] {
+						picks[i], picks[j] = picks[j], picks[i]
+					}
+				}
+				op.icdf = append(op.icdf, byte(picks[i]+1))
+			}
+			op.icdf = append(op.icdf, 0)
+			op.fl = uint32(rng.Intn(nsym))
+		case opUint:
+			bits := 2 + rng.Intn(31)
+			op.ft = uint32(2 + rng.Int63n(int64(1)<<bits-2))
+			op.fl = uint32(rng.Int63n(int64(op.ft)))
+		case opBits:
+			op.ft = uint32(1 + rng.Intn(25))
+			op.fl = uint32(rng.Int63n(int64(1) << op.ft))
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// testEncode codes ops, patches the first symbol to patch and shrinks the
+// buffer to the bytes Tell accounts for plus slack. It returns the packet
+// and the Tell and TellFrac values after each symbol.
+func testEncode(t *testing.T, ops []testOp, patch uint32, slack int) ([]byte, []int, []uint32) {
+	var enc Encoder
+	enc.Init(make([]byte, 4096))
+	tell := []int{enc.Tell()}
+	frac := []uint32{enc.TellFrac()}
+	for _, op := range ops {
+		switch op.kind {
+		case opEncode:
+			enc.Encode(uint(op.fl), uint(op.fh), uint(op.ft))
+		case opEncodeBin:
+			enc.EncodeBin(uint(op.fl), uint(op.fh), uint(op.ft))
+		case opBitLogp:
+			enc.EncBitLogp(int(op.fl), uint(op.ft))
+		case opIcdf:
+			enc.EncIcdf(int(op.fl), op.icdf, uint(op.ft))
+		case opUint:
+			enc.EncUint(op.fl, op.ft)
+		case opBits:
+			enc.EncBits(op.fl, uint(op.ft))
+		}
+		tell = append(tell, enc.Tell())
+		frac = append(frac, enc.TellFrac())
+	}
+	enc.EncPatchInitialBits(uint(patch), uint(ops[0].ft))
+	size := (enc.Tell()+7)/8 + slack
+	enc.Shrink(uint32(size))
+	enc.Done()
+	if enc.GetError() != 0 {
+		t.Fatalf("encoder error %d", enc.GetError())
+	}
+	return enc.Buf[:size], tell, frac
+}
+
+func TestRangeCoderRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 2000; trial++ {
+		patchBits := uint32(1 + rng.Intn(8))
+		ops := testScript(rng, 1+rng.Intn(300), patchBits)
+		patch := uint32(rng.Intn(1 << patchBits))
+		packet, tell, frac := testEncode(t, ops, patch, rng.Intn(3))
+
+		var dec Decoder
+		dec.Init(packet)
+		if dec.Tell() != tell[0] || dec.TellFrac() != frac[0] {
+			t.Fatalf("trial %d: decoder starts at %d/%d bits, encoder at %d/%d", trial, dec.Tell(), dec.TellFrac(), tell[0], frac[0])
+		}
+		for i, op := range ops {
+			if i == 0 {
+				op.fl, op.fh = patch, patch+1
+			}
+			want, got := op.fl, op.fl
+			switch op.kind {
+			case opEncode, opEncodeBin:
+				var s uint
+				ft := uint(op.ft)
+				if op.kind == opEncode {
+					s = dec.Decode(ft)
+				} else {
+					s = dec.DecodeBin(ft)
+					ft = 1 << ft
+				}
+				if uint32(s) < op.fl || uint32(s) >= op.fh {
+					t.Fatalf("trial %d, symbol %d: %d outside [%d,%d)", trial, i, s, op.fl, op.fh)
+				}
+				dec.DecUpdate(uint(op.fl), uint(op.fh), ft)
+			case opBitLogp:
+				got = uint32(dec.DecBitLogp(uint(op.ft)))
+			case opIcdf:
+				got = uint32(dec.DecIcdf(op.icdf, uint(op.ft)))
+			case opUint:
+				got = dec.DecUint(op.ft)
+			case opBits:
+				got = dec.DecBits(uint(op.ft))
+			}
+			if got != want {
+				t.Fatalf("trial %d, symbol %d (kind %d): decoded %d, want %d", trial, i, op.kind, got, want)
+			}
+			if dec.Tell() != tell[i+1] || dec.TellFrac() != frac[i+1] {
+				t.Fatalf("trial %d, symbol %d: decoder at %d/%d bits, encoder at %d/%d", trial, i, dec.Tell(), dec.TellFrac(), tell[i+1], frac[i+1])
+			}
+		}
+		if dec.GetError() != 0 {
+			t.Fatalf("trial %d: decoder error %d", trial, dec.GetError())
+		}
+	}
+}
+
+var update = flag.Bool("update", false, "rewrite testdata/scripts.txt")
+
+const testScriptsFile = "testdata/scripts.txt"
+
+// The scripts of testdata/scripts.txt, with the packets this Encoder codes
+// them into. The Concentus EntropyCoder decodes the same scripts and must
+// code the same bytes; libopus aliases this package.
+type testScriptPacket struct {
+	patch  uint32
+	packet []byte
+	ops    []testOp
+}
+
+func writeTestScripts(t *testing.T) {
+	var b bytes.Buffer
+	b.WriteString("# Scripts of entcode_test.go and their packets, from go test -update.\n")
+	b.WriteString("# script <patch> <packet>, then <kind> <fl> <fh> <ft> [<icdf>...] per symbol\n")
+	for seed := int64(1); seed <= 40; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		patchBits := uint32(1 + rng.Intn(8))
+		ops := testScript(rng, 100, patchBits)
+		patch := uint32(rng.Intn(1 << patchBits))
+		packet, _, _ := testEncode(t, ops, patch, 0)
+		fmt.Fprintf(&b, "script %d %x\n", patch, packet)
+		for _, op := range ops {
+			fmt.Fprintf(&b, "%d %d %d %d", op.kind, op.fl, op.fh, op.ft)
+			for _, v := range op.icdf {
+				fmt.Fprintf(&b, " %d", v)
+			}
+			b.WriteString("\n")
+		}
+	}
+	if err := os.WriteFile(testScriptsFile, b.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestScripts(t *testing.T) []testScriptPacket {
+	data, err := os.ReadFile(testScriptsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scripts []testScriptPacket
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 || f[0] == "#" {
+			continue
+		}
+		if f[0] == "script" {
+			s := testScriptPacket{}
+			patch, _ := strconv.ParseUint(f[1], 10, 32)
+			s.patch = uint32(patch)
+			if s.packet, err = hex.DecodeString(f[2]); err != nil {
+				t.Fatal(err)
+			}
+			scripts = append(scripts, s)
+			continue
+		}
+		v := make([]uint32, len(f))
+		for i := range f {
+			n, err := strconv.ParseUint(f[i], 10, 32)
+			if err != nil {
+				t.Fatalf("%s: %v", testScriptsFile, err)
+			}
+			v[i] = uint32(n)
+		}
+		op := testOp{kind: int(v[0]), fl: v[1], fh: v[2], ft: v[3]}
+		for _, x := range v[4:] {
+			op.icdf = append(op.icdf, byte(x))
+		}
+		s := &scripts[len(scripts)-1]
+		s.ops = append(s.ops, op)
+	}
+	return scripts
+}
+
+func TestRangeCoderScripts(t *testing.T) {
+	if *update {
+		writeTestScripts(t)
+	}
+	scripts := readTestScripts(t)
+	if len(scripts) == 0 {
+		t.Fatal("no scripts")
+	}
+	for i, s := range scripts {
+		packet, _, _ := testEncode(t, s.ops, s.patch, 0)
+		if !bytes.Equal(packet, s.packet) {
+			t.Fatalf("script %d: coded %x, want %x", i, packet, s.packet)
+		}
+	}
+}
@@ -1,3 +1,5 @@
 package libopus
 
-func opus_select_arch() int { panic("TODO") }
+import "github.com/gotranspile/opus/celt"
+
+func opus_select_arch() int { return celt.OpusSelectArch() }
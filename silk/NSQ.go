@@ -132,10 +132,7 @@ func silk_noise_shape_quantizer(NSQ *NSQState, signalType int, x_sc_Q10 []int32,
 	psLPC_Q14_ind := 0
 	for i = 0; i < length; i++ {
 		NSQ.Rand_seed = int32(RAND_INCREMENT + int(uint32(int32(int(uint32(NSQ.Rand_seed))*RAND_MULTIPLIER))))
-		LPC_pred_Q10 = func() int32 {
-			_ = arch
-			return silk_noise_shape_quantizer_short_prediction_c(NSQ.SLPC_Q14[:], MAX_LPC_ORDER-1+psLPC_Q14_ind, a_Q12, predictLPCOrder)
-		}()
+		LPC_pred_Q10 = silk_noise_shape_quantizer_short_prediction(NSQ.SLPC_Q14[:], MAX_LPC_ORDER-1+psLPC_Q14_ind, a_Q12, predictLPCOrder, arch)
 		if signalType == TYPE_VOICED {
 			LTP_pred_Q13 = 2
 			LTP_pred_Q13 = int32(int64(LTP_pred_Q13) + ((int64(sLTP_Q15[pred_lag_ind+0]) * int64(b_Q14[0])) >> 16))
@@ -0,0 +1,29 @@
+//go:build !purego
+
+package silk
+
+import "github.com/gotranspile/opus/celt"
+
+/* The products of SMULWB are formed by pairs of lanes with PMULDQ and
+   summed in any order, which integer arithmetic allows */
+
+//go:noescape
+func short_prediction_sse4_1_asm(buf []int32, coef []int16, order int) int32
+
+//go:noescape
+func short_prediction_avx2_asm(buf []int32, coef []int16, order int) int32
+
+var short_prediction_impl = [celt.OPUS_ARCHMASK + 1]func([]int32, int, []int16, int) int32{
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_c,
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_sse4_1,
+	silk_noise_shape_quantizer_short_prediction_avx2, silk_noise_shape_quantizer_short_prediction_avx2,
+	silk_noise_shape_quantizer_short_prediction_avx2, silk_noise_shape_quantizer_short_prediction_avx2,
+}
+
+func silk_noise_shape_quantizer_short_prediction_sse4_1(buf32 []int32, i int, coef16 []int16, order int) int32 {
+	return short_prediction_sse4_1_asm(buf32[i+1-order:i+1], coef16[:order], order)
+}
+
+func silk_noise_shape_quantizer_short_prediction_avx2(buf32 []int32, i int, coef16 []int16, order int) int32 {
+	return short_prediction_avx2_asm(buf32[i+1-order:i+1], coef16[:order], order)
+}
@@ -0,0 +1,146 @@
+//go:build !purego
+
+#include "textflag.h"
+
+DATA reverse8<>+0(SB)/4, $7
+DATA reverse8<>+4(SB)/4, $6
+DATA reverse8<>+8(SB)/4, $5
+DATA reverse8<>+12(SB)/4, $4
+DATA reverse8<>+16(SB)/4, $3
+DATA reverse8<>+20(SB)/4, $2
+DATA reverse8<>+24(SB)/4, $1
+DATA reverse8<>+28(SB)/4, $0
+GLOBL reverse8<>(SB), RODATA|NOPTR, $32
+
+// func short_prediction_sse4_1_asm(buf []int32, coef []int16, order int) int32
+// Returns order/2 + sum of (buf[order-1-k] * coef[k]) >> 16 for k < order.
+TEXT ·short_prediction_sse4_1_asm(SB), NOSPLIT, $0-60
+	MOVQ buf_base+0(FP), SI
+	MOVQ coef_base+24(FP), DI
+	MOVQ order+48(FP), CX
+	LEAQ -4(SI)(CX*4), SI
+	MOVQ CX, DX
+	SHRQ $1, DX
+	PXOR X0, X0
+	CMPQ CX, $4
+	JLT  sse_tail
+
+sse_quad:
+	MOVOU    -12(SI), X1
+	PSHUFD   $0x1B, X1, X1
+	PMOVSXWD (DI), X2
+	MOVOU    X1, X3
+	PMULDQ   X2, X3
+	PSRLQ    $16, X3
+	PSRLQ    $32, X1
+	PSRLQ    $32, X2
+	PMULDQ   X2, X1
+	PSRLQ    $16, X1
+	PADDD    X3, X0
+	PADDD    X1, X0
+	SUBQ     $16, SI
+	ADDQ     $8, DI
+	SUBQ     $4, CX
+	CMPQ     CX, $4
+	JGE      sse_quad
+
+sse_tail:
+	TESTQ CX, CX
+	JZ    sse_done
+
+sse_tail_loop:
+	MOVLQSX (SI), AX
+	MOVWQSX (DI), BX
+	IMULQ   BX, AX
+	SARQ    $16, AX
+	ADDL    AX, DX
+	SUBQ    $4, SI
+	ADDQ    $2, DI
+	DECQ    CX
+	JNZ     sse_tail_loop
+
+sse_done:
+	MOVQ   X0, AX
+	PEXTRD $2, X0, BX
+	ADDL   AX, DX
+	ADDL   BX, DX
+	MOVL   DX, ret+56(FP)
+	RET
+
+// func short_prediction_avx2_asm(buf []int32, coef []int16, order int) int32
+// As short_prediction_sse4_1_asm, eight taps at a time.
+TEXT ·short_prediction_avx2_asm(SB), NOSPLIT, $0-60
+	MOVQ    buf_base+0(FP), SI
+	MOVQ    coef_base+24(FP), DI
+	MOVQ    order+48(FP), CX
+	LEAQ    -4(SI)(CX*4), SI
+	MOVQ    CX, DX
+	SHRQ    $1, DX
+	VPXOR   Y0, Y0, Y0
+	VMOVDQU reverse8<>(SB), Y5
+	CMPQ    CX, $8
+	JLT     avx2_fold
+
+avx2_oct:
+	VMOVDQU   -28(SI), Y1
+	VPERMD    Y1, Y5, Y1
+	VPMOVSXWD (DI), Y2
+	VPMULDQ   Y2, Y1, Y3
+	VPSRLQ    $16, Y3, Y3
+	VPSRLQ    $32, Y1, Y1
+	VPSRLQ    $32, Y2, Y2
+	VPMULDQ   Y2, Y1, Y1
+	VPSRLQ    $16, Y1, Y1
+	VPADDD    Y3, Y0, Y0
+	VPADDD    Y1, Y0, Y0
+	SUBQ      $32, SI
+	ADDQ      $16, DI
+	SUBQ      $8, CX
+	CMPQ      CX, $8
+	JGE       avx2_oct
+
+avx2_fold:
+	VEXTRACTI128 $1, Y0, X4
+	VPADDD       X4, X0, X0
+	VZEROUPPER
+	CMPQ         CX, $4
+	JLT          avx2_tail
+
+	MOVOU    -12(SI), X1
+	PSHUFD   $0x1B, X1, X1
+	PMOVSXWD (DI), X2
+	MOVOU    X1, X3
+	PMULDQ   X2, X3
+	PSRLQ    $16, X3
+	PSRLQ    $32, X1
+	PSRLQ    $32, X2
+	PMULDQ   X2, X1
+	PSRLQ    $16, X1
+	PADDD    X3, X0
+	PADDD    X1, X0
+	SUBQ     $16, SI
+	ADDQ     $8, DI
+	SUBQ     $4, CX
+
+avx2_tail:
+	TESTQ CX, CX
+	JZ    avx2_done
+
+avx2_tail_loop:
+	MOVLQSX (SI), AX
+	MOVWQSX (DI), BX
+	IMULQ   BX, AX
+	SARQ    $16, AX
+	ADDL    AX, DX
+	SUBQ    $4, SI
+	ADDQ    $2, DI
+	DECQ    CX
+	JNZ     avx2_tail_loop
+
+avx2_done:
+	MOVQ   X0, AX
+	PEXTRD $2, X0, BX
+	ADDL   AX, DX
+	ADDL   BX, DX
+	MOVL   DX, ret+56(FP)
+	RET
@@ -0,0 +1,10 @@
+package silk
+
+import "github.com/gotranspile/opus/celt"
+
+/* Run-time dispatch of the short-term prediction of the noise shaping
+   quantizers, the inner loop of both, as in silk/x86/x86_silk_map.c */
+
+func silk_noise_shape_quantizer_short_prediction(buf32 []int32, i int, coef16 []int16, order int, arch int) int32 {
+	return short_prediction_impl[arch&celt.OPUS_ARCHMASK](buf32, i, coef16, order)
+}
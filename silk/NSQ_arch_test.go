@@ -0,0 +1,34 @@
+package silk
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gotranspile/opus/celt"
+)
+
+func TestShortPredictionBitExact(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	buf := make([]int32, 64)
+	coef := make([]int16, 16)
+	for arch := celt.OPUS_ARCH_C + 1; arch <= opus_select_arch(); arch++ {
+		for trial := 0; trial < 2000; trial++ {
+			for i := range buf {
+				/* Full range, to exercise the wrap-around of the sum */
+				buf[i] = int32(rng.Uint32())
+			}
+			for i := range coef {
+				coef[i] = int16(rng.Intn(math.MaxUint16+1) - 32768)
+			}
+			/* The only orders SILK uses */
+			for _, order := range []int{10, 16} {
+				i := 15 + rng.Intn(len(buf)-15)
+				want := silk_noise_shape_quantizer_short_prediction(buf, i, coef, order, celt.OPUS_ARCH_C)
+				if got := silk_noise_shape_quantizer_short_prediction(buf, i, coef, order, arch); got != want {
+					t.Fatalf("arch %d, order %d: %d, want %d", arch, order, got, want)
+				}
+			}
+		}
+	}
+}
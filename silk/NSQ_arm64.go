@@ -0,0 +1,22 @@
+//go:build !purego
+
+package silk
+
+import "github.com/gotranspile/opus/celt"
+
+/* As on x86, the products of SMULWB are formed by pairs of lanes, with
+   SMULL and SMULL2, and summed in any order */
+
+//go:noescape
+func short_prediction_neon_asm(buf []int32, coef []int16, order int) int32
+
+var short_prediction_impl = [celt.OPUS_ARCHMASK + 1]func([]int32, int, []int16, int) int32{
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_c,
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_neon,
+	silk_noise_shape_quantizer_short_prediction_neon, silk_noise_shape_quantizer_short_prediction_neon,
+	silk_noise_shape_quantizer_short_prediction_neon, silk_noise_shape_quantizer_short_prediction_neon,
+}
+
+func silk_noise_shape_quantizer_short_prediction_neon(buf32 []int32, i int, coef16 []int16, order int) int32 {
+	return short_prediction_neon_asm(buf32[i+1-order:i+1], coef16[:order], order)
+}
@@ -0,0 +1,57 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// Encoded by hand, like the vector FMUL and FADD of the CELT kernels.
+#define REV64_V2_H4   WORD $0x0E600842 // REV64 V2.4H, V2.4H
+#define SXTL_V2_S4    WORD $0x0F10A442 // SXTL V2.4S, V2.4H
+#define SMULL_V3_V1   WORD $0x0EA2C023 // SMULL V3.2D, V1.2S, V2.2S
+#define SMULL2_V4_V1  WORD $0x4EA2C024 // SMULL2 V4.2D, V1.4S, V2.4S
+#define SHRN_V5_V3_16 WORD $0x0F308465 // SHRN V5.2S, V3.2D, #16
+#define SHRN2_V5_V4_16 WORD $0x4F308485 // SHRN2 V5.4S, V4.2D, #16
+
+// func short_prediction_neon_asm(buf []int32, coef []int16, order int) int32
+// Returns order/2 + sum of (buf[order-1-k] * coef[k]) >> 16 for k < order.
+TEXT ·short_prediction_neon_asm(SB), NOSPLIT, $0-60
+	MOVD buf_base+0(FP), R0
+	MOVD coef_base+24(FP), R1
+	MOVD order+48(FP), R2
+	ADD  R2<<2, R0, R0
+	LSR  $1, R2, R3
+	VEOR V0.B16, V0.B16, V0.B16
+	CMP  $4, R2
+	BLT  neon_tail
+
+neon_quad:
+	SUB    $16, R0
+	VLD1   (R0), [V1.S4]
+	VLD1.P 8(R1), [V2.H4]
+	REV64_V2_H4
+	SXTL_V2_S4
+	SMULL_V3_V1
+	SMULL2_V4_V1
+	SHRN_V5_V3_16
+	SHRN2_V5_V4_16
+	VADD   V5.S4, V0.S4, V0.S4
+	SUB    $4, R2
+	CMP    $4, R2
+	BGE    neon_quad
+
+neon_tail:
+	CBZ R2, neon_done
+
+neon_tail_loop:
+	MOVW.W -4(R0), R4
+	MOVH.P 2(R1), R5
+	MUL    R4, R5, R4
+	ASR    $16, R4, R4
+	ADDW   R4, R3, R3
+	SUBS   $1, R2
+	BNE    neon_tail_loop
+
+neon_done:
+	VADDV V0.S4, V0
+	VMOV  V0.S[0], R4
+	ADDW  R4, R3, R3
+	MOVW  R3, ret+56(FP)
+	RET
@@ -291,10 +291,7 @@ func silk_noise_shape_quantizer_del_dec(NSQ *NSQState, psDelDec []NSQ_del_dec_st
 			psDD = &psDelDec[k]
 			psSS := psSampleState[k][:]
 			psDD.Seed = int32(RAND_INCREMENT + int(uint32(int32(int(uint32(psDD.Seed))*RAND_MULTIPLIER))))
-			LPC_pred_Q14 = func() int32 {
-				_ = arch
-				return silk_noise_shape_quantizer_short_prediction_c(psDD.SLPC_Q14[:], MAX_LPC_ORDER-1+i, a_Q12[:], predictLPCOrder)
-			}()
+			LPC_pred_Q14 = silk_noise_shape_quantizer_short_prediction(psDD.SLPC_Q14[:], MAX_LPC_ORDER-1+i, a_Q12[:], predictLPCOrder, arch)
 			LPC_pred_Q14 = int32(int(uint32(LPC_pred_Q14)) << 4)
 			tmp2 = int32(int64(psDD.Diff_Q14) + ((int64(psDD.SAR2_Q14[0]) * int64(int16(warping_Q16))) >> 16))
 			tmp1 = int32(int(psDD.SAR2_Q14[0]) + (((int(psDD.SAR2_Q14[1]) - int(tmp2)) * int(int64(int16(warping_Q16)))) >> 16))
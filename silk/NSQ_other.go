@@ -0,0 +1,12 @@
+//go:build purego || (!amd64 && !arm64)
+
+package silk
+
+import "github.com/gotranspile/opus/celt"
+
+var short_prediction_impl = [celt.OPUS_ARCHMASK + 1]func([]int32, int, []int16, int) int32{
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_c,
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_c,
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_c,
+	silk_noise_shape_quantizer_short_prediction_c, silk_noise_shape_quantizer_short_prediction_c,
+}
@@ -1,5 +1,7 @@
 package silk
 
+import "github.com/gotranspile/opus/celt"
+
 const CELT_SIG_SCALE = 32768.0
 const OPUS_FAST_INT64 = 1
 const Q15ONE = 1.0
@@ -17,5 +19,5 @@ type celt_norm = float32
 type celt_ener = float32
 
 func opus_select_arch() int {
-	return 0 // FIXME
+	return celt.OpusSelectArch()
 }
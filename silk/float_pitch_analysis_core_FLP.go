@@ -114,7 +114,7 @@ func PitchAnalysisCore_FLP(frame []float32, pitch_out []int, lagIndex *int16, co
 	target_ptr = frame_4kHz[int32(int(uint32(int32(sf_length_4kHz)))<<2):]
 	for k = 0; k < nb_subfr>>1; k++ {
 		basis_ptr_ptr, basis_ptr_i = target_ptr, -min_lag_4kHz
-		celt.PitchXcorrC(target_ptr, target_ptr[-max_lag_4kHz:], xcorr[:], sf_length_8kHz, max_lag_4kHz-min_lag_4kHz+1, arch)
+		celt.PitchXcorr(target_ptr, target_ptr[-max_lag_4kHz:], xcorr[:], sf_length_8kHz, max_lag_4kHz-min_lag_4kHz+1, arch)
 		cross_corr = float64(xcorr[max_lag_4kHz-min_lag_4kHz])
 		normalizer = silk_energy_FLP([]float32(target_ptr), sf_length_8kHz) + silk_energy_FLP(basis_ptr_ptr[basis_ptr_i:], sf_length_8kHz) + float64(sf_length_8kHz)*4000.0
 		C[0][min_lag_4kHz] += float32(cross_corr * 2 / normalizer)
@@ -406,7 +406,7 @@ func silk_P_Ana_calc_corr_st3(cross_corr_st3 [4][34][5]float32, frame []float32,
 		lag_low = int(Lag_range_ptr[k*2+0])
 		lag_high = int(Lag_range_ptr[k*2+1])
 		// FIXME
-		celt.PitchXcorrC(target_ptr, target_ptr[-start_lag-lag_high:], xcorr[:], sf_length, lag_high-lag_low+1, arch)
+		celt.PitchXcorr(target_ptr, target_ptr[-start_lag-lag_high:], xcorr[:], sf_length, lag_high-lag_low+1, arch)
 		for j = lag_low; j <= lag_high; j++ {
 			scratch_mem[lag_counter] = float32(xcorr[lag_high-j])
 			lag_counter++
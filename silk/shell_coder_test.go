@@ -0,0 +1,116 @@
+package silk
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gotranspile/opus/entcode"
+)
+
+// testShellBlocks draws n shell blocks of 16 pulse counts, each block
+// summing to at most SILK_MAX_PULSES.
+func testShellBlocks(rng *rand.Rand, n int) [][]int {
+	blocks := make([][]int, n)
+	for b := range blocks {
+		blocks[b] = make([]int, SHELL_CODEC_FRAME_LENGTH)
+		for p := rng.Intn(SILK_MAX_PULSES + 1); p > 0; p-- {
+			blocks[b][rng.Intn(len(blocks[b]))]++
+		}
+	}
+	return blocks
+}
+
+func testShellEncode(t *testing.T, blocks [][]int) []byte {
+	var enc entcode.Encoder
+	enc.Init(make([]byte, 1<<16))
+	for _, block := range blocks {
+		ShellEncoder(&enc, block)
+	}
+	enc.Done()
+	if enc.GetError() != 0 {
+		t.Fatalf("encoder error %d", enc.GetError())
+	}
+	return enc.Buf[:(enc.Tell()+7)/8]
+}
+
+func TestShellCoderRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	blocks := testShellBlocks(rng, 5000)
+	packet := testShellEncode(t, blocks)
+
+	var dec entcode.Decoder
+	dec.Init(packet)
+	out := make([]int16, SHELL_CODEC_FRAME_LENGTH)
+	for b, block := range blocks {
+		/* The block sum is coded separately, ahead of the split */
+		sum := 0
+		for _, p := range block {
+			sum += p
+		}
+		shellDecoder(out, &dec, sum)
+		for i := range block {
+			if int(out[i]) != block[i] {
+				t.Fatalf("block %d: decoded %v, want %v", b, out, block)
+			}
+		}
+	}
+	if dec.GetError() != 0 {
+		t.Fatalf("decoder error %d", dec.GetError())
+	}
+}
+
+var update = flag.Bool("update", false, "rewrite testdata/shell_blocks.txt")
+
+// testdata/shell_blocks.txt holds blocks of testShellBlocks and the packet
+// ShellEncoder codes them into, which the Concentus shell coder must code
+// the same.
+const testShellBlocksFile = "testdata/shell_blocks.txt"
+
+func TestShellCoderBlocks(t *testing.T) {
+	if *update {
+		blocks := testShellBlocks(rand.New(rand.NewSource(2)), 400)
+		var b bytes.Buffer
+		b.WriteString("# Blocks of shell_coder_test.go and their packet, from go test -update.\n")
+		fmt.Fprintf(&b, "packet %x\n", testShellEncode(t, blocks))
+		for _, block := range blocks {
+			fmt.Fprintln(&b, strings.Trim(fmt.Sprint(block), "[]"))
+		}
+		if err := os.WriteFile(testShellBlocksFile, b.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := os.ReadFile(testShellBlocksFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []byte
+	var blocks [][]int
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		switch {
+		case len(f) == 0 || f[0] == "#":
+		case f[0] == "packet":
+			if want, err = hex.DecodeString(f[1]); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			block := make([]int, len(f))
+			for i := range f {
+				if block[i], err = strconv.Atoi(f[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+			blocks = append(blocks, block)
+		}
+	}
+	if got := testShellEncode(t, blocks); len(blocks) == 0 || !bytes.Equal(got, want) {
+		t.Fatalf("%d blocks coded to %x, want %x", len(blocks), got, want)
+	}
+}